@@ -0,0 +1,422 @@
+// Package admin implements the operator subcommands of the server binary:
+// user management, seeding master data and one-off maintenance jobs. They run
+// against the same services as the API and print either text or, with
+// --json, one JSON object per invocation for scripting.
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"asset-management-api/app/auth"
+	"asset-management-api/app/config"
+	"asset-management-api/app/repository"
+	"asset-management-api/app/services"
+	"asset-management-api/app/userimport"
+	"asset-management-api/assetpb"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Env is what the commands run against.
+type Env struct {
+	Config    config.Config
+	DB        *pgxpool.Pool
+	Keys      *auth.KeyManager
+	Passwords *auth.Passwords
+}
+
+const usage = `usage: server <command> [flags]
+
+commands:
+  user create --nip N --name NAME --role ID [--email E] [--area ID] [--outlet ID] [--password P]
+  user reset-password --nip N [--password P]
+  user disable --nip N
+  user enable --nip N
+  user import --file FILE [--commit] [--deactivate-missing]
+  seed master-data --file FILE
+  notifications rebuild
+  tokens purge-expired
+  apikey create --nip N --label LABEL --scopes upload,get-file,assets:read [--expires DURATION]
+  assets recompute-depreciation
+
+Every command accepts --json. A password left out is generated and printed.`
+
+type command func(ctx context.Context, env Env, p *printer, args []string) error
+
+var commands = map[string]command{
+	"user create":                   userCreate,
+	"user reset-password":           userResetPassword,
+	"user disable":                  userDisable,
+	"user enable":                   userEnable,
+	"user import":                   userImport,
+	"seed master-data":              seedMasterData,
+	"notifications rebuild":         notificationsRebuild,
+	"tokens purge-expired":          tokensPurgeExpired,
+	"apikey create":                 apiKeyCreate,
+	"assets recompute-depreciation": assetsRecomputeDepreciation,
+}
+
+// IsCommand reports whether name is the first word of an admin command.
+func IsCommand(name string) bool {
+	for key := range commands {
+		if strings.HasPrefix(key, name+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// Command runs the admin command named by the first two arguments. In JSON
+// mode a failure is also written to out as {"error": "..."}.
+func Command(ctx context.Context, env Env, args []string, out io.Writer) error {
+	if len(args) < 2 {
+		return fmt.Errorf("%s", usage)
+	}
+	run, ok := commands[args[0]+" "+args[1]]
+	if !ok {
+		return fmt.Errorf("unknown command %q\n%s", strings.Join(args[:2], " "), usage)
+	}
+
+	p := &printer{out: out}
+	err := run(ctx, env, p, args[2:])
+	if err != nil && p.json {
+		p.result(map[string]string{"error": err.Error()}, "")
+	}
+	return err
+}
+
+// printer writes a command's result as text or JSON.
+type printer struct {
+	out  io.Writer
+	json bool
+}
+
+func (p *printer) result(v interface{}, format string, args ...interface{}) error {
+	if p.json {
+		return json.NewEncoder(p.out).Encode(v)
+	}
+	_, err := fmt.Fprintf(p.out, format+"\n", args...)
+	return err
+}
+
+// flags returns a flag set that binds --json to the printer.
+func (p *printer) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	fs.BoolVar(&p.json, "json", false, "print the result as JSON")
+	return fs
+}
+
+func userService(env Env) *services.UserService {
+	return services.NewUserService(repository.NewUserRepository(env.DB), auth.NewTokenIssuer(env.DB, env.Config.Auth, env.Keys), env.Passwords, nil, nil, env.Config.Server.AppBaseURL)
+}
+
+type userResult struct {
+	Nip int32 `json:"nip"`
+	// Password is set only when it was generated
+	Password string `json:"password,omitempty"`
+}
+
+func userCreate(ctx context.Context, env Env, p *printer, args []string) error {
+	fs := p.flags("user create")
+	nip := fs.Int("nip", 0, "employee number")
+	name := fs.String("name", "", "full name")
+	email := fs.String("email", "", "email address")
+	role := fs.Int("role", 0, "role ID")
+	area := fs.Int("area", 0, "area ID, for area users")
+	outlet := fs.Int("outlet", 0, "outlet ID, for outlet users")
+	password := fs.String("password", "", "initial password (generated when empty)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *nip == 0 || *name == "" || *role == 0 {
+		return errors.New("user create: --nip, --name and --role are required")
+	}
+
+	resp, err := userService(env).CreateUser(ctx, &assetpb.CreateUserRequest{
+		Nip:          int32(*nip),
+		UserFullName: *name,
+		UserEmail:    *email,
+		UserPassword: *password,
+		RoleId:       int32(*role),
+		AreaId:       int32(*area),
+		OutletId:     int32(*outlet),
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.GetSuccess() {
+		return fmt.Errorf("user create: %s", resp.GetMessage())
+	}
+
+	result := userResult{Nip: int32(*nip), Password: resp.GetInitialPassword()}
+	if result.Password != "" {
+		return p.result(result, "created user %d with password %s", result.Nip, result.Password)
+	}
+	return p.result(result, "created user %d", result.Nip)
+}
+
+func userResetPassword(ctx context.Context, env Env, p *printer, args []string) error {
+	fs := p.flags("user reset-password")
+	nip := fs.Int("nip", 0, "employee number")
+	password := fs.String("password", "", "new password (generated when empty)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *nip == 0 {
+		return errors.New("user reset-password: --nip is required")
+	}
+
+	generated, err := userService(env).SetPassword(ctx, int32(*nip), *password)
+	if errors.Is(err, auth.ErrUnknownUser) {
+		return fmt.Errorf("user %d not found", *nip)
+	}
+	if err != nil {
+		return err
+	}
+
+	result := userResult{Nip: int32(*nip), Password: generated}
+	if result.Password != "" {
+		return p.result(result, "reset password of user %d to %s", result.Nip, result.Password)
+	}
+	return p.result(result, "reset password of user %d", result.Nip)
+}
+
+func userDisable(ctx context.Context, env Env, p *printer, args []string) error {
+	fs := p.flags("user disable")
+	nip := fs.Int("nip", 0, "employee number")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *nip == 0 {
+		return errors.New("user disable: --nip is required")
+	}
+
+	err := userService(env).DisableUser(ctx, int32(*nip))
+	if errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("user %d not found", *nip)
+	}
+	if err != nil {
+		return err
+	}
+	return p.result(userResult{Nip: int32(*nip)}, "disabled user %d", *nip)
+}
+
+func userEnable(ctx context.Context, env Env, p *printer, args []string) error {
+	fs := p.flags("user enable")
+	nip := fs.Int("nip", 0, "employee number")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *nip == 0 {
+		return errors.New("user enable: --nip is required")
+	}
+
+	err := userService(env).EnableUser(ctx, int32(*nip))
+	if errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("user %d not found or not disabled", *nip)
+	}
+	if err != nil {
+		return err
+	}
+	return p.result(userResult{Nip: int32(*nip)}, "enabled user %d", *nip)
+}
+
+type importResult struct {
+	Committed bool                  `json:"committed"`
+	Changes   []userimport.Change   `json:"changes"`
+	Errors    []userimport.RowError `json:"errors"`
+	Unchanged int                   `json:"unchanged"`
+}
+
+// userImport previews or applies a user spreadsheet like the ImportUsers
+// endpoint; initial passwords of created users are printed.
+func userImport(ctx context.Context, env Env, p *printer, args []string) error {
+	fs := p.flags("user import")
+	file := fs.String("file", "", "CSV or XLSX file of users")
+	commit := fs.Bool("commit", false, "apply the changes instead of previewing them")
+	deactivateMissing := fs.Bool("deactivate-missing", false, "deactivate users of the file's outlets who are not in it")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("user import: --file is required")
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+	rows, err := userimport.ReadRows(data, strings.TrimPrefix(strings.ToLower(filepath.Ext(*file)), "."))
+	if err != nil {
+		return err
+	}
+	result, err := userimport.NewImporter(env.DB).Import(ctx, rows, userimport.Options{
+		Commit:            *commit,
+		DeactivateMissing: *deactivateMissing,
+	})
+	if err != nil {
+		return err
+	}
+
+	if p.json {
+		return p.result(importResult{result.Committed, result.Changes, result.Errors, result.Unchanged}, "")
+	}
+	var lines []string
+	for _, e := range result.Errors {
+		lines = append(lines, fmt.Sprintf("row %d: %s", e.Row, e.Message))
+	}
+	for _, c := range result.Changes {
+		line := fmt.Sprintf("%s %d %s", c.Action, c.Nip, c.Name)
+		if len(c.Fields) > 0 {
+			line += " (" + strings.Join(c.Fields, ", ") + ")"
+		}
+		if c.Password != "" {
+			line += " password " + c.Password
+		}
+		lines = append(lines, line)
+	}
+	summary := fmt.Sprintf("%d to create, %d to update, %d to deactivate, %d unchanged",
+		result.Count(userimport.ActionCreate), result.Count(userimport.ActionUpdate), result.Count(userimport.ActionDeactivate), result.Unchanged)
+	switch {
+	case len(result.Errors) > 0:
+		summary += "; nothing imported because of errors"
+	case result.Committed:
+		summary = "imported: " + summary
+	default:
+		summary += "; dry run, add --commit to apply"
+	}
+	lines = append(lines, summary)
+	if err := p.result(nil, "%s", strings.Join(lines, "\n")); err != nil {
+		return err
+	}
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("user import: %d rows with errors", len(result.Errors))
+	}
+	return nil
+}
+
+func seedMasterData(ctx context.Context, env Env, p *printer, args []string) error {
+	fs := p.flags("seed master-data")
+	file := fs.String("file", "", "YAML or JSON file with the master data")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("seed master-data: --file is required")
+	}
+
+	data, err := LoadMasterData(*file)
+	if err != nil {
+		return err
+	}
+	counts, err := SeedMasterData(ctx, env.DB, data)
+	if err != nil {
+		return err
+	}
+
+	var parts []string
+	for _, table := range masterTables {
+		parts = append(parts, fmt.Sprintf("%s=%d", table, counts[table]))
+	}
+	return p.result(counts, "seeded %s", strings.Join(parts, " "))
+}
+
+// notificationsRebuild re-derives the notification rows from the assets and
+// submissions. No reminders are sent: the dispatcher is left out, since a
+// command line run would exit before delivering them.
+func notificationsRebuild(ctx context.Context, env Env, p *printer, args []string) error {
+	fs := p.flags("notifications rebuild")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	notifications := services.NewNotificationService(env.DB,
+		repository.NewNotificationRepository(env.DB),
+		repository.NewAssetRepository(env.DB),
+		repository.NewSubmissionRepository(env.DB),
+		repository.NewUserRepository(env.DB),
+		nil, nil, nil)
+	resp, err := notifications.InsertNotificationsForAllAssets(ctx, &assetpb.InsertAllRequest{})
+	if err != nil {
+		return err
+	}
+	return p.result(map[string]string{"message": resp.GetMessage()}, "%s", resp.GetMessage())
+}
+
+func tokensPurgeExpired(ctx context.Context, env Env, p *printer, args []string) error {
+	fs := p.flags("tokens purge-expired")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Purging needs no login providers or password policy
+	authService := services.NewAuthService(env.DB, auth.NewTokenIssuer(env.DB, env.Config.Auth, env.Keys), auth.NewLoginGuard(env.DB, env.Config.Auth), auth.NewTwoFactor(env.DB, env.Config.Auth), nil, nil)
+	purged, err := authService.PurgeExpiredSessions(ctx)
+	if err != nil {
+		return err
+	}
+	return p.result(map[string]int64{"purged": purged}, "purged %d expired sessions", purged)
+}
+
+// apiKeyCreate creates a REST server key, e.g. to replace a key formerly
+// listed in API_KEYS, and prints its secret.
+func apiKeyCreate(ctx context.Context, env Env, p *printer, args []string) error {
+	fs := p.flags("apikey create")
+	nip := fs.Int("nip", 0, "employee number of the owner")
+	label := fs.String("label", "", "what the key is for")
+	scopes := fs.String("scopes", "", "comma separated: "+strings.Join(auth.Scopes, ", "))
+	expires := fs.Duration("expires", 0, "lifetime, e.g. 2160h (never expires when 0)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *nip == 0 || *label == "" || *scopes == "" {
+		return errors.New("apikey create: --nip, --label and --scopes are required")
+	}
+
+	var scopeList []string
+	for _, scope := range strings.Split(*scopes, ",") {
+		scopeList = append(scopeList, strings.TrimSpace(scope))
+	}
+	var expiresAt *time.Time
+	if *expires > 0 {
+		t := time.Now().Add(*expires)
+		expiresAt = &t
+	}
+	key, secret, err := auth.NewAPIKeys(env.DB).Create(ctx, int32(*nip), *label, scopeList, expiresAt)
+	if errors.Is(err, auth.ErrInvalidScope) {
+		return fmt.Errorf("apikey create: --scopes must be one or more of %s", strings.Join(auth.Scopes, ", "))
+	}
+	if errors.Is(err, auth.ErrInvalidOwner) {
+		return fmt.Errorf("user %d not found or disabled", *nip)
+	}
+	if err != nil {
+		return err
+	}
+
+	result := map[string]interface{}{"key_id": key.Id, "secret": secret}
+	return p.result(result, "created API key %d: %s", key.Id, secret)
+}
+
+func assetsRecomputeDepreciation(ctx context.Context, env Env, p *printer, args []string) error {
+	fs := p.flags("assets recompute-depreciation")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	assets := services.NewAssetService(repository.NewAssetRepository(env.DB), nil, nil)
+	report, err := assets.RecomputeDepreciation(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	return p.result(report, "checked %d assets: %d updated, %d skipped", report.Checked, report.Updated, report.Skipped)
+}
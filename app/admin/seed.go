@@ -0,0 +1,213 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"gopkg.in/yaml.v3"
+)
+
+// MasterData is the reference data loaded by "seed master-data". Rows carry
+// their IDs so that they can refer to each other and so that seeding the same
+// file again updates rows instead of duplicating them.
+type MasterData struct {
+	Roles []struct {
+		Id   int32  `yaml:"id"`
+		Name string `yaml:"name"`
+	} `yaml:"roles"`
+	Areas []struct {
+		Id   int32  `yaml:"id"`
+		Name string `yaml:"name"`
+	} `yaml:"areas"`
+	Outlets []struct {
+		Id     int32  `yaml:"id"`
+		Name   string `yaml:"name"`
+		AreaId int32  `yaml:"area_id"`
+	} `yaml:"outlets"`
+	Positions []struct {
+		Id   int32  `yaml:"id"`
+		Name string `yaml:"name"`
+	} `yaml:"positions"`
+	MaintenancePeriods []struct {
+		Id   int32  `yaml:"id"`
+		Name string `yaml:"name"`
+	} `yaml:"maintenance_periods"`
+	Classifications []struct {
+		Id                  int32  `yaml:"id"`
+		Name                string `yaml:"name"`
+		EconomicValue       int32  `yaml:"economic_value"`
+		MaintenancePeriodId int32  `yaml:"maintenance_period_id"`
+	} `yaml:"classifications"`
+	AssetNamings []struct {
+		Id               int32  `yaml:"id"`
+		Name             string `yaml:"name"`
+		ClassificationId int32  `yaml:"classification_id"`
+	} `yaml:"asset_namings"`
+	PersonalResponsibles []struct {
+		Id   int32  `yaml:"id"`
+		Name string `yaml:"name"`
+	} `yaml:"personal_responsibles"`
+}
+
+// masterTables are the seeded tables in dependency order.
+var masterTables = []string{
+	"roles", "areas", "outlets", "positions", "maintenance_periods",
+	"classifications", "mst_assets", "personal_responsibles",
+}
+
+// serialColumns are the ID columns whose sequences must move past the seeded IDs.
+var serialColumns = map[string]string{
+	"roles":                 "role_id",
+	"areas":                 "area_id",
+	"outlets":               "outlet_id",
+	"positions":             "id",
+	"maintenance_periods":   "period_id",
+	"classifications":       "classification_id",
+	"mst_assets":            "id_asset_naming",
+	"personal_responsibles": "personal_responsible_id",
+}
+
+// LoadMasterData reads a master data file. YAML is a superset of JSON, so
+// either format is accepted.
+func LoadMasterData(path string) (*MasterData, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var data MasterData
+	if err := yaml.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &data, nil
+}
+
+// SeedMasterData inserts or updates every row of data in one transaction and
+// returns the number of rows written per table.
+func SeedMasterData(ctx context.Context, db *pgxpool.Pool, data *MasterData) (map[string]int, error) {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	counts := make(map[string]int)
+	upsert := func(table, query string, args ...interface{}) error {
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("seed %s: %w", table, err)
+		}
+		counts[table]++
+		return nil
+	}
+
+	for _, r := range data.Roles {
+		err := upsert("roles", `
+            INSERT INTO roles (role_id, role_name) VALUES ($1, $2)
+            ON CONFLICT (role_id) DO UPDATE SET role_name = EXCLUDED.role_name`, r.Id, r.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, a := range data.Areas {
+		err := upsert("areas", `
+            INSERT INTO areas (area_id, area_name) VALUES ($1, $2)
+            ON CONFLICT (area_id) DO UPDATE SET area_name = EXCLUDED.area_name`, a.Id, a.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, o := range data.Outlets {
+		err := upsert("outlets", `
+            INSERT INTO outlets (outlet_id, outlet_name) VALUES ($1, $2)
+            ON CONFLICT (outlet_id) DO UPDATE SET outlet_name = EXCLUDED.outlet_name`, o.Id, o.Name)
+		if err != nil {
+			return nil, err
+		}
+		if o.AreaId == 0 {
+			continue
+		}
+		_, err = tx.Exec(ctx, `
+            INSERT INTO area_outlets (outlet_id, area_id) VALUES ($1, $2)
+            ON CONFLICT (outlet_id) DO UPDATE SET area_id = EXCLUDED.area_id`, o.Id, o.AreaId)
+		if err != nil {
+			return nil, fmt.Errorf("seed area_outlets: %w", err)
+		}
+	}
+	for _, pos := range data.Positions {
+		err := upsert("positions", `
+            INSERT INTO positions (id, position_name) VALUES ($1, $2)
+            ON CONFLICT (id) DO UPDATE SET position_name = EXCLUDED.position_name`, pos.Id, pos.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, m := range data.MaintenancePeriods {
+		err := upsert("maintenance_periods", `
+            INSERT INTO maintenance_periods (period_id, period_name) VALUES ($1, $2)
+            ON CONFLICT (period_id) DO UPDATE SET period_name = EXCLUDED.period_name`, m.Id, m.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range data.Classifications {
+		err := upsert("classifications", `
+            INSERT INTO classifications (classification_id, classification_name, classification_economic_value, maintenance_period_id)
+            VALUES ($1, $2, $3, NULLIF($4, 0))
+            ON CONFLICT (classification_id) DO UPDATE SET
+                classification_name = EXCLUDED.classification_name,
+                classification_economic_value = EXCLUDED.classification_economic_value,
+                maintenance_period_id = EXCLUDED.maintenance_period_id`,
+			c.Id, c.Name, c.EconomicValue, c.MaintenancePeriodId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, n := range data.AssetNamings {
+		err := upsert("mst_assets", `
+            INSERT INTO mst_assets (id_asset_naming, asset_naming, classification_id) VALUES ($1, $2, NULLIF($3, 0))
+            ON CONFLICT (id_asset_naming) DO UPDATE SET
+                asset_naming = EXCLUDED.asset_naming,
+                classification_id = EXCLUDED.classification_id`,
+			n.Id, n.Name, n.ClassificationId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, pr := range data.PersonalResponsibles {
+		err := upsert("personal_responsibles", `
+            INSERT INTO personal_responsibles (personal_responsible_id, name) VALUES ($1, $2)
+            ON CONFLICT (personal_responsible_id) DO UPDATE SET name = EXCLUDED.name`, pr.Id, pr.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := advanceSequences(ctx, tx, counts); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// advanceSequences moves each seeded table's ID sequence past its highest
+// ID, so rows created later through the API do not collide with seeded ones.
+func advanceSequences(ctx context.Context, tx pgx.Tx, counts map[string]int) error {
+	for _, table := range masterTables {
+		if counts[table] == 0 {
+			continue
+		}
+		column := serialColumns[table]
+		query := fmt.Sprintf(`
+            SELECT setval(pg_get_serial_sequence('%[1]s', '%[2]s'), MAX(%[2]s))
+            FROM %[1]s
+            WHERE pg_get_serial_sequence('%[1]s', '%[2]s') IS NOT NULL`, table, column)
+		if _, err := tx.Exec(ctx, query); err != nil {
+			return fmt.Errorf("advance %s sequence: %w", table, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// API key scopes, each granting one route of the REST server.
+const (
+	ScopeUpload     = "upload"
+	ScopeGetFile    = "get-file"
+	ScopeAssetsRead = "assets:read"
+)
+
+// Scopes lists every API key scope.
+var Scopes = []string{ScopeUpload, ScopeGetFile, ScopeAssetsRead}
+
+var (
+	// ErrAPIKeyNotFound means the key does not exist, is revoked or belongs to
+	// another user.
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrInvalidScope means a scope is not one of Scopes.
+	ErrInvalidScope = errors.New("invalid api key scope")
+	// ErrInvalidOwner means the owner of a new key is unknown or disabled.
+	ErrInvalidOwner = errors.New("api key owner not found or disabled")
+)
+
+// apiKeyPrefix marks secrets as API keys of this service, e.g. for secret
+// scanners.
+const apiKeyPrefix = "amk_"
+
+// lastUsedInterval is how often last_used_at is updated, to save a write on
+// every call.
+const lastUsedInterval = time.Minute
+
+// APIKey is a stored API key. The secret itself is only known when created.
+type APIKey struct {
+	Id int32
+	// Prefix is the start of the secret, to tell keys apart
+	Prefix     string
+	Label      string
+	Nip        int32
+	Scopes     []string
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	// RoleId, AreaId and OutletId are those of the owner, set by
+	// Authenticate; AreaId and OutletId are 0 when the owner has none
+	RoleId   int32
+	AreaId   int32
+	OutletId int32
+}
+
+// HasScope reports whether the key grants scope.
+func (k *APIKey) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKeys stores API keys hashed and authenticates callers by them. A key
+// stops working when it is revoked, expires or its owner is disabled.
+type APIKeys struct {
+	DB *pgxpool.Pool
+}
+
+func NewAPIKeys(db *pgxpool.Pool) *APIKeys {
+	return &APIKeys{DB: db}
+}
+
+// Create stores a new key of nip and returns it with its secret. expiresAt
+// may be nil for a key that does not expire.
+func (k *APIKeys) Create(ctx context.Context, nip int32, label string, scopes []string, expiresAt *time.Time) (APIKey, string, error) {
+	for _, scope := range scopes {
+		if !validScope(scope) {
+			return APIKey{}, "", ErrInvalidScope
+		}
+	}
+	if len(scopes) == 0 {
+		return APIKey{}, "", ErrInvalidScope
+	}
+
+	random, err := randomHex(24)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	secret := apiKeyPrefix + random
+	key := APIKey{
+		Prefix:    secret[:len(apiKeyPrefix)+8],
+		Label:     label,
+		Nip:       nip,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	}
+	err = k.DB.QueryRow(ctx, `
+        INSERT INTO api_keys (prefix, key_hash, label, nip, scopes, expires_at)
+        SELECT $1::text, $2::text, $3::text, nip, $5::text[], $6::timestamp FROM users WHERE nip = $4 AND disabled_at IS NULL
+        RETURNING id, created_at`,
+		key.Prefix, hashToken(secret), label, nip, scopes, expiresAt).Scan(&key.Id, &key.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return APIKey{}, "", ErrInvalidOwner
+	}
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	return key, secret, nil
+}
+
+// List returns the keys of nip that are not revoked, or of every user when
+// nip is 0, newest first.
+func (k *APIKeys) List(ctx context.Context, nip int32) ([]APIKey, error) {
+	rows, err := k.DB.Query(ctx, `
+        SELECT id, prefix, label, nip, scopes, created_at, expires_at, last_used_at
+        FROM api_keys
+        WHERE revoked_at IS NULL AND ($1 = 0 OR nip = $1)
+        ORDER BY created_at DESC, id DESC`, nip)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []APIKey
+	for rows.Next() {
+		var key APIKey
+		if err := rows.Scan(&key.Id, &key.Prefix, &key.Label, &key.Nip, &key.Scopes, &key.CreatedAt, &key.ExpiresAt, &key.LastUsedAt); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// Revoke ends key id of nip, or of any user when nip is 0.
+func (k *APIKeys) Revoke(ctx context.Context, id, nip int32) error {
+	tag, err := k.DB.Exec(ctx, `
+        UPDATE api_keys SET revoked_at = NOW()
+        WHERE id = $1 AND ($2 = 0 OR nip = $2) AND revoked_at IS NULL`, id, nip)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// Authenticate returns the key secret belongs to, or ErrInvalidToken if it
+// is unknown or no longer valid, and records that it was used.
+func (k *APIKeys) Authenticate(ctx context.Context, secret string) (*APIKey, error) {
+	var key APIKey
+	err := k.DB.QueryRow(ctx, `
+        SELECT api_keys.id, prefix, label, api_keys.nip, scopes, api_keys.created_at, expires_at, last_used_at,
+               users.role_id, COALESCE(users.area_id, 0), COALESCE(users.outlet_id, 0)
+        FROM api_keys
+        JOIN users ON users.nip = api_keys.nip
+        WHERE key_hash = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())
+          AND users.disabled_at IS NULL`, hashToken(secret)).
+		Scan(&key.Id, &key.Prefix, &key.Label, &key.Nip, &key.Scopes, &key.CreatedAt, &key.ExpiresAt, &key.LastUsedAt,
+			&key.RoleId, &key.AreaId, &key.OutletId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = k.DB.Exec(ctx, `
+        UPDATE api_keys SET last_used_at = NOW()
+        WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - make_interval(secs => $2))`,
+		key.Id, lastUsedInterval.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func validScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
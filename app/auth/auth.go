@@ -1,17 +1,13 @@
 package auth
 
 import (
-    "asset-management-api/app/database"
-    "asset-management-api/assetpb"
     "context"
+    "errors"
     "net/http"
-    "os"
     "strings"
-    "time"
 
     "github.com/dgrijalva/jwt-go"
     "github.com/gin-gonic/gin"
-    "github.com/jackc/pgx/v5/pgxpool"
     "github.com/rs/zerolog/log"
     "google.golang.org/grpc"
     "google.golang.org/grpc/codes"
@@ -19,123 +15,223 @@ import (
     "google.golang.org/grpc/status"
 )
 
-var db *pgxpool.Pool
+// JWTAuthMiddleware verifies the caller's access token on every call except
+// excludeMethods, which accept anonymous callers but still see the claims of
+// a valid token. Callers who must change their password may only use
+// passwordMethods until they have. Verification is stateless apart from
+// the in-memory set of ended sessions kept by tokens.Revocations.
+func JWTAuthMiddleware(tokens *TokenIssuer, excludeMethods, passwordMethods []string) grpc.UnaryServerInterceptor {
+    return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+        if isExcluded(info.FullMethod, excludeMethods) {
+            return handler(optionalClaims(ctx, tokens), req)
+        }
 
-func init() {
-    db = database.DBConn()
-}
+        ctx, err := authenticate(ctx, tokens)
+        if err != nil {
+            return nil, err
+        }
+        if err := checkPasswordChange(ctx, info.FullMethod, passwordMethods); err != nil {
+            return nil, err
+        }
 
-func ValidateToken(tokenString string) *string {
-    var token string
-    query := "SELECT token FROM token_stores WHERE token = $1" // PostgreSQL uses $1
-    err := db.QueryRow(context.Background(), query, tokenString).Scan(&token)
-    if err != nil {
-        log.Error().Err(err).Msg("Failed to validate token")
-        return nil
+        return handler(ctx, req)
     }
-    return &token
 }
 
-func JWTAuthMiddleware(jwtSecret string, excludeMethods []string) grpc.UnaryServerInterceptor {
-    if jwtSecret == "" {
-        log.Fatal().Msg("JWT_SECRET is not set")
-    }
-
-    return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-        for _, excludedMethod := range excludeMethods {
-            if info.FullMethod == excludedMethod {
-                return handler(ctx, req)
-            }
+// JWTStreamAuthMiddleware is the streaming counterpart of JWTAuthMiddleware.
+func JWTStreamAuthMiddleware(tokens *TokenIssuer, excludeMethods, passwordMethods []string) grpc.StreamServerInterceptor {
+    return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+        if isExcluded(info.FullMethod, excludeMethods) {
+            return handler(srv, ss)
         }
 
-        md, ok := metadata.FromIncomingContext(ctx)
-        if !ok {
-            log.Error().Msg("Failed to extract metadata from incoming context")
-            return nil, status.Error(codes.Unauthenticated, "Failed to extract metadata")
+        ctx, err := authenticate(ss.Context(), tokens)
+        if err != nil {
+            return err
         }
-
-        tokenMeta := md.Get("authorization")
-        if len(tokenMeta) == 0 {
-            log.Warn().Msg("Unauthorized: token is missing")
-            return nil, status.Error(codes.Unauthenticated, "Unauthorized: token is missing")
+        if err := checkPasswordChange(ctx, info.FullMethod, passwordMethods); err != nil {
+            return err
         }
 
-        tokenString := strings.Replace(tokenMeta[0], "Bearer ", "", 1)
-        if len(tokenString) == 0 {
-            log.Warn().Msg("Unauthorized: token is missing")
-            return nil, status.Error(codes.Unauthenticated, "Unauthorized: token is missing")
-        }
+        return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+    }
+}
 
-        findToken := ValidateToken(tokenString)
-        if findToken == nil {
-            log.Error().Msg("Token not found in database")
-            return nil, status.Error(codes.Unauthenticated, "Invalid token")
-        }
+type authenticatedStream struct {
+    grpc.ServerStream
+    ctx context.Context
+}
 
-        token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-            return []byte(jwtSecret), nil
-        })
-        if err != nil || !token.Valid {
-            log.Error().Msg("Invalid token")
-            return nil, status.Error(codes.Unauthenticated, "Invalid token")
-        }
+func (s *authenticatedStream) Context() context.Context {
+    return s.ctx
+}
 
-        return handler(ctx, req)
+func isExcluded(method string, excludeMethods []string) bool {
+    for _, excludedMethod := range excludeMethods {
+        if method == excludedMethod {
+            return true
+        }
     }
+    return false
 }
 
-func GenerateJWTToken(nip int32) *string {
-    jwtSecret := os.Getenv("JWT_SECRET")
-    if jwtSecret == "" {
-        log.Error().Msg("JWT_SECRET is not set")
+// checkPasswordChange turns away callers who must change their password
+// from every method but passwordMethods.
+func checkPasswordChange(ctx context.Context, method string, passwordMethods []string) error {
+    claims, _ := ClaimsFromContext(ctx)
+    if claims == nil || !claims.MustChangePassword || isExcluded(method, passwordMethods) {
         return nil
     }
+    return status.Error(codes.PermissionDenied, "Password change required")
+}
 
-    var user assetpb.User
-    query := "SELECT user_full_name, role_id, outlet_id, area_id FROM users WHERE nip = $1"
-    err := db.QueryRow(context.Background(), query, nip).Scan(&user.UserFullName, &user.RoleId, &user.OutletId, &user.AreaId)
+// optionalClaims adds the claims of a valid bearer token to ctx, if any.
+func optionalClaims(ctx context.Context, tokens *TokenIssuer) context.Context {
+    md, _ := metadata.FromIncomingContext(ctx)
+    tokenMeta := md.Get("authorization")
+    if len(tokenMeta) == 0 {
+        return ctx
+    }
+    claims, err := tokens.Verify(strings.Replace(tokenMeta[0], "Bearer ", "", 1))
     if err != nil {
-        log.Error().Err(err).Msg("Failed to fetch user data")
-        return nil
+        return ctx
+    }
+    return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// authenticate validates the bearer token in the incoming metadata and
+// returns a context carrying the caller's claims.
+func authenticate(ctx context.Context, tokens *TokenIssuer) (context.Context, error) {
+    md, ok := metadata.FromIncomingContext(ctx)
+    if !ok {
+        log.Error().Msg("Failed to extract metadata from incoming context")
+        return nil, status.Error(codes.Unauthenticated, "Failed to extract metadata")
+    }
+
+    tokenMeta := md.Get("authorization")
+    if len(tokenMeta) == 0 {
+        log.Warn().Msg("Unauthorized: token is missing")
+        return nil, status.Error(codes.Unauthenticated, "Unauthorized: token is missing")
     }
 
-    claims := jwt.MapClaims{
-        "sub":       nip,
-        "name":      user.UserFullName,
-        "role_id":   user.RoleId,
-        "outlet_id": user.OutletId,
-        "area_id":   user.AreaId,
-        "exp":       time.Now().Add(time.Hour * 72).Unix(),
+    tokenString := strings.Replace(tokenMeta[0], "Bearer ", "", 1)
+    if len(tokenString) == 0 {
+        log.Warn().Msg("Unauthorized: token is missing")
+        return nil, status.Error(codes.Unauthenticated, "Unauthorized: token is missing")
     }
-    token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-    tokenString, err := token.SignedString([]byte(jwtSecret))
+
+    claims, err := tokens.Verify(tokenString)
     if err != nil {
-        log.Error().Err(err).Msg("Failed to generate JWT token")
-        return nil
+        log.Warn().Msg("Invalid token")
+        return nil, status.Error(codes.Unauthenticated, "Invalid token")
     }
 
-    log.Info().Str("token", tokenString).Msg("Generated JWT token")
-    return &tokenString
+    return context.WithValue(ctx, claimsKey{}, claims), nil
+}
+
+// Claims is the identity of the caller as carried by the JWT token.
+type Claims struct {
+    Nip      int32
+    Name     string
+    RoleId   int32
+    OutletId int32
+    AreaId   int32
+    // SessionId identifies the login the token was issued for
+    SessionId string
+    // Admin is set for users of the configured admin role
+    Admin bool
+    // MustChangePassword limits the token to the password change methods
+    MustChangePassword bool
 }
 
-func APIKeyMiddleware(apiKeys map[string]bool) gin.HandlerFunc {
+type claimsKey struct{}
+
+// ClaimsFromContext returns the claims stored by JWTAuthMiddleware.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+    claims, ok := ctx.Value(claimsKey{}).(*Claims)
+    return claims, ok
+}
+
+// ContextWithClaims returns ctx carrying claims, as JWTAuthMiddleware does.
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+    return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+func claimsFromMap(m jwt.MapClaims) *Claims {
+    claimInt := func(key string) int32 {
+        if v, ok := m[key].(float64); ok {
+            return int32(v)
+        }
+        return 0
+    }
+    name, _ := m["name"].(string)
+    sessionId, _ := m["sid"].(string)
+    admin, _ := m["adm"].(bool)
+    mustChangePassword, _ := m["pwc"].(bool)
+    return &Claims{
+        Nip:                claimInt("sub"),
+        Name:               name,
+        RoleId:             claimInt("role_id"),
+        OutletId:           claimInt("outlet_id"),
+        AreaId:             claimInt("area_id"),
+        SessionId:          sessionId,
+        Admin:              admin,
+        MustChangePassword: mustChangePassword,
+    }
+}
+
+// APIKeyMiddleware authenticates REST calls by the X-API-KEY header against
+// the stored keys; routes then check its scopes with RequireScope. Keys are
+// only ever logged by their prefix.
+func APIKeyMiddleware(keys *APIKeys) gin.HandlerFunc {
     return func(c *gin.Context) {
-        apiKey := c.GetHeader("X-API-KEY")
-        if apiKey == "" {
+        secret := c.GetHeader("X-API-KEY")
+        if secret == "" {
             log.Warn().Msg("Missing API key")
-            c.JSON(http.StatusUnauthorized, gin.H{"error": "Missing API key"})
-            c.Abort()
+            c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing API key"})
             return
         }
 
-        if !apiKeys[apiKey] {
-            log.Warn().Str("api_key", apiKey).Msg("Invalid API key")
-            c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid API key"})
-            c.Abort()
+        key, err := keys.Authenticate(c.Request.Context(), secret)
+        if errors.Is(err, ErrInvalidToken) {
+            log.Warn().Str("client_ip", c.ClientIP()).Msg("Invalid API key")
+            c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid API key"})
             return
         }
+        if err != nil {
+            log.Error().Err(err).Msg("Failed to check API key")
+            c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to check API key"})
+            return
+        }
+
+        c.Set(apiKeyContextKey, key)
+        c.Next()
+    }
+}
+
+const apiKeyContextKey = "api_key"
+
+// APIKeyFromContext returns the key stored by APIKeyMiddleware.
+func APIKeyFromContext(c *gin.Context) (*APIKey, bool) {
+    key, ok := c.Get(apiKeyContextKey)
+    if !ok {
+        return nil, false
+    }
+    apiKey, ok := key.(*APIKey)
+    return apiKey, ok
+}
 
-        log.Info().Str("api_key", apiKey).Msg("API key validated successfully")
+// RequireScope rejects calls whose API key does not grant scope.
+func RequireScope(scope string) gin.HandlerFunc {
+    return func(c *gin.Context) {
+        key, ok := APIKeyFromContext(c)
+        if !ok || !key.HasScope(scope) {
+            if ok {
+                log.Warn().Str("api_key", key.Prefix).Str("scope", scope).Msg("API key lacks scope")
+            }
+            c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "API key does not grant " + scope})
+            return
+        }
         c.Next()
     }
-}
\ No newline at end of file
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"asset-management-api/app/config"
+	"asset-management-api/app/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Authentication providers, as named in AUTH_PROVIDERS.
+const (
+	ProviderLocal = "local"
+	ProviderLDAP  = "ldap"
+	ProviderOIDC  = "oidc"
+)
+
+var (
+	// ErrUnknownUser means the provider does not know the user, or cannot
+	// handle the credentials given.
+	ErrUnknownUser = errors.New("unknown user")
+	// ErrBadCredentials means the provider knows the user but rejected the
+	// password or code.
+	ErrBadCredentials = errors.New("invalid credentials")
+)
+
+// Credentials are what a user logs in with: a NIP and password, or the code
+// and state an OIDC provider redirected back with.
+type Credentials struct {
+	Nip      int32
+	Password string
+	Code     string
+	State    string
+}
+
+// Identity is a user as vouched for by a provider.
+type Identity struct {
+	Provider string
+	// Nip is 0 when the provider does not know it
+	Nip    int32
+	Name   string
+	Email  string
+	Groups []string
+}
+
+// Authenticator checks credentials against one identity provider.
+type Authenticator interface {
+	Name() string
+	Authenticate(ctx context.Context, creds Credentials) (*Identity, error)
+}
+
+// Providers are the configured authenticators and the provisioner mapping
+// their identities to local users.
+type Providers struct {
+	// Password authenticators are tried in order for NIP and password logins
+	Password    []Authenticator
+	OIDC        *OIDC
+	Provisioner *Provisioner
+}
+
+func NewProviders(db *pgxpool.Pool, cfg config.Config) (*Providers, error) {
+	provisioner, err := NewProvisioner(db, cfg.Auth)
+	if err != nil {
+		return nil, err
+	}
+	providers := &Providers{Provisioner: provisioner}
+	for _, name := range cfg.Auth.Providers {
+		switch name {
+		case ProviderLocal:
+			providers.Password = append(providers.Password, &LocalAuthenticator{DB: db})
+		case ProviderLDAP:
+			providers.Password = append(providers.Password, NewLDAPAuthenticator(cfg.LDAP))
+		case ProviderOIDC:
+			providers.OIDC = NewOIDC(db, cfg.OIDC)
+		default:
+			return nil, fmt.Errorf("unknown authentication provider %q", name)
+		}
+	}
+	return providers, nil
+}
+
+// Authenticate tries a NIP and password with each password authenticator in
+// turn. It fails with ErrBadCredentials if any of them knew the user, and
+// ErrUnknownUser if none did.
+func (p *Providers) Authenticate(ctx context.Context, creds Credentials) (*Identity, error) {
+	result := ErrUnknownUser
+	for _, authenticator := range p.Password {
+		identity, err := authenticator.Authenticate(ctx, creds)
+		switch {
+		case err == nil:
+			return identity, nil
+		case errors.Is(err, ErrBadCredentials):
+			result = err
+		case !errors.Is(err, ErrUnknownUser):
+			return nil, fmt.Errorf("%s: %w", authenticator.Name(), err)
+		}
+	}
+	return nil, result
+}
+
+// dummyPasswordHash is compared against when the user does not exist, so that
+// unknown NIPs take as long to reject as wrong passwords.
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, _ := utils.HashPassword("dummy password")
+	return hash
+})
+
+// LocalAuthenticator checks passwords against the bcrypt hashes in users.
+type LocalAuthenticator struct {
+	DB *pgxpool.Pool
+}
+
+func (a *LocalAuthenticator) Name() string {
+	return ProviderLocal
+}
+
+func (a *LocalAuthenticator) Authenticate(ctx context.Context, creds Credentials) (*Identity, error) {
+	identity := Identity{Provider: ProviderLocal, Nip: creds.Nip}
+	var hash string
+	err := a.DB.QueryRow(ctx, `
+        SELECT user_password, user_full_name, COALESCE(user_email, '')
+        FROM users WHERE nip = $1`, creds.Nip).Scan(&hash, &identity.Name, &identity.Email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		utils.VerifyPassword(dummyPasswordHash(), creds.Password)
+		return nil, ErrUnknownUser
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.VerifyPassword(hash, creds.Password); err != nil {
+		return nil, ErrBadCredentials
+	}
+	return &identity, nil
+}
@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"asset-management-api/app/config"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Reasons recorded in the login audit log.
+const (
+	LoginSucceeded   = "success"
+	LoginBadPassword = "bad_password"
+	LoginUnknownUser = "unknown_user"
+	LoginDisabled    = "disabled"
+	LoginThrottled   = "throttled"
+	LoginMFARequired = "mfa_required"
+	LoginBadMFACode  = "bad_mfa_code"
+)
+
+// Throttle scopes: failures are counted per account and per client address.
+const (
+	scopeAccount = "account"
+	scopeIP      = "ip"
+)
+
+// loginAuditRetention is how long login attempts are kept.
+const loginAuditRetention = 90 * 24 * time.Hour
+
+// LoginAttempt is one entry of the login audit log.
+type LoginAttempt struct {
+	Nip       int32
+	Success   bool
+	Reason    string
+	Client    Client
+	CreatedAt time.Time
+}
+
+// LoginGuard slows down and locks out password guessing. Every failed login
+// delays the next attempt for the same account, doubling from Backoff; after
+// MaxFailures the account is locked for Lockout. Addresses are counted the
+// same way but get MaxFailures attempts before slowing down and MaxIPFailures
+// before locking, since many users may share one. Counts restart once
+// Lockout has passed without a failure.
+type LoginGuard struct {
+	DB            *pgxpool.Pool
+	MaxFailures   int
+	MaxIPFailures int
+	Backoff       time.Duration
+	Lockout       time.Duration
+}
+
+func NewLoginGuard(db *pgxpool.Pool, cfg config.Auth) *LoginGuard {
+	return &LoginGuard{
+		DB:            db,
+		MaxFailures:   cfg.LoginMaxFailures,
+		MaxIPFailures: cfg.LoginMaxIPFailures,
+		Backoff:       cfg.LoginBackoff,
+		Lockout:       cfg.LoginLockout,
+	}
+}
+
+// Check returns how long client must wait before nip may be tried, or zero
+// if it may be tried now.
+func (g *LoginGuard) Check(ctx context.Context, nip int32, client Client) (time.Duration, error) {
+	var blockedUntil *time.Time
+	err := g.DB.QueryRow(ctx, `
+        SELECT MAX(blocked_until) FROM login_throttles
+        WHERE (scope = $1 AND key = $2) OR (scope = $3 AND key = $4)`,
+		scopeAccount, accountKey(nip), scopeIP, addressKey(client.IP)).Scan(&blockedUntil)
+	if err != nil || blockedUntil == nil {
+		return 0, err
+	}
+	if wait := time.Until(*blockedUntil); wait > 0 {
+		return wait, nil
+	}
+	return 0, nil
+}
+
+// Failed counts a failed login against nip and the address of client.
+func (g *LoginGuard) Failed(ctx context.Context, nip int32, client Client) error {
+	if err := g.fail(ctx, scopeAccount, accountKey(nip), 1, g.MaxFailures); err != nil {
+		return err
+	}
+	if client.IP == "" {
+		return nil
+	}
+	return g.fail(ctx, scopeIP, addressKey(client.IP), g.MaxFailures, g.MaxIPFailures)
+}
+
+// fail adds a failure to one counter and blocks it for the resulting delay:
+// none for the first free failures, then doubling from Backoff, and Lockout
+// once max is reached.
+func (g *LoginGuard) fail(ctx context.Context, scope, key string, free, max int) error {
+	now := time.Now()
+	var failures int
+	err := g.DB.QueryRow(ctx, `
+        INSERT INTO login_throttles (scope, key, failures, last_failure_at)
+        VALUES ($1, $2, 1, $3)
+        ON CONFLICT (scope, key) DO UPDATE SET
+            failures = CASE WHEN login_throttles.last_failure_at < $4 THEN 1
+                            ELSE login_throttles.failures + 1 END,
+            last_failure_at = $3
+        RETURNING failures`, scope, key, now, now.Add(-g.Lockout)).Scan(&failures)
+	if err != nil {
+		return err
+	}
+
+	if failures <= free {
+		return nil
+	}
+	delay := g.Backoff
+	for i := free + 1; i < failures && delay < g.Lockout; i++ {
+		delay *= 2
+	}
+	if delay > g.Lockout || failures >= max {
+		delay = g.Lockout
+	}
+	_, err = g.DB.Exec(ctx, `
+        UPDATE login_throttles SET blocked_until = $3
+        WHERE scope = $1 AND key = $2`, scope, key, now.Add(delay))
+	return err
+}
+
+// Succeeded clears the failures of nip. The address keeps its count, so a
+// valid account cannot be used to reset it.
+func (g *LoginGuard) Succeeded(ctx context.Context, nip int32) error {
+	_, err := g.DB.Exec(ctx, "DELETE FROM login_throttles WHERE scope = $1 AND key = $2", scopeAccount, accountKey(nip))
+	return err
+}
+
+// Unlock clears the failures of nip and, when not empty, of ip. It reports
+// whether anything was cleared.
+func (g *LoginGuard) Unlock(ctx context.Context, nip int32, ip string) (bool, error) {
+	tag, err := g.DB.Exec(ctx, `
+        DELETE FROM login_throttles
+        WHERE (scope = $1 AND key = $2) OR (scope = $3 AND key = $4)`,
+		scopeAccount, accountKey(nip), scopeIP, addressKey(ip))
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Record adds an attempt to the login audit log.
+func (g *LoginGuard) Record(ctx context.Context, attempt LoginAttempt) error {
+	_, err := g.DB.Exec(ctx, `
+        INSERT INTO login_audit (nip, success, reason, ip_address, user_agent)
+        VALUES ($1, $2, $3, $4, $5)`,
+		attempt.Nip, attempt.Success, attempt.Reason, attempt.Client.IP, attempt.Client.UserAgent)
+	return err
+}
+
+// Attempts returns the latest login attempts, newest first, for nip or for
+// every account when nip is 0.
+func (g *LoginGuard) Attempts(ctx context.Context, nip int32, limit int32) ([]LoginAttempt, error) {
+	rows, err := g.DB.Query(ctx, `
+        SELECT nip, success, reason, ip_address, user_agent, created_at
+        FROM login_audit
+        WHERE $1 = 0 OR nip = $1
+        ORDER BY created_at DESC, id DESC
+        LIMIT $2`, nip, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []LoginAttempt
+	for rows.Next() {
+		var a LoginAttempt
+		if err := rows.Scan(&a.Nip, &a.Success, &a.Reason, &a.Client.IP, &a.Client.UserAgent, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, a)
+	}
+	return attempts, rows.Err()
+}
+
+// Cleanup drops counters that no longer block anything and audit entries
+// past their retention.
+func (g *LoginGuard) Cleanup(ctx context.Context) error {
+	now := time.Now()
+	_, err := g.DB.Exec(ctx, `
+        DELETE FROM login_throttles
+        WHERE last_failure_at < $1 AND (blocked_until IS NULL OR blocked_until < $2)`,
+		now.Add(-g.Lockout), now)
+	if err != nil {
+		return err
+	}
+	_, err = g.DB.Exec(ctx, "DELETE FROM login_audit WHERE created_at < $1", now.Add(-loginAuditRetention))
+	return err
+}
+
+func accountKey(nip int32) string {
+	return strconv.Itoa(int(nip))
+}
+
+// addressKey is the counter of a client address, which is the trusted one
+// from ClientFromContext. IPv6 clients usually have a whole /64 to pick
+// addresses from, so they are counted by it.
+func addressKey(ip string) string {
+	addr := net.ParseIP(ip)
+	if addr == nil || addr.To4() != nil {
+		return ip
+	}
+	return (&net.IPNet{IP: addr.Mask(net.CIDRMask(64, 128)), Mask: net.CIDRMask(64, 128)}).String()
+}
@@ -0,0 +1,294 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"sort"
+
+	"asset-management-api/app/config"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// minRSABits is the smallest RSA key accepted for signing tokens.
+const minRSABits = 2048
+
+// signingMethodEdDSA signs with Ed25519 keys (RFC 8037), which jwt-go does
+// not provide.
+type signingMethodEdDSA struct{}
+
+// SigningMethodEdDSA is registered with jwt-go as "EdDSA".
+var SigningMethodEdDSA jwt.SigningMethod = &signingMethodEdDSA{}
+
+func init() {
+	jwt.RegisterSigningMethod(SigningMethodEdDSA.Alg(), func() jwt.SigningMethod {
+		return SigningMethodEdDSA
+	})
+}
+
+func (m *signingMethodEdDSA) Alg() string {
+	return "EdDSA"
+}
+
+func (m *signingMethodEdDSA) Sign(signingString string, key interface{}) (string, error) {
+	private, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return "", jwt.ErrInvalidKeyType
+	}
+	return jwt.EncodeSegment(ed25519.Sign(private, []byte(signingString))), nil
+}
+
+func (m *signingMethodEdDSA) Verify(signingString, signature string, key interface{}) error {
+	public, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return jwt.ErrInvalidKeyType
+	}
+	sig, err := jwt.DecodeSegment(signature)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(public, []byte(signingString), sig) {
+		return jwt.ErrSignatureInvalid
+	}
+	return nil
+}
+
+// tokenKey is one key tokens are signed or verified with. Each key is pinned
+// to a single algorithm.
+type tokenKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+	// secret is set instead of the key pair for the HS256 secret
+	secret []byte
+}
+
+func (k *tokenKey) verificationKey() interface{} {
+	if k.secret != nil {
+		return k.secret
+	}
+	return k.public
+}
+
+// KeyManager signs tokens with the current key and verifies them with any of
+// the configured keys, found by the token's kid header. During a rotation the
+// previous key stays in JWT_VERIFICATION_KEYS until the tokens it signed have
+// expired.
+//
+// Asymmetric keys are identified by their RFC 7638 thumbprint and published
+// by JWKS. The HS256 JWT_SECRET, kept for deployments without key files and
+// for tokens issued before them, signs without a kid and is never published.
+type KeyManager struct {
+	signing *tokenKey
+	keys    map[string]*tokenKey
+}
+
+// NewKeyManager loads the keys named in cfg. JWTSigningKey, if set, signs;
+// otherwise JWTSecret does.
+func NewKeyManager(cfg config.Auth) (*KeyManager, error) {
+	m := &KeyManager{keys: map[string]*tokenKey{}}
+	if cfg.JWTSecret != "" {
+		m.signing = &tokenKey{method: jwt.SigningMethodHS256, secret: []byte(cfg.JWTSecret)}
+		m.keys[""] = m.signing
+	}
+	for _, path := range cfg.JWTVerificationKeys {
+		key, err := loadTokenKey(path)
+		if err != nil {
+			return nil, err
+		}
+		m.keys[key.id] = key
+	}
+	if cfg.JWTSigningKey != "" {
+		key, err := loadTokenKey(cfg.JWTSigningKey)
+		if err != nil {
+			return nil, err
+		}
+		if key.private == nil {
+			return nil, fmt.Errorf("%s: signing key must be a private key", cfg.JWTSigningKey)
+		}
+		m.signing = key
+		m.keys[key.id] = key
+	}
+	if m.signing == nil {
+		return nil, errors.New("no JWT signing key or secret configured")
+	}
+	return m, nil
+}
+
+// Sign returns claims signed with the current key.
+func (m *KeyManager) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(m.signing.method, claims)
+	if m.signing.id != "" {
+		token.Header["kid"] = m.signing.id
+	}
+	if m.signing.secret != nil {
+		return token.SignedString(m.signing.secret)
+	}
+	return token.SignedString(m.signing.private)
+}
+
+// Parse verifies a token with the key its kid names, accepting only that
+// key's algorithm, and returns its claims.
+func (m *KeyManager) Parse(tokenString string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key %q", kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method %v for key %q", token.Header["alg"], kid)
+		}
+		return key.verificationKey(), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// JWK is a public key in JSON Web Key format.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS returns the public keys tokens may be verified with, the signing key
+// first.
+func (m *KeyManager) JWKS() []JWK {
+	var others []JWK
+	for _, key := range m.keys {
+		if key.secret == nil && key != m.signing {
+			others = append(others, key.jwk())
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i].Kid < others[j].Kid })
+
+	jwks := []JWK{}
+	if m.signing.secret == nil {
+		jwks = append(jwks, m.signing.jwk())
+	}
+	return append(jwks, others...)
+}
+
+// JWKSHandler serves JWKS as a JSON Web Key Set, for other services to
+// verify our tokens with.
+func (m *KeyManager) JWKSHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// Clients refetch on an unknown kid, so a short cache is enough
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		json.NewEncoder(w).Encode(map[string][]JWK{"keys": m.JWKS()})
+	}
+}
+
+func (k *tokenKey) jwk() JWK {
+	jwk := JWK{Kid: k.id, Use: "sig", Alg: k.method.Alg()}
+	switch public := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+	return jwk
+}
+
+// thumbprint is the RFC 7638 thumbprint of the key, used as its kid.
+func (k *tokenKey) thumbprint() string {
+	jwk := k.jwk()
+	// The required members in lexicographic order
+	var members interface{}
+	if jwk.Kty == "RSA" {
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	} else {
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	}
+	data, _ := json.Marshal(members)
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// loadTokenKey reads an RSA or Ed25519 key, private or public, from a PEM
+// file.
+func loadTokenKey(path string) (*tokenKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM data", path)
+	}
+
+	var parsed interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%s: unsupported PEM block %q", path, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	key := &tokenKey{}
+	switch parsed := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.method, key.private, key.public = jwt.SigningMethodRS256, parsed, &parsed.PublicKey
+	case *rsa.PublicKey:
+		key.method, key.public = jwt.SigningMethodRS256, parsed
+	case ed25519.PrivateKey:
+		key.method, key.private, key.public = SigningMethodEdDSA, parsed, parsed.Public()
+	case ed25519.PublicKey:
+		key.method, key.public = SigningMethodEdDSA, parsed
+	default:
+		return nil, fmt.Errorf("%s: only RSA and Ed25519 keys are supported", path)
+	}
+	if public, ok := key.public.(*rsa.PublicKey); ok && public.N.BitLen() < minRSABits {
+		return nil, fmt.Errorf("%s: RSA keys need at least %d bits", path, minRSABits)
+	}
+	key.id = key.thumbprint()
+	return key, nil
+}
@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"asset-management-api/app/config"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// testKeys is a key manager that signs with an Ed25519 key and still accepts
+// tokens of the previous RSA key and of the HS256 secret.
+type testKeys struct {
+	manager   *KeyManager
+	ed        ed25519.PrivateKey
+	edKid     string
+	rsa       *rsa.PrivateKey
+	rsaKid    string
+	rsaPublic []byte
+}
+
+// writePEM writes block to a file in dir and returns its path.
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func newTestKeys(t *testing.T) *testKeys {
+	t.Helper()
+	dir := t.TempDir()
+	k := &testKeys{}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.ed = edKey
+
+	k.rsa, err = rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only the public half of the previous key is left on the server
+	rsaDER, err := x509.MarshalPKIXPublicKey(&k.rsa.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.rsaPublic = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: rsaDER})
+
+	k.manager, err = NewKeyManager(config.Auth{
+		JWTSecret:           "secret",
+		JWTSigningKey:       writePEM(t, dir, "signing.pem", "PRIVATE KEY", edDER),
+		JWTVerificationKeys: []string{writePEM(t, dir, "previous.pem", "PUBLIC KEY", rsaDER)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.edKid = k.manager.signing.id
+	for kid, key := range k.manager.keys {
+		if key.method == jwt.SigningMethodRS256 {
+			k.rsaKid = kid
+		}
+	}
+	return k
+}
+
+func testClaims() jwt.MapClaims {
+	return jwt.MapClaims{"sub": "1001", "exp": time.Now().Add(time.Hour).Unix()}
+}
+
+// signWith signs testClaims with method and key under kid; an empty kid is
+// left out of the header.
+func signWith(t *testing.T, method jwt.SigningMethod, kid string, key interface{}) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, testClaims())
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestKeyManagerParse(t *testing.T) {
+	k := newTestKeys(t)
+	current, err := k.manager.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"current key", current},
+		{"previous key", signWith(t, jwt.SigningMethodRS256, k.rsaKid, k.rsa)},
+		{"secret", signWith(t, jwt.SigningMethodHS256, "", []byte("secret"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := k.manager.Parse(tt.token)
+			if err != nil || claims["sub"] != "1001" {
+				t.Errorf("Parse() = %v, %v", claims, err)
+			}
+		})
+	}
+}
+
+func TestKeyManagerParseRejects(t *testing.T) {
+	k := newTestKeys(t)
+	_, otherEd, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := signWith(t, SigningMethodEdDSA, k.edKid, k.ed)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"alg none without kid", signWith(t, jwt.SigningMethodNone, "", jwt.UnsafeAllowNoneSignatureType)},
+		{"alg none with a kid", signWith(t, jwt.SigningMethodNone, k.edKid, jwt.UnsafeAllowNoneSignatureType)},
+		// The RSA public key is published, so an HMAC over it must not pass
+		{"HS256 with the RSA public key as secret", signWith(t, jwt.SigningMethodHS256, k.rsaKid, k.rsaPublic)},
+		{"HS256 with the RSA public key, no kid", signWith(t, jwt.SigningMethodHS256, "", k.rsaPublic)},
+		{"unknown kid", signWith(t, SigningMethodEdDSA, "unknown", k.ed)},
+		{"RS256 under the Ed25519 kid", signWith(t, jwt.SigningMethodRS256, k.edKid, k.rsa)},
+		{"EdDSA under the RSA kid", signWith(t, SigningMethodEdDSA, k.rsaKid, k.ed)},
+		{"EdDSA without kid", signWith(t, SigningMethodEdDSA, "", k.ed)},
+		{"RS256 without kid", signWith(t, jwt.SigningMethodRS256, "", k.rsa)},
+		// Same key type, other algorithm: only the pin rejects these
+		{"PS256 under the RSA kid", signWith(t, jwt.SigningMethodPS256, k.rsaKid, k.rsa)},
+		{"HS512 with the secret", signWith(t, jwt.SigningMethodHS512, "", []byte("secret"))},
+		{"EdDSA signed by another key", signWith(t, SigningMethodEdDSA, k.edKid, otherEd)},
+		{"EdDSA with a changed signature", valid[:len(valid)-4] + "AAAA"},
+		{"wrong secret", signWith(t, jwt.SigningMethodHS256, "", []byte("guess"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if claims, err := k.manager.Parse(tt.token); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Parse() = %v, %v, want ErrInvalidToken", claims, err)
+			}
+		})
+	}
+}
+
+func TestSigningMethodEdDSA(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := SigningMethodEdDSA.Sign("header.payload", private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SigningMethodEdDSA.Verify("header.payload", signature, public); err != nil {
+		t.Errorf("Verify() = %v", err)
+	}
+	if err := SigningMethodEdDSA.Verify("header.other", signature, public); err != jwt.ErrSignatureInvalid {
+		t.Errorf("Verify() of other data = %v, want ErrSignatureInvalid", err)
+	}
+	if _, err := SigningMethodEdDSA.Sign("header.payload", []byte("secret")); err != jwt.ErrInvalidKeyType {
+		t.Errorf("Sign() with a secret = %v, want ErrInvalidKeyType", err)
+	}
+	if err := SigningMethodEdDSA.Verify("header.payload", signature, []byte("secret")); err != jwt.ErrInvalidKeyType {
+		t.Errorf("Verify() with a secret = %v, want ErrInvalidKeyType", err)
+	}
+}
+
+// TestJWKSRoundTrip verifies tokens with the keys as another service would
+// rebuild them from the published set.
+func TestJWKSRoundTrip(t *testing.T) {
+	k := newTestKeys(t)
+	rec := httptest.NewRecorder()
+	k.manager.JWKSHandler()(rec, httptest.NewRequest("GET", "/.well-known/jwks.json", nil))
+	var set struct {
+		Keys []JWK `json:"keys"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&set); err != nil {
+		t.Fatal(err)
+	}
+	// The HS256 secret is never published
+	if len(set.Keys) != 2 || set.Keys[0].Kid != k.edKid || set.Keys[1].Kid != k.rsaKid {
+		t.Fatalf("keys = %+v, want the Ed25519 key then the RSA key", set.Keys)
+	}
+
+	decode := func(s string) []byte {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	published := map[string]*tokenKey{}
+	for _, jwk := range set.Keys {
+		key := &tokenKey{}
+		switch jwk.Kty {
+		case "OKP":
+			key.method, key.public = SigningMethodEdDSA, ed25519.PublicKey(decode(jwk.X))
+		case "RSA":
+			key.method = jwt.SigningMethodRS256
+			key.public = &rsa.PublicKey{N: new(big.Int).SetBytes(decode(jwk.N)), E: int(new(big.Int).SetBytes(decode(jwk.E)).Int64())}
+		}
+		if jwk.Use != "sig" || jwk.Alg != key.method.Alg() {
+			t.Errorf("key %s: use %q, alg %q", jwk.Kid, jwk.Use, jwk.Alg)
+		}
+		if key.thumbprint() != jwk.Kid {
+			t.Errorf("key %s: thumbprint of the published key is %s", jwk.Kid, key.thumbprint())
+		}
+		published[jwk.Kid] = key
+	}
+
+	current, err := k.manager.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, signed := range []string{current, signWith(t, jwt.SigningMethodRS256, k.rsaKid, k.rsa)} {
+		token, err := jwt.Parse(signed, func(token *jwt.Token) (interface{}, error) {
+			key := published[token.Header["kid"].(string)]
+			if key == nil || key.method.Alg() != token.Method.Alg() {
+				return nil, errors.New("no published key")
+			}
+			return key.public, nil
+		})
+		if err != nil || !token.Valid {
+			t.Errorf("token %s does not verify with the published keys: %v", signed, err)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"asset-management-api/app/config"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// LDAPAuthenticator logs users in by looking up the entry holding their NIP
+// and binding as it with their password.
+type LDAPAuthenticator struct {
+	Config config.LDAP
+	// TLSConfig is used for ldaps:// and StartTLS; nil verifies the server
+	// against the system roots
+	TLSConfig *tls.Config
+}
+
+func NewLDAPAuthenticator(cfg config.LDAP) *LDAPAuthenticator {
+	return &LDAPAuthenticator{Config: cfg}
+}
+
+func (a *LDAPAuthenticator) Name() string {
+	return ProviderLDAP
+}
+
+func (a *LDAPAuthenticator) Authenticate(ctx context.Context, creds Credentials) (*Identity, error) {
+	if creds.Nip == 0 {
+		return nil, ErrUnknownUser
+	}
+
+	conn, err := a.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	// Requests in flight fail once the caller gives up
+	defer context.AfterFunc(ctx, func() { conn.Close() })()
+
+	if a.Config.BindDN != "" {
+		if err := conn.Bind(a.Config.BindDN, a.Config.BindPassword); err != nil {
+			return nil, fmt.Errorf("bind as %s: %w", a.Config.BindDN, err)
+		}
+	}
+
+	// A size limit of one more than needed tells an ambiguous NIP apart
+	result, err := conn.Search(ldap.NewSearchRequest(
+		a.Config.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false,
+		fmt.Sprintf("(%s=%s)", ldap.EscapeFilter(a.Config.NIPAttribute), strconv.Itoa(int(creds.Nip))),
+		[]string{a.Config.NameAttribute, a.Config.EmailAttribute, a.Config.GroupAttribute},
+		nil,
+	))
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) || ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+		return nil, ErrUnknownUser
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Entries) != 1 {
+		return nil, ErrUnknownUser
+	}
+	entry := result.Entries[0]
+
+	// An empty password would be an unauthenticated bind, which servers
+	// accept for any DN
+	if creds.Password == "" {
+		return nil, ErrBadCredentials
+	}
+	if err := conn.Bind(entry.DN, creds.Password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrBadCredentials
+		}
+		return nil, err
+	}
+
+	return &Identity{
+		Provider: ProviderLDAP,
+		Nip:      creds.Nip,
+		Name:     entry.GetAttributeValue(a.Config.NameAttribute),
+		Email:    entry.GetAttributeValue(a.Config.EmailAttribute),
+		Groups:   entry.GetAttributeValues(a.Config.GroupAttribute),
+	}, nil
+}
+
+// dial connects to Config.URL, encrypted with ldaps:// or StartTLS. Every
+// request gives up after Config.Timeout.
+func (a *LDAPAuthenticator) dial() (*ldap.Conn, error) {
+	u, err := url.Parse(a.Config.URL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "ldap" && u.Scheme != "ldaps" {
+		return nil, fmt.Errorf("unsupported LDAP URL scheme %q", u.Scheme)
+	}
+	tlsConfig := a.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	tlsConfig = tlsConfig.Clone()
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = u.Hostname()
+	}
+
+	conn, err := ldap.DialURL(a.Config.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: a.Config.Timeout}),
+		ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(a.Config.Timeout)
+
+	if u.Scheme == "ldap" && a.Config.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("start TLS: %w", err)
+		}
+	}
+	return conn, nil
+}
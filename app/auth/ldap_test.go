@@ -0,0 +1,414 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"asset-management-api/app/config"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+)
+
+// ldapMessage encodes an LDAPMessage with id carrying op.
+func ldapMessage(id int64, op *ber.Packet) []byte {
+	message := ber.NewSequence("LDAPMessage")
+	message.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "messageID"))
+	message.AppendChild(op)
+	return message.Bytes()
+}
+
+// ldapResult encodes an LDAPResult of application type op.
+func ldapResult(op ber.Tag, code int64, message string) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, op, nil, "")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "resultCode"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, message, "diagnosticMessage"))
+	return result
+}
+
+// ldapStub is a local LDAP server holding a few entries. It answers simple
+// binds, equality searches and StartTLS.
+type ldapStub struct {
+	addr string
+	ldapStubOptions
+	entries []ldapStubEntry
+
+	mu sync.Mutex
+	// binds lists the DNs bound as, and whether the connection was
+	// encrypted at the time
+	binds []ldapStubBind
+}
+
+type ldapStubEntry struct {
+	dn         string
+	password   string
+	attributes map[string][]string
+}
+
+type ldapStubBind struct {
+	dn        string
+	encrypted bool
+}
+
+type ldapStubOptions struct {
+	// implicitTLS serves ldaps://
+	implicitTLS *tls.Config
+	// startTLS enables StartTLS
+	startTLS *tls.Config
+	// referral is sent as a search reference before the entries
+	referral string
+	// ignoreSizeLimit returns every matching entry
+	ignoreSizeLimit bool
+	// searchReply, if set, is written instead of answering searches
+	searchReply []byte
+}
+
+// newLDAPStub starts a stub serving entries.
+func newLDAPStub(t *testing.T, opts ldapStubOptions, entries ...ldapStubEntry) *ldapStub {
+	t.Helper()
+	var ln net.Listener
+	var err error
+	if opts.implicitTLS != nil {
+		ln, err = tls.Listen("tcp", "127.0.0.1:0", opts.implicitTLS)
+	} else {
+		ln, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	s := &ldapStub{addr: ln.Addr().String(), ldapStubOptions: opts, entries: entries}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn, opts.implicitTLS != nil)
+		}
+	}()
+	return s
+}
+
+func (s *ldapStub) bound() []ldapStubBind {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]ldapStubBind(nil), s.binds...)
+}
+
+func (s *ldapStub) serve(conn net.Conn, encrypted bool) {
+	defer func() { conn.Close() }()
+	for {
+		message, err := ber.ReadPacket(conn)
+		if err != nil || len(message.Children) < 2 {
+			return
+		}
+		id, _ := message.Children[0].Value.(int64)
+		op := message.Children[1]
+		fields := op.Children
+
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			dn, password := fields[1].Value.(string), fields[2].Data.String()
+			s.mu.Lock()
+			s.binds = append(s.binds, ldapStubBind{dn: dn, encrypted: encrypted})
+			s.mu.Unlock()
+			code := int64(ldap.LDAPResultInvalidCredentials)
+			for _, entry := range s.entries {
+				if entry.dn == dn && entry.password == password {
+					code = ldap.LDAPResultSuccess
+				}
+			}
+			conn.Write(ldapMessage(id, ldapResult(ldap.ApplicationBindResponse, code, "")))
+
+		case ldap.ApplicationExtendedRequest:
+			if s.startTLS == nil || fields[0].Data.String() != "1.3.6.1.4.1.1466.20037" {
+				conn.Write(ldapMessage(id, ldapResult(ldap.ApplicationExtendedResponse, ldap.LDAPResultProtocolError, "unsupported")))
+				continue
+			}
+			conn.Write(ldapMessage(id, ldapResult(ldap.ApplicationExtendedResponse, ldap.LDAPResultSuccess, "")))
+			tlsConn := tls.Server(conn, s.startTLS)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn, encrypted = tlsConn, true
+
+		case ldap.ApplicationSearchRequest:
+			if s.searchReply != nil {
+				conn.Write(s.searchReply)
+				return
+			}
+			base, limit := fields[0].Value.(string), fields[3].Value.(int64)
+			attribute, value := fields[6].Children[0].Value.(string), fields[6].Children[1].Value.(string)
+
+			if s.referral != "" {
+				reference := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultReference, nil, "")
+				reference.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, s.referral, "URI"))
+				conn.Write(ldapMessage(id, reference))
+			}
+			code, sent := int64(ldap.LDAPResultSuccess), int64(0)
+			for _, entry := range s.entries {
+				if !strings.HasSuffix(entry.dn, base) || !contains(entry.attributes[attribute], value) {
+					continue
+				}
+				if limit > 0 && sent == limit && !s.ignoreSizeLimit {
+					code = ldap.LDAPResultSizeLimitExceeded
+					break
+				}
+				result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "")
+				result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.dn, "objectName"))
+				attributes := ber.NewSequence("attributes")
+				for _, requested := range fields[7].Children {
+					name := requested.Value.(string)
+					if len(entry.attributes[name]) == 0 {
+						continue
+					}
+					attr := ber.NewSequence("attribute")
+					attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+					values := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+					for _, v := range entry.attributes[name] {
+						values.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "value"))
+					}
+					attr.AppendChild(values)
+					attributes.AppendChild(attr)
+				}
+				result.AppendChild(attributes)
+				conn.Write(ldapMessage(id, result))
+				sent++
+			}
+			conn.Write(ldapMessage(id, ldapResult(ldap.ApplicationSearchResultDone, code, "")))
+
+		case ldap.ApplicationUnbindRequest:
+			return
+		}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+var ldapTestEntries = []ldapStubEntry{
+	{dn: "cn=reader,dc=example,dc=com", password: "reader-secret"},
+	{
+		dn:       "uid=budi,ou=people,dc=example,dc=com",
+		password: "budi-secret",
+		attributes: map[string][]string{
+			"employeeNumber": {"1001"},
+			"cn":             {"Budi Santoso"},
+			"mail":           {"budi@example.com"},
+			"memberOf":       {"cn=asset-admins,ou=groups,dc=example,dc=com", "cn=staff,ou=groups,dc=example,dc=com"},
+		},
+	},
+	// Two entries claiming the same NIP
+	{dn: "uid=ani,ou=people,dc=example,dc=com", password: "x", attributes: map[string][]string{"employeeNumber": {"2002"}}},
+	{dn: "uid=ani2,ou=people,dc=example,dc=com", password: "x", attributes: map[string][]string{"employeeNumber": {"2002"}}},
+}
+
+func ldapTestConfig(url string) config.LDAP {
+	return config.LDAP{
+		URL:            url,
+		BindDN:         "cn=reader,dc=example,dc=com",
+		BindPassword:   "reader-secret",
+		BaseDN:         "ou=people,dc=example,dc=com",
+		NIPAttribute:   "employeeNumber",
+		NameAttribute:  "cn",
+		EmailAttribute: "mail",
+		GroupAttribute: "memberOf",
+		Timeout:        5 * time.Second,
+	}
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	stub := newLDAPStub(t, ldapStubOptions{}, ldapTestEntries...)
+	a := NewLDAPAuthenticator(ldapTestConfig("ldap://" + stub.addr))
+
+	identity, err := a.Authenticate(context.Background(), Credentials{Nip: 1001, Password: "budi-secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Identity{
+		Provider: ProviderLDAP,
+		Nip:      1001,
+		Name:     "Budi Santoso",
+		Email:    "budi@example.com",
+		Groups:   []string{"cn=asset-admins,ou=groups,dc=example,dc=com", "cn=staff,ou=groups,dc=example,dc=com"},
+	}
+	if !reflect.DeepEqual(identity, want) {
+		t.Errorf("identity = %+v, want %+v", identity, want)
+	}
+
+	binds := stub.bound()
+	if len(binds) != 2 || binds[0].dn != "cn=reader,dc=example,dc=com" || binds[1].dn != "uid=budi,ou=people,dc=example,dc=com" {
+		t.Errorf("binds = %+v, want the reader then the user", binds)
+	}
+}
+
+func TestLDAPAuthenticateFailures(t *testing.T) {
+	stub := newLDAPStub(t, ldapStubOptions{}, ldapTestEntries...)
+	tests := []struct {
+		name    string
+		creds   Credentials
+		config  func(*config.LDAP)
+		wantErr error
+	}{
+		{"wrong password", Credentials{Nip: 1001, Password: "wrong"}, nil, ErrBadCredentials},
+		{"empty password", Credentials{Nip: 1001}, nil, ErrBadCredentials},
+		{"unknown NIP", Credentials{Nip: 9999, Password: "x"}, nil, ErrUnknownUser},
+		{"ambiguous NIP", Credentials{Nip: 2002, Password: "x"}, nil, ErrUnknownUser},
+		{"no NIP", Credentials{Password: "x"}, nil, ErrUnknownUser},
+		{"outside the base DN", Credentials{Nip: 1001, Password: "budi-secret"},
+			func(c *config.LDAP) { c.BaseDN = "ou=contractors,dc=example,dc=com" }, ErrUnknownUser},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := ldapTestConfig("ldap://" + stub.addr)
+			if tt.config != nil {
+				tt.config(&cfg)
+			}
+			_, err := NewLDAPAuthenticator(cfg).Authenticate(context.Background(), tt.creds)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// A broken service account is a configuration error, not the user's
+	cfg := ldapTestConfig("ldap://" + stub.addr)
+	cfg.BindPassword = "wrong"
+	_, err := NewLDAPAuthenticator(cfg).Authenticate(context.Background(), Credentials{Nip: 1001, Password: "budi-secret"})
+	if err == nil || errors.Is(err, ErrBadCredentials) || errors.Is(err, ErrUnknownUser) {
+		t.Errorf("service bind failure: err = %v", err)
+	}
+}
+
+// testTLS returns a server certificate for 127.0.0.1 and a client config
+// trusting it.
+func testTLS(t *testing.T) (server, client *tls.Config) {
+	t.Helper()
+	ts := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(ts.Close)
+	return &tls.Config{Certificates: ts.TLS.Certificates}, ts.Client().Transport.(*http.Transport).TLSClientConfig
+}
+
+func TestLDAPStartTLS(t *testing.T) {
+	serverTLS, clientTLS := testTLS(t)
+	stub := newLDAPStub(t, ldapStubOptions{startTLS: serverTLS}, ldapTestEntries...)
+
+	cfg := ldapTestConfig("ldap://" + stub.addr)
+	cfg.StartTLS = true
+	a := &LDAPAuthenticator{Config: cfg, TLSConfig: clientTLS}
+	if _, err := a.Authenticate(context.Background(), Credentials{Nip: 1001, Password: "budi-secret"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, bind := range stub.bound() {
+		if !bind.encrypted {
+			t.Errorf("bind as %s was not encrypted", bind.dn)
+		}
+	}
+
+	// An untrusted certificate stops the login before any password is sent
+	before := len(stub.bound())
+	if _, err := NewLDAPAuthenticator(cfg).Authenticate(context.Background(), Credentials{Nip: 1001, Password: "budi-secret"}); err == nil {
+		t.Error("expected an error for an untrusted certificate")
+	}
+	if len(stub.bound()) != before {
+		t.Error("bound after a failed StartTLS")
+	}
+
+	// A server without StartTLS
+	plain := newLDAPStub(t, ldapStubOptions{}, ldapTestEntries...)
+	a.Config.URL = "ldap://" + plain.addr
+	if _, err := a.Authenticate(context.Background(), Credentials{Nip: 1001, Password: "budi-secret"}); err == nil {
+		t.Error("expected an error when the server refuses StartTLS")
+	}
+	if len(plain.bound()) != 0 {
+		t.Error("bound after the server refused StartTLS")
+	}
+}
+
+func TestLDAPS(t *testing.T) {
+	serverTLS, clientTLS := testTLS(t)
+	stub := newLDAPStub(t, ldapStubOptions{implicitTLS: serverTLS}, ldapTestEntries...)
+
+	a := &LDAPAuthenticator{Config: ldapTestConfig("ldaps://" + stub.addr), TLSConfig: clientTLS}
+	if _, err := a.Authenticate(context.Background(), Credentials{Nip: 1001, Password: "budi-secret"}); err != nil {
+		t.Fatal(err)
+	}
+	if binds := stub.bound(); len(binds) != 2 || !binds[1].encrypted {
+		t.Errorf("binds = %+v", binds)
+	}
+}
+
+func TestLDAPSearchResults(t *testing.T) {
+	// A referral to another directory is not followed; the entry found here
+	// still logs the user in
+	referral := newLDAPStub(t, ldapStubOptions{referral: "ldap://other.example.com/ou=people,dc=example,dc=com"}, ldapTestEntries...)
+	a := NewLDAPAuthenticator(ldapTestConfig("ldap://" + referral.addr))
+	if identity, err := a.Authenticate(context.Background(), Credentials{Nip: 1001, Password: "budi-secret"}); err != nil || identity.Name != "Budi Santoso" {
+		t.Errorf("with a referral: identity = %+v, err = %v", identity, err)
+	}
+	if _, err := a.Authenticate(context.Background(), Credentials{Nip: 9999, Password: "x"}); !errors.Is(err, ErrUnknownUser) {
+		t.Errorf("only a referral: err = %v, want ErrUnknownUser", err)
+	}
+
+	// A server that ignores the size limit still cannot pick an entry
+	unlimited := newLDAPStub(t, ldapStubOptions{ignoreSizeLimit: true}, ldapTestEntries...)
+	a = NewLDAPAuthenticator(ldapTestConfig("ldap://" + unlimited.addr))
+	if _, err := a.Authenticate(context.Background(), Credentials{Nip: 2002, Password: "x"}); !errors.Is(err, ErrUnknownUser) {
+		t.Errorf("several entries: err = %v, want ErrUnknownUser", err)
+	}
+	for _, bind := range unlimited.bound() {
+		if bind.dn != "cn=reader,dc=example,dc=com" {
+			t.Errorf("bound as %s with several matching entries", bind.dn)
+		}
+	}
+}
+
+func TestLDAPBrokenResponses(t *testing.T) {
+	entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "")
+	entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "uid=budi,ou=people,dc=example,dc=com", "objectName"))
+	entry.AppendChild(ber.NewSequence("attributes"))
+	// The message id of the search, after the service account's bind
+	complete := ldapMessage(2, entry)
+
+	tests := []struct {
+		name  string
+		reply []byte
+	}{
+		{"truncated entry", complete[:len(complete)-5]},
+		{"length past the end", []byte{0x30, 0x84, 0x7f, 0xff, 0xff, 0xff, 0x02, 0x01, 0x02}},
+		{"not a sequence", []byte{0x04, 0x03, 'a', 'b', 'c'}},
+		{"entry without a result", complete},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newLDAPStub(t, ldapStubOptions{searchReply: tt.reply}, ldapTestEntries...)
+			cfg := ldapTestConfig("ldap://" + stub.addr)
+			cfg.Timeout = time.Second
+			_, err := NewLDAPAuthenticator(cfg).Authenticate(context.Background(), Credentials{Nip: 1001, Password: "budi-secret"})
+			if err == nil || errors.Is(err, ErrBadCredentials) || errors.Is(err, ErrUnknownUser) {
+				t.Errorf("err = %v, want a directory error", err)
+			}
+			if binds := stub.bound(); len(binds) != 1 {
+				t.Errorf("binds = %+v, want only the service account", binds)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"asset-management-api/app/config"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/oauth2"
+)
+
+// oidcStateTTL is how long a user has to log in at the provider.
+const oidcStateTTL = 10 * time.Minute
+
+// OIDC logs users in through an OpenID Connect provider with the
+// authorization code flow and PKCE. The pending logins' state, nonce and
+// code verifier are kept in the database, so any instance can complete them.
+type OIDC struct {
+	DB     querier
+	Config config.OIDC
+	Client *http.Client
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewOIDC(db *pgxpool.Pool, cfg config.OIDC) *OIDC {
+	return &OIDC{DB: db, Config: cfg, Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (o *OIDC) Name() string {
+	return ProviderOIDC
+}
+
+// AuthorizationURL starts a login and returns the provider page to send the
+// user to. The provider redirects back to Config.RedirectURL with the code and
+// state for Authenticate.
+func (o *OIDC) AuthorizationURL(ctx context.Context) (string, error) {
+	provider, _, err := o.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := randomHex(16)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := randomHex(16)
+	if err != nil {
+		return "", err
+	}
+	verifier := oauth2.GenerateVerifier()
+	_, err = o.DB.Exec(ctx, `
+        INSERT INTO oidc_states (state, nonce, code_verifier, expires_at)
+        VALUES ($1, $2, $3, $4)`, state, nonce, verifier, time.Now().Add(oidcStateTTL))
+	if err != nil {
+		return "", err
+	}
+
+	return o.oauth2Config(provider).AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// Authenticate completes a login started by AuthorizationURL: it redeems the
+// code and returns the identity in the verified ID token. Each state works
+// once.
+func (o *OIDC) Authenticate(ctx context.Context, creds Credentials) (*Identity, error) {
+	if creds.Code == "" || creds.State == "" {
+		return nil, ErrUnknownUser
+	}
+
+	var nonce, verifier string
+	err := o.DB.QueryRow(ctx, `
+        DELETE FROM oidc_states WHERE state = $1 AND expires_at > NOW()
+        RETURNING nonce, code_verifier`, creds.State).Scan(&nonce, &verifier)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrBadCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	provider, idTokenVerifier, err := o.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = oidc.ClientContext(ctx, o.Client)
+	token, err := o.oauth2Config(provider).Exchange(ctx, creds.Code, oauth2.VerifierOption(verifier))
+	var retrieveErr *oauth2.RetrieveError
+	// An invalid, expired or reused code
+	if errors.As(err, &retrieveErr) && retrieveErr.ErrorCode == "invalid_grant" {
+		return nil, ErrBadCredentials
+	}
+	if err != nil {
+		return nil, fmt.Errorf("token endpoint: %w", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("token endpoint: no ID token")
+	}
+
+	// Checks the signature, issuer, audience and expiry
+	idToken, err := idTokenVerifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, ErrBadCredentials
+	}
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+
+	identity := &Identity{Provider: ProviderOIDC, Groups: stringsClaim(claims[o.Config.GroupsClaim])}
+	identity.Name, _ = claims["name"].(string)
+	// Unverified addresses cannot be trusted to match local users by, and
+	// neither can those of providers that do not say
+	if verified, ok := claims["email_verified"].(bool); ok && verified {
+		identity.Email, _ = claims["email"].(string)
+	}
+	switch nip := claims[o.Config.NIPClaim].(type) {
+	case float64:
+		identity.Nip = int32(nip)
+	case string:
+		n, _ := strconv.ParseInt(nip, 10, 32)
+		identity.Nip = int32(n)
+	}
+	return identity, nil
+}
+
+func (o *OIDC) oauth2Config(provider *oidc.Provider) *oauth2.Config {
+	endpoint := provider.Endpoint()
+	// client_secret_basic is the OIDC default; auto-detection would retry a
+	// refused code a second time, masking the provider's error
+	endpoint.AuthStyle = oauth2.AuthStyleInHeader
+	return &oauth2.Config{
+		ClientID:     o.Config.ClientID,
+		ClientSecret: o.Config.ClientSecret,
+		RedirectURL:  o.Config.RedirectURL,
+		Endpoint:     endpoint,
+		Scopes:       o.Config.Scopes,
+	}
+}
+
+func stringsClaim(claim interface{}) []string {
+	switch claim := claim.(type) {
+	case string:
+		return []string{claim}
+	case []interface{}:
+		var values []string
+		for _, v := range claim {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// discover fetches the provider's discovery document once, and makes sure
+// it is for Config.Issuer. The provider's keys are fetched when first needed
+// and again for an unknown key ID, e.g. after the provider rotated them.
+func (o *OIDC) discover(ctx context.Context) (*oidc.Provider, *oidc.IDTokenVerifier, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.provider != nil {
+		return o.provider, o.verifier, nil
+	}
+
+	provider, err := oidc.NewProvider(oidc.ClientContext(ctx, o.Client), o.Config.Issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("discover %s: %w", o.Config.Issuer, err)
+	}
+	o.provider = provider
+	o.verifier = provider.Verifier(&oidc.Config{ClientID: o.Config.ClientID})
+	return o.provider, o.verifier, nil
+}
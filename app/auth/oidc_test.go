@@ -0,0 +1,456 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"asset-management-api/app/config"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	testClientID     = "asset-app"
+	testClientSecret = "client-secret"
+	testRedirectURL  = "https://assets.example.com/login/callback"
+)
+
+// fakeIssuer is an OpenID provider serving discovery, its key set and a
+// token endpoint that checks PKCE. Tests register the ID token each code
+// redeems for.
+type fakeIssuer struct {
+	*httptest.Server
+	t *testing.T
+
+	mu sync.Mutex
+	// keys are the published signing keys
+	keys       map[string]*rsa.PrivateKey
+	signingKid string
+	grants     map[string]oidcGrant
+	keyFetches int
+	// claimedIssuer, when set, is the issuer discovery reports instead
+	claimedIssuer string
+}
+
+type oidcGrant struct {
+	challenge string
+	idToken   string
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	t.Helper()
+	f := &fakeIssuer{t: t, keys: map[string]*rsa.PrivateKey{}, grants: map[string]oidcGrant{}}
+	f.rotate("key-1", false)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		claimedIssuer := f.claimedIssuer
+		f.mu.Unlock()
+		if claimedIssuer == "" {
+			claimedIssuer = f.URL
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 claimedIssuer,
+			"authorization_endpoint": f.URL + "/authorize",
+			"token_endpoint":         f.URL + "/token",
+			"jwks_uri":               f.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.keyFetches++
+		var keys []map[string]string
+		for kid, key := range f.keys {
+			keys = append(keys, map[string]string{
+				"kty": "RSA",
+				"use": "sig",
+				"kid": kid,
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+	})
+	mux.HandleFunc("/token", f.token)
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeIssuer) token(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	id, secret, _ := r.BasicAuth()
+	if id != testClientID || secret != testClientSecret {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+		return
+	}
+	if r.PostFormValue("grant_type") != "authorization_code" || r.PostFormValue("redirect_uri") != testRedirectURL {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	f.mu.Lock()
+	grant, ok := f.grants[r.PostFormValue("code")]
+	delete(f.grants, r.PostFormValue("code"))
+	f.mu.Unlock()
+	verifier := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(verifier[:]) != grant.challenge {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{"access_token": "access", "id_token": grant.idToken, "token_type": "Bearer"})
+}
+
+// rotate adds a signing key and signs with it from now on; keep leaves the
+// previous keys published.
+func (f *fakeIssuer) rotate(kid string, keep bool) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !keep {
+		f.keys = map[string]*rsa.PrivateKey{}
+	}
+	f.keys[kid] = key
+	f.signingKid = kid
+	return key
+}
+
+// sign returns claims signed with the current key.
+func (f *fakeIssuer) sign(claims jwt.MapClaims) string {
+	f.mu.Lock()
+	kid, key := f.signingKid, f.keys[f.signingKid]
+	f.mu.Unlock()
+	return signRS256(f.t, kid, key, claims)
+}
+
+func signRS256(t *testing.T, kid string, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func (f *fakeIssuer) grant(code, challenge, idToken string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.grants[code] = oidcGrant{challenge: challenge, idToken: idToken}
+}
+
+func (f *fakeIssuer) fetches() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.keyFetches
+}
+
+// oidcStates keeps oidc_states rows in memory.
+type oidcStates struct {
+	mu     sync.Mutex
+	states map[string][]string
+}
+
+func (s *oidcStates) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states[args[0].(string)] = []string{args[1].(string), args[2].(string)}
+	return pgconn.NewCommandTag("INSERT 0 1"), nil
+}
+
+// QueryRow deletes and returns the nonce and code verifier of a state.
+func (s *oidcStates) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row := s.states[args[0].(string)]
+	delete(s.states, args[0].(string))
+	return stringRow(row)
+}
+
+type stringRow []string
+
+func (r stringRow) Scan(dest ...interface{}) error {
+	if r == nil {
+		return pgx.ErrNoRows
+	}
+	for i, d := range dest {
+		*d.(*string) = r[i]
+	}
+	return nil
+}
+
+func newTestOIDC(issuer *fakeIssuer) *OIDC {
+	o := NewOIDC(nil, config.OIDC{
+		Issuer:       issuer.URL,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+		Scopes:       []string{"openid", "email", "profile"},
+		NIPClaim:     "nip",
+		GroupsClaim:  "groups",
+	})
+	o.DB = &oidcStates{states: map[string][]string{}}
+	return o
+}
+
+// login runs the authorization code flow. token builds the ID token the
+// provider issues from the default claims, which carry the login's nonce.
+func login(t *testing.T, o *OIDC, issuer *fakeIssuer, token func(claims jwt.MapClaims) string) (*Identity, error) {
+	t.Helper()
+	ctx := context.Background()
+	authorizationURL, err := o.AuthorizationURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(authorizationURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if u.Path != "/authorize" || query.Get("client_id") != testClientID || query.Get("redirect_uri") != testRedirectURL ||
+		query.Get("response_type") != "code" || query.Get("code_challenge_method") != "S256" || query.Get("scope") != "openid email profile" {
+		t.Fatalf("authorization URL %s", authorizationURL)
+	}
+
+	claims := jwt.MapClaims{
+		"iss":            issuer.URL,
+		"aud":            testClientID,
+		"sub":            "budi",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          query.Get("nonce"),
+		"name":           "Budi Santoso",
+		"email":          "budi@example.com",
+		"email_verified": true,
+		"nip":            "1001",
+		"groups":         []interface{}{"asset-admins", "staff"},
+	}
+	if token == nil {
+		token = issuer.sign
+	}
+	code := "code-" + query.Get("state")
+	issuer.grant(code, query.Get("code_challenge"), token(claims))
+	return o.Authenticate(ctx, Credentials{Code: code, State: query.Get("state")})
+}
+
+func TestOIDCAuthenticate(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	o := newTestOIDC(issuer)
+
+	identity, err := login(t, o, issuer, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Identity{
+		Provider: ProviderOIDC,
+		Nip:      1001,
+		Name:     "Budi Santoso",
+		Email:    "budi@example.com",
+		Groups:   []string{"asset-admins", "staff"},
+	}
+	if !reflect.DeepEqual(identity, want) {
+		t.Errorf("identity = %+v, want %+v", identity, want)
+	}
+}
+
+func TestOIDCClaims(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	o := newTestOIDC(issuer)
+	tests := []struct {
+		name      string
+		change    func(jwt.MapClaims)
+		wantEmail string
+		wantNip   int32
+	}{
+		{"numeric NIP", func(c jwt.MapClaims) { c["nip"] = 1001 }, "budi@example.com", 1001},
+		{"no NIP", func(c jwt.MapClaims) { delete(c, "nip") }, "budi@example.com", 0},
+		{"unverified email", func(c jwt.MapClaims) { c["email_verified"] = false }, "", 1001},
+		{"email not said to be verified", func(c jwt.MapClaims) { delete(c, "email_verified") }, "", 1001},
+		{"verified as a string", func(c jwt.MapClaims) { c["email_verified"] = "true" }, "", 1001},
+		{"audience list", func(c jwt.MapClaims) { c["aud"] = []interface{}{"other-app", testClientID} }, "budi@example.com", 1001},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := login(t, o, issuer, func(claims jwt.MapClaims) string {
+				tt.change(claims)
+				return issuer.sign(claims)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if identity.Email != tt.wantEmail || identity.Nip != tt.wantNip {
+				t.Errorf("email %q, NIP %d, want %q, %d", identity.Email, identity.Nip, tt.wantEmail, tt.wantNip)
+			}
+		})
+	}
+}
+
+func TestOIDCRejectsTokens(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	o := newTestOIDC(issuer)
+	rogue, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed := func(change func(jwt.MapClaims)) func(jwt.MapClaims) string {
+		return func(claims jwt.MapClaims) string {
+			change(claims)
+			return issuer.sign(claims)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		token   func(jwt.MapClaims) string
+		wantErr error
+	}{
+		{"wrong nonce", changed(func(c jwt.MapClaims) { c["nonce"] = "replayed" }), ErrBadCredentials},
+		{"no nonce", changed(func(c jwt.MapClaims) { delete(c, "nonce") }), ErrBadCredentials},
+		{"other audience", changed(func(c jwt.MapClaims) { c["aud"] = "other-app" }), nil},
+		{"audience list without the client", changed(func(c jwt.MapClaims) { c["aud"] = []interface{}{"other-app"} }), nil},
+		{"no audience", changed(func(c jwt.MapClaims) { delete(c, "aud") }), nil},
+		{"other issuer", changed(func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }), nil},
+		{"no issuer", changed(func(c jwt.MapClaims) { delete(c, "iss") }), nil},
+		{"expired", changed(func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }), nil},
+		{"no expiry", changed(func(c jwt.MapClaims) { delete(c, "exp") }), nil},
+		{"unknown key", func(c jwt.MapClaims) string { return signRS256(t, "rogue", rogue, c) }, nil},
+		{"key ID of another key", func(c jwt.MapClaims) string { return signRS256(t, "key-1", rogue, c) }, nil},
+		{"HS256", func(c jwt.MapClaims) string {
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
+			token.Header["kid"] = "key-1"
+			signed, _ := token.SignedString([]byte(testClientSecret))
+			return signed
+		}, nil},
+		{"unsigned", func(c jwt.MapClaims) string {
+			signed, _ := jwt.NewWithClaims(jwt.SigningMethodNone, c).SignedString(jwt.UnsafeAllowNoneSignatureType)
+			return signed
+		}, nil},
+		{"no ID token", func(c jwt.MapClaims) string { return "" }, nil},
+		{"tampered", func(c jwt.MapClaims) string {
+			parts := strings.Split(issuer.sign(c), ".")
+			c["nip"] = "1"
+			payload, _ := json.Marshal(c)
+			parts[1] = base64.RawURLEncoding.EncodeToString(payload)
+			return strings.Join(parts, ".")
+		}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := login(t, o, issuer, tt.token)
+			if err == nil || identity != nil {
+				t.Fatalf("accepted: %+v", identity)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOIDCStateAndCode(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	o := newTestOIDC(issuer)
+	ctx := context.Background()
+
+	authorizationURL, err := o.AuthorizationURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(authorizationURL)
+	state := u.Query().Get("state")
+
+	// The provider refuses the code, e.g. because the verifier is wrong
+	issuer.grant("code", "another challenge", "")
+	if _, err := o.Authenticate(ctx, Credentials{Code: "code", State: state}); !errors.Is(err, ErrBadCredentials) {
+		t.Errorf("refused code: err = %v, want ErrBadCredentials", err)
+	}
+	// Each state works once, even after a failure
+	issuer.grant("code", u.Query().Get("code_challenge"), "")
+	if _, err := o.Authenticate(ctx, Credentials{Code: "code", State: state}); !errors.Is(err, ErrBadCredentials) {
+		t.Errorf("reused state: err = %v, want ErrBadCredentials", err)
+	}
+	if _, err := o.Authenticate(ctx, Credentials{Code: "code", State: "unknown"}); !errors.Is(err, ErrBadCredentials) {
+		t.Errorf("unknown state: err = %v, want ErrBadCredentials", err)
+	}
+	if _, err := o.Authenticate(ctx, Credentials{}); !errors.Is(err, ErrUnknownUser) {
+		t.Errorf("no code: err = %v, want ErrUnknownUser", err)
+	}
+}
+
+func TestOIDCKeyRotation(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	o := newTestOIDC(issuer)
+	issuer.mu.Lock()
+	oldKey := issuer.keys["key-1"]
+	issuer.mu.Unlock()
+
+	if _, err := login(t, o, issuer, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := login(t, o, issuer, nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := issuer.fetches(); n != 1 {
+		t.Fatalf("fetched keys %d times, want 1", n)
+	}
+
+	// An unknown key ID fetches the keys again
+	key2 := issuer.rotate("key-2", false)
+	if _, err := login(t, o, issuer, nil); err != nil {
+		t.Fatalf("new key after rotation: %v", err)
+	}
+	if n := issuer.fetches(); n != 2 {
+		t.Errorf("fetched keys %d times, want 2", n)
+	}
+
+	// The retired key is no longer trusted
+	_, err := login(t, o, issuer, func(claims jwt.MapClaims) string { return signRS256(t, "key-1", oldKey, claims) })
+	if err == nil {
+		t.Error("accepted a token of a retired key")
+	}
+
+	// Keys published side by side are both trusted
+	issuer.rotate("key-3", true)
+	if _, err := login(t, o, issuer, nil); err != nil {
+		t.Fatalf("key-3: %v", err)
+	}
+	if _, err := login(t, o, issuer, func(claims jwt.MapClaims) string { return signRS256(t, "key-2", key2, claims) }); err != nil {
+		t.Errorf("key-2 while still published: %v", err)
+	}
+}
+
+func TestOIDCDiscoveryIssuerMismatch(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	o := newTestOIDC(issuer)
+	issuer.mu.Lock()
+	issuer.claimedIssuer = "https://evil.example.com"
+	issuer.mu.Unlock()
+	if _, err := o.AuthorizationURL(context.Background()); err == nil {
+		t.Error("accepted a discovery document of another issuer")
+	}
+}
@@ -0,0 +1,302 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"asset-management-api/app/config"
+	"asset-management-api/app/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrPasswordPolicy means a password was refused by the policy; the error's
+// text tells the user why.
+var ErrPasswordPolicy = errors.New("password does not meet the policy")
+
+type policyError string
+
+func (e policyError) Error() string { return string(e) }
+
+func (e policyError) Is(target error) bool { return target == ErrPasswordPolicy }
+
+// maxPasswordBytes is what bcrypt hashes; longer passwords are refused
+// rather than silently cut.
+const maxPasswordBytes = 72
+
+// classNames describe the password classes in policy errors.
+var classNames = map[string]string{
+	"lower":  "a lowercase letter",
+	"upper":  "an uppercase letter",
+	"letter": "a letter",
+	"digit":  "a digit",
+	"symbol": "a symbol",
+}
+
+// Passwords enforces the password policy on passwords users choose and keeps
+// their previous hashes in password_history to refuse reused ones. Passwords
+// set for a user by someone else, such as an admin, must be changed at the
+// user's next login.
+type Passwords struct {
+	DB        *pgxpool.Pool
+	MinLength int
+	Classes   []string
+	// History is how many of the last passwords cannot be chosen again,
+	// counting the current one
+	History int
+	// Leaked passwords, lowercased or as SHA-1 digests
+	breached       map[string]struct{}
+	breachedHashes map[[sha1.Size]byte]struct{}
+}
+
+func NewPasswords(db *pgxpool.Pool, cfg config.Passwords) (*Passwords, error) {
+	p := &Passwords{
+		DB:             db,
+		MinLength:      cfg.MinLength,
+		Classes:        cfg.Classes,
+		History:        cfg.History,
+		breached:       map[string]struct{}{},
+		breachedHashes: map[[sha1.Size]byte]struct{}{},
+	}
+	if cfg.BreachedList != "" {
+		if err := p.loadBreached(cfg.BreachedList); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// loadBreached reads a list of leaked passwords. Lines of 40 hex digits,
+// optionally followed by ":count", are SHA-1 digests; # starts a comment.
+func (p *Passwords) loadBreached(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("breached password list: %v", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		digest, _, _ := strings.Cut(line, ":")
+		if len(digest) == 2*sha1.Size {
+			var sum [sha1.Size]byte
+			if _, err := hex.Decode(sum[:], []byte(digest)); err == nil {
+				p.breachedHashes[sum] = struct{}{}
+				continue
+			}
+		}
+		p.breached[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("breached password list: %v", err)
+	}
+	return nil
+}
+
+// Validate checks a password against the length, character class and
+// breached list rules.
+func (p *Passwords) Validate(password string) error {
+	if utf8.RuneCountInString(password) < p.MinLength {
+		return policyError(fmt.Sprintf("password must be at least %d characters", p.MinLength))
+	}
+	if len(password) > maxPasswordBytes {
+		return policyError(fmt.Sprintf("password must be at most %d bytes", maxPasswordBytes))
+	}
+
+	present := map[string]bool{}
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			present["lower"], present["letter"] = true, true
+		case unicode.IsUpper(r):
+			present["upper"], present["letter"] = true, true
+		case unicode.IsLetter(r):
+			present["letter"] = true
+		case unicode.IsDigit(r):
+			present["digit"] = true
+		default:
+			present["symbol"] = true
+		}
+	}
+	var missing []string
+	for _, class := range p.Classes {
+		if !present[class] {
+			missing = append(missing, classNames[class])
+		}
+	}
+	if n := len(missing); n > 0 {
+		list := missing[n-1]
+		if n > 1 {
+			list = strings.Join(missing[:n-1], ", ") + " and " + list
+		}
+		return policyError("password must contain " + list)
+	}
+
+	if p.isBreached(password) {
+		return policyError("password appears in a list of leaked passwords, choose another one")
+	}
+	return nil
+}
+
+func (p *Passwords) isBreached(password string) bool {
+	if _, ok := p.breached[strings.ToLower(password)]; ok {
+		return true
+	}
+	_, ok := p.breachedHashes[sha1.Sum([]byte(password))]
+	return ok
+}
+
+// Change replaces the password of nip with one they chose, after checking
+// their current one, and ends their sessions but keepSessionId. A wrong
+// current password gets ErrBadCredentials.
+func (p *Passwords) Change(ctx context.Context, nip int32, keepSessionId, current, password string) error {
+	tx, err := p.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	currentHash, err := lockPassword(ctx, tx, nip, true)
+	if err != nil {
+		return err
+	}
+	if utils.VerifyPassword(currentHash, current) != nil {
+		return ErrBadCredentials
+	}
+	if err := p.replace(ctx, tx, nip, currentHash, password); err != nil {
+		return err
+	}
+	if _, err := revokeUser(ctx, tx, nip, keepSessionId); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// Assign sets a password for nip on their behalf, e.g. by an admin, which
+// they must change at their next login; their sessions end. The password is
+// not checked against the policy.
+func (p *Passwords) Assign(ctx context.Context, nip int32, password string) error {
+	tx, err := p.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	currentHash, err := lockPassword(ctx, tx, nip, false)
+	if err != nil {
+		return err
+	}
+	hash, err := utils.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	if err := p.store(ctx, tx, nip, currentHash, hash, true); err != nil {
+		return err
+	}
+	if _, err := revokeUser(ctx, tx, nip, ""); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// lockPassword returns the password hash of nip and locks the user until tx
+// ends. Unknown and deleted users, and disabled ones when activeOnly, get
+// ErrUnknownUser.
+func lockPassword(ctx context.Context, tx pgx.Tx, nip int32, activeOnly bool) (string, error) {
+	var hash string
+	err := tx.QueryRow(ctx, `
+        SELECT user_password FROM users
+        WHERE nip = $1 AND status <> 'deleted' AND (NOT $2 OR disabled_at IS NULL)
+        FOR UPDATE`, nip, activeOnly).Scan(&hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrUnknownUser
+	}
+	return hash, err
+}
+
+// replace sets a password the user chose once it passed the policy and is
+// not one of their recent ones.
+func (p *Passwords) replace(ctx context.Context, tx pgx.Tx, nip int32, currentHash, password string) error {
+	if err := p.Validate(password); err != nil {
+		return err
+	}
+	reused, err := p.recentlyUsed(ctx, tx, nip, currentHash, password)
+	if err != nil {
+		return err
+	}
+	if reused {
+		return policyError(fmt.Sprintf("password must differ from your last %d passwords", p.History))
+	}
+
+	hash, err := utils.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	return p.store(ctx, tx, nip, currentHash, hash, false)
+}
+
+// recentlyUsed reports whether password is the current one or one of the
+// previous History-1.
+func (p *Passwords) recentlyUsed(ctx context.Context, tx pgx.Tx, nip int32, currentHash, password string) (bool, error) {
+	if p.History <= 0 {
+		return false, nil
+	}
+	hashes := []string{currentHash}
+	rows, err := tx.Query(ctx, `
+        SELECT password_hash FROM password_history
+        WHERE nip = $1 ORDER BY id DESC LIMIT $2`, nip, p.History-1)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return false, err
+		}
+		hashes = append(hashes, hash)
+	}
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+
+	for _, hash := range hashes {
+		if utils.VerifyPassword(hash, password) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// store sets the new hash, moves the current one to the history and drops
+// history entries that are no longer checked.
+func (p *Passwords) store(ctx context.Context, tx pgx.Tx, nip int32, currentHash, hash string, mustChange bool) error {
+	if _, err := tx.Exec(ctx, "INSERT INTO password_history (nip, password_hash) VALUES ($1, $2)", nip, currentHash); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, "UPDATE users SET user_password = $2, must_change_password = $3 WHERE nip = $1", nip, hash, mustChange); err != nil {
+		return err
+	}
+	keep := p.History - 1
+	if keep < 0 {
+		keep = 0
+	}
+	_, err := tx.Exec(ctx, `
+        DELETE FROM password_history
+        WHERE nip = $1 AND id NOT IN (SELECT id FROM password_history WHERE nip = $1 ORDER BY id DESC LIMIT $2)`,
+		nip, keep)
+	return err
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"asset-management-api/app/config"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// externalPassword is stored for users created from a directory. It is no
+// bcrypt hash, so local logins always fail until a password is set.
+const externalPassword = "!"
+
+// Provisioner maps identities from LDAP and OIDC to local users: by NIP, then
+// by email, creating missing users when AutoProvision is on. Roles and outlets
+// mapped from the identity's groups are applied on every login.
+type Provisioner struct {
+	DB            *pgxpool.Pool
+	AutoProvision bool
+	DefaultRoleId int32
+	GroupRoles    []config.GroupMapping
+	GroupOutlets  []config.GroupMapping
+}
+
+func NewProvisioner(db *pgxpool.Pool, cfg config.Auth) (*Provisioner, error) {
+	roles, err := config.ParseGroupMappings(cfg.GroupRoles)
+	if err != nil {
+		return nil, err
+	}
+	outlets, err := config.ParseGroupMappings(cfg.GroupOutlets)
+	if err != nil {
+		return nil, err
+	}
+	return &Provisioner{
+		DB:            db,
+		AutoProvision: cfg.AutoProvision,
+		DefaultRoleId: cfg.DefaultRoleId,
+		GroupRoles:    roles,
+		GroupOutlets:  outlets,
+	}, nil
+}
+
+// Resolve returns the NIP of the local user identity belongs to, or
+// ErrUnknownUser if there is none and none may be created.
+func (p *Provisioner) Resolve(ctx context.Context, identity *Identity) (int32, error) {
+	if identity.Provider == ProviderLocal {
+		return identity.Nip, nil
+	}
+
+	nip, err := p.find(ctx, identity)
+	if errors.Is(err, ErrUnknownUser) && p.AutoProvision && identity.Nip != 0 {
+		return identity.Nip, p.create(ctx, identity)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return nip, p.sync(ctx, nip, identity)
+}
+
+func (p *Provisioner) find(ctx context.Context, identity *Identity) (int32, error) {
+	var nip int32
+	err := pgx.ErrNoRows
+	if identity.Nip != 0 {
+		err = p.DB.QueryRow(ctx, "SELECT nip FROM users WHERE nip = $1", identity.Nip).Scan(&nip)
+	}
+	if errors.Is(err, pgx.ErrNoRows) && identity.Email != "" {
+		err = p.DB.QueryRow(ctx, "SELECT nip FROM users WHERE LOWER(user_email) = LOWER($1) ORDER BY nip LIMIT 1", identity.Email).Scan(&nip)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrUnknownUser
+	}
+	return nip, err
+}
+
+func (p *Provisioner) create(ctx context.Context, identity *Identity) error {
+	roleId, ok := mapGroups(p.GroupRoles, identity.Groups)
+	if !ok {
+		roleId = p.DefaultRoleId
+	}
+	outletId, _ := mapGroups(p.GroupOutlets, identity.Groups)
+
+	name := identity.Name
+	if name == "" {
+		name = identity.Email
+	}
+	_, err := p.DB.Exec(ctx, `
+        INSERT INTO users (nip, user_full_name, user_email, user_password, role_id, area_id, outlet_id)
+        VALUES ($1, $2, NULLIF($3, ''), $4, $5,
+                (SELECT area_id FROM area_outlets WHERE outlet_id = $6), NULLIF($6, 0))`,
+		identity.Nip, name, identity.Email, externalPassword, roleId, outletId)
+	return err
+}
+
+// sync applies the role and outlet mapped from the identity's groups, if any.
+func (p *Provisioner) sync(ctx context.Context, nip int32, identity *Identity) error {
+	roleId, _ := mapGroups(p.GroupRoles, identity.Groups)
+	outletId, _ := mapGroups(p.GroupOutlets, identity.Groups)
+	if roleId == 0 && outletId == 0 {
+		return nil
+	}
+	_, err := p.DB.Exec(ctx, `
+        UPDATE users SET
+            role_id = COALESCE(NULLIF($2, 0), role_id),
+            outlet_id = COALESCE(NULLIF($3, 0), outlet_id),
+            area_id = COALESCE((SELECT area_id FROM area_outlets WHERE outlet_id = $3), area_id)
+        WHERE nip = $1`, nip, roleId, outletId)
+	return err
+}
+
+// mapGroups returns the id of the first mapping matching one of groups.
+func mapGroups(mappings []config.GroupMapping, groups []string) (int32, bool) {
+	for _, mapping := range mappings {
+		for _, group := range groups {
+			if strings.EqualFold(mapping.Group, group) || strings.EqualFold(mapping.Group, groupName(group)) {
+				return mapping.Id, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// groupName returns the value of the first component of an LDAP DN, e.g.
+// asset-admins for cn=asset-admins,ou=groups,dc=example,dc=com, or group
+// itself if it is no DN.
+func groupName(group string) string {
+	first, _, _ := strings.Cut(group, ",")
+	if _, value, ok := strings.Cut(first, "="); ok {
+		return strings.TrimSpace(value)
+	}
+	return group
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrResetThrottled means a reset token was issued to the user moments ago.
+var ErrResetThrottled = errors.New("password reset requested too recently")
+
+// resetInterval is the least time between two reset emails to one user.
+const resetInterval = time.Minute
+
+// IssueResetToken creates a single-use password reset token for nip, valid
+// for ResetTTL. Disabled and unknown users get ErrInvalidToken.
+func (t *TokenIssuer) IssueResetToken(ctx context.Context, nip int32) (string, error) {
+	if _, err := claimsFor(ctx, t.DB, nip); err != nil {
+		return "", err
+	}
+
+	var recent bool
+	err := t.DB.QueryRow(ctx, `
+        SELECT EXISTS(SELECT 1 FROM password_resets
+                      WHERE nip = $1 AND used_at IS NULL AND created_at > $2)`,
+		nip, time.Now().Add(-resetInterval)).Scan(&recent)
+	if err != nil {
+		return "", err
+	}
+	if recent {
+		return "", ErrResetThrottled
+	}
+
+	token, err := randomHex(32)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	_, err = t.DB.Exec(ctx, `
+        INSERT INTO password_resets (token_hash, nip, created_at, expires_at)
+        VALUES ($1, $2, $3, $4)`, hashToken(token), nip, now, now.Add(t.ResetTTL))
+	return token, err
+}
+
+// ResetPassword spends a reset token: the owner's password is replaced by
+// password, their other reset tokens are spent and all their sessions are
+// revoked. It returns the owner. Unknown, used and expired tokens get
+// ErrInvalidToken; a password refused by passwords gets an
+// ErrPasswordPolicy error and leaves the token unspent.
+func (t *TokenIssuer) ResetPassword(ctx context.Context, token, password string, passwords *Passwords) (int32, error) {
+	tx, err := t.DB.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var nip int32
+	err = tx.QueryRow(ctx, `
+        UPDATE password_resets SET used_at = NOW()
+        WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+        RETURNING nip`, hashToken(token)).Scan(&nip)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrInvalidToken
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	currentHash, err := lockPassword(ctx, tx, nip, true)
+	if errors.Is(err, ErrUnknownUser) {
+		return 0, ErrInvalidToken
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := passwords.replace(ctx, tx, nip, currentHash, password); err != nil {
+		return 0, err
+	}
+	if _, err := tx.Exec(ctx, "UPDATE password_resets SET used_at = NOW() WHERE nip = $1 AND used_at IS NULL", nip); err != nil {
+		return 0, err
+	}
+	if _, err := revokeUser(ctx, tx, nip, ""); err != nil {
+		return 0, err
+	}
+	return nip, tx.Commit(ctx)
+}
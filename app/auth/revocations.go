@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+// RevocationChannel is the Postgres NOTIFY channel the sessions trigger
+// sends the id of each ended session to.
+const RevocationChannel = "session_revocations"
+
+// Revocations keeps the sessions ended recently enough that access tokens
+// issued for them may not have expired yet, so that access tokens can be
+// checked against it in memory. It learns of ended sessions from
+// RevocationChannel and reloads them from sessions whenever it (re)connects,
+// so revocations made while it was not listening are not missed.
+type Revocations struct {
+	DB *pgxpool.Pool
+	// TTL is how long a session is kept after it ended: the lifetime of
+	// access tokens
+	TTL time.Duration
+
+	mu      sync.RWMutex
+	revoked map[string]time.Time
+}
+
+func NewRevocations(db *pgxpool.Pool, ttl time.Duration) *Revocations {
+	return &Revocations{DB: db, TTL: ttl, revoked: make(map[string]time.Time)}
+}
+
+// Revoked reports whether sessionId has ended.
+func (r *Revocations) Revoked(sessionId string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.revoked[sessionId]
+	return ok
+}
+
+func (r *Revocations) add(sessionId string, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.revoked[sessionId]; !ok {
+		r.revoked[sessionId] = now
+	}
+}
+
+// prune forgets the sessions whose access tokens have all expired by now.
+func (r *Revocations) prune(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for sessionId, revokedAt := range r.revoked {
+		if now.Sub(revokedAt) > r.TTL {
+			delete(r.revoked, sessionId)
+		}
+	}
+}
+
+// Run listens until ctx is cancelled, reconnecting with a capped backoff
+// whenever the listening connection drops.
+func (r *Revocations) Run(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				r.prune(now)
+			}
+		}
+	}()
+
+	backoff := time.Second
+	for ctx.Err() == nil {
+		err := r.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Error().Err(err).Msgf("Session revocation listener stopped, reconnecting in %s", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+func (r *Revocations) listen(ctx context.Context) error {
+	conn, err := r.DB.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+RevocationChannel); err != nil {
+		return err
+	}
+	// Loaded after LISTEN, so that nothing revoked in between is missed
+	rows, err := conn.Query(ctx, `
+        SELECT session_id FROM sessions
+        WHERE revoked_at > NOW() - make_interval(secs => $1)`, r.TTL.Seconds())
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for rows.Next() {
+		var sessionId string
+		if err := rows.Scan(&sessionId); err != nil {
+			rows.Close()
+			return err
+		}
+		r.add(sessionId, now)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	log.Info().Msgf("Listening on %s", RevocationChannel)
+
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		r.add(n.Payload, time.Now())
+	}
+}
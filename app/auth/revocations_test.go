@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"asset-management-api/app/config"
+)
+
+func TestRevocationsPrune(t *testing.T) {
+	r := NewRevocations(nil, 15*time.Minute)
+	start := time.Now()
+	r.add("old", start)
+	r.add("new", start.Add(10*time.Minute))
+	// A repeated notification keeps the first time
+	r.add("old", start.Add(10*time.Minute))
+
+	r.prune(start.Add(20 * time.Minute))
+	if r.Revoked("old") {
+		t.Error("session ended longer than the access token lifetime ago is still kept")
+	}
+	if !r.Revoked("new") {
+		t.Error("recently ended session was pruned")
+	}
+	if r.Revoked("live") {
+		t.Error("live session reported as revoked")
+	}
+}
+
+func TestVerifyRevokedSession(t *testing.T) {
+	keys, err := NewKeyManager(config.Auth{JWTSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens := &TokenIssuer{AccessTTL: 15 * time.Minute, Keys: keys, Revocations: NewRevocations(nil, 15*time.Minute)}
+	token, err := tokens.sign(&Claims{Nip: 1001, SessionId: "s1"}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claims, err := tokens.Verify(token); err != nil || claims.Nip != 1001 || claims.SessionId != "s1" {
+		t.Fatalf("Verify() = %+v, %v", claims, err)
+	}
+	tokens.Revocations.add("s1", time.Now())
+	if _, err := tokens.Verify(token); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("token of an ended session: err = %v, want ErrInvalidToken", err)
+	}
+}
@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ErrSessionNotFound means the session does not exist, has ended or belongs
+// to another user.
+var ErrSessionNotFound = errors.New("session not found")
+
+// Client is where a login or refresh came from.
+type Client struct {
+	UserAgent string
+	IP        string
+}
+
+// maxIPLength is the width of sessions.ip_address.
+const maxIPLength = 64
+
+// GatewayHeader is the metadata key the HTTP gateway vouches for its calls
+// with. Its value is a secret of this process, so only the gateway, which
+// runs in the same process, can send it.
+const GatewayHeader = "x-gateway-secret"
+
+var gatewaySecret = func() string {
+	secret, err := randomHex(32)
+	if err != nil {
+		panic(err)
+	}
+	return secret
+}()
+
+// GatewayMetadata is what the HTTP gateway adds to each call it forwards.
+func GatewayMetadata() metadata.MD {
+	return metadata.Pairs(GatewayHeader, gatewaySecret)
+}
+
+// ClientFromContext reads the caller's user agent and address from the
+// incoming gRPC metadata. Calls through the gateway carry the browser's user
+// agent and, as X-Forwarded-For, the client address the gateway settled on;
+// any other caller could send whatever X-Forwarded-For it likes, so the peer
+// address is used for them.
+func ClientFromContext(ctx context.Context) Client {
+	var client Client
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return strings.TrimSpace(values[0])
+		}
+		return ""
+	}
+
+	client.UserAgent = first("grpcgateway-user-agent")
+	if client.UserAgent == "" {
+		client.UserAgent = first("user-agent")
+	}
+
+	// The left-most address is the original client
+	if forwarded := first("x-forwarded-for"); forwarded != "" && fromGateway(md) {
+		client.IP = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		client.IP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(client.IP); err == nil {
+			client.IP = host
+		}
+	}
+	if len(client.IP) > maxIPLength {
+		client.IP = client.IP[:maxIPLength]
+	}
+	return client
+}
+
+func fromGateway(md metadata.MD) bool {
+	values := md.Get(GatewayHeader)
+	return len(values) == 1 && subtle.ConstantTimeCompare([]byte(values[0]), []byte(gatewaySecret)) == 1
+}
+
+// Session is one login of a user, kept alive by refreshing.
+type Session struct {
+	Id         string
+	Nip        int32
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+}
+
+func (t *TokenIssuer) createSession(ctx context.Context, q querier, sessionId string, nip int32, client Client, now time.Time) error {
+	_, err := q.Exec(ctx, `
+        INSERT INTO sessions (session_id, nip, user_agent, ip_address, created_at, last_seen_at, expires_at)
+        VALUES ($1, $2, $3, $4, $5, $5, $6)`, sessionId, nip, client.UserAgent, client.IP, now, now.Add(t.RefreshTTL))
+	return err
+}
+
+// touchSession records a refresh: the session is seen now, from client, and
+// lives for another RefreshTTL.
+func (t *TokenIssuer) touchSession(ctx context.Context, q querier, sessionId string, client Client, now time.Time) error {
+	_, err := q.Exec(ctx, `
+        UPDATE sessions SET user_agent = $2, ip_address = $3, last_seen_at = $4, expires_at = $5
+        WHERE session_id = $1`, sessionId, client.UserAgent, client.IP, now, now.Add(t.RefreshTTL))
+	return err
+}
+
+// Sessions returns the active sessions of nip, most recently seen first.
+func (t *TokenIssuer) Sessions(ctx context.Context, nip int32) ([]Session, error) {
+	rows, err := t.DB.Query(ctx, `
+        SELECT session_id, nip, user_agent, ip_address, created_at, last_seen_at, expires_at
+        FROM sessions
+        WHERE nip = $1 AND revoked_at IS NULL AND expires_at > NOW()
+        ORDER BY last_seen_at DESC`, nip)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []Session
+	for rows.Next() {
+		var s Session
+		if err := rows.Scan(&s.Id, &s.Nip, &s.UserAgent, &s.IP, &s.CreatedAt, &s.LastSeenAt, &s.ExpiresAt); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, rows.Err()
+}
+
+// RevokeSession ends a session and revokes its refresh tokens; its access
+// tokens stop working at once.
+func (t *TokenIssuer) RevokeSession(ctx context.Context, sessionId string) error {
+	return revokeFamily(ctx, t.DB, sessionId)
+}
+
+// RevokeUserSession ends a session of nip, and fails with ErrSessionNotFound
+// if nip has no such active session.
+func (t *TokenIssuer) RevokeUserSession(ctx context.Context, nip int32, sessionId string) error {
+	tx, err := t.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+        UPDATE sessions SET revoked_at = NOW()
+        WHERE session_id = $1 AND nip = $2 AND revoked_at IS NULL`, sessionId, nip)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrSessionNotFound
+	}
+	if err := revokeFamily(ctx, tx, sessionId); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// RevokeUser ends every session of nip except keepSessionId, which may be
+// empty, and returns how many were ended.
+func (t *TokenIssuer) RevokeUser(ctx context.Context, nip int32, keepSessionId string) (int64, error) {
+	tx, err := t.DB.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	revoked, err := revokeUser(ctx, tx, nip, keepSessionId)
+	if err != nil {
+		return 0, err
+	}
+	return revoked, tx.Commit(ctx)
+}
+
+// PurgeExpired deletes sessions that have expired or been revoked, along with
+// their refresh tokens, the expired tokens of live sessions, spent password
+// reset tokens and abandoned single sign-on logins. It returns how many
+// sessions were removed. Revoked sessions are kept for AccessTTL, for
+// Revocations to reload after a reconnect.
+func (t *TokenIssuer) PurgeExpired(ctx context.Context) (int64, error) {
+	tag, err := t.DB.Exec(ctx, `
+        DELETE FROM sessions
+        WHERE expires_at < NOW() OR revoked_at < NOW() - make_interval(secs => $1)`, t.AccessTTL.Seconds())
+	if err != nil {
+		return 0, err
+	}
+	if _, err := t.DB.Exec(ctx, "DELETE FROM refresh_tokens WHERE expires_at < NOW()"); err != nil {
+		return 0, err
+	}
+	if _, err := t.DB.Exec(ctx, "DELETE FROM password_resets WHERE expires_at < NOW() OR used_at IS NOT NULL"); err != nil {
+		return 0, err
+	}
+	if _, err := t.DB.Exec(ctx, "DELETE FROM oidc_states WHERE expires_at < NOW()"); err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func revokeUser(ctx context.Context, q querier, nip int32, keepSessionId string) (int64, error) {
+	tag, err := q.Exec(ctx, `
+        UPDATE sessions SET revoked_at = NOW()
+        WHERE nip = $1 AND session_id <> $2 AND revoked_at IS NULL`, nip, keepSessionId)
+	if err != nil {
+		return 0, err
+	}
+	_, err = q.Exec(ctx, `
+        UPDATE refresh_tokens SET revoked_at = NOW()
+        WHERE nip = $1 AND family_id <> $2 AND revoked_at IS NULL`, nip, keepSessionId)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func revokeFamily(ctx context.Context, q querier, familyId string) error {
+	if _, err := q.Exec(ctx, "UPDATE sessions SET revoked_at = NOW() WHERE session_id = $1 AND revoked_at IS NULL", familyId); err != nil {
+		return err
+	}
+	_, err := q.Exec(ctx, "UPDATE refresh_tokens SET revoked_at = NOW() WHERE family_id = $1 AND revoked_at IS NULL", familyId)
+	return err
+}
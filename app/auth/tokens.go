@@ -0,0 +1,329 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"asset-management-api/app/config"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrInvalidToken covers unknown, expired, revoked and malformed tokens.
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrTokenReused means a refresh token was presented after it had
+	// already been exchanged; its whole family has been revoked.
+	ErrTokenReused = errors.New("refresh token reused")
+)
+
+// Token types tell access tokens apart from other JWTs signed with the same
+// key: a challenge token only proves the password step of a login that still
+// needs a second factor.
+const (
+	accessTokenType    = "access"
+	challengeTokenType = "mfa"
+)
+
+// challengeTTL is how long the second step of a login may take.
+const challengeTTL = 5 * time.Minute
+
+// TokenPair is the result of a login or a refresh.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	// ExpiresIn is the lifetime of the access token
+	ExpiresIn time.Duration
+	// SessionId is the refresh token family, shared by every token issued
+	// from the same login
+	SessionId string
+}
+
+// TokenIssuer issues short-lived access tokens, verified without a database
+// lookup, and rotating refresh tokens stored hashed in refresh_tokens. Each
+// refresh token family is a row in sessions; access tokens of ended sessions
+// are turned away by Revocations.
+type TokenIssuer struct {
+	DB         *pgxpool.Pool
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	// ResetTTL is the lifetime of password reset tokens
+	ResetTTL time.Duration
+	// AdminRoleId is the role whose tokens carry the admin flag
+	AdminRoleId int32
+	Keys        *KeyManager
+	// Revocations, if set, holds the sessions ended within AccessTTL
+	Revocations *Revocations
+}
+
+func NewTokenIssuer(db *pgxpool.Pool, cfg config.Auth, keys *KeyManager) *TokenIssuer {
+	return &TokenIssuer{
+		DB:          db,
+		AccessTTL:   cfg.AccessTokenTTL,
+		RefreshTTL:  cfg.RefreshTokenTTL,
+		ResetTTL:    cfg.PasswordResetTTL,
+		AdminRoleId: cfg.AdminRoleId,
+		Keys:        keys,
+		Revocations: NewRevocations(db, cfg.AccessTokenTTL),
+	}
+}
+
+// querier is implemented by both *pgxpool.Pool and pgx.Tx.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// claimsFor loads the identity carried by an access token. Disabled and
+// deleted users get ErrInvalidToken, which also ends their refreshes.
+func claimsFor(ctx context.Context, q querier, nip int32) (*Claims, error) {
+	claims := &Claims{Nip: nip}
+	err := q.QueryRow(ctx, `
+        SELECT user_full_name, role_id, COALESCE(outlet_id, 0), COALESCE(area_id, 0), must_change_password
+        FROM users WHERE nip = $1 AND disabled_at IS NULL`, nip).Scan(
+		&claims.Name, &claims.RoleId, &claims.OutletId, &claims.AreaId, &claims.MustChangePassword)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (t *TokenIssuer) sign(claims *Claims, now time.Time) (string, error) {
+	return t.Keys.Sign(jwt.MapClaims{
+		"sub":       claims.Nip,
+		"name":      claims.Name,
+		"role_id":   claims.RoleId,
+		"outlet_id": claims.OutletId,
+		"area_id":   claims.AreaId,
+		"sid":       claims.SessionId,
+		"adm":       claims.RoleId == t.AdminRoleId,
+		"pwc":       claims.MustChangePassword,
+		"typ":       accessTokenType,
+		"iat":       now.Unix(),
+		"exp":       now.Add(t.AccessTTL).Unix(),
+	})
+}
+
+// Verify checks an access token's signature, algorithm, type and expiry and
+// that its session has not ended, and returns its claims.
+func (t *TokenIssuer) Verify(tokenString string) (*Claims, error) {
+	mapClaims, err := t.parse(tokenString, accessTokenType)
+	if err != nil {
+		return nil, err
+	}
+	claims := claimsFromMap(mapClaims)
+	if t.Revocations != nil && t.Revocations.Revoked(claims.SessionId) {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// IssueChallenge returns a token standing for nip having passed the password
+// step of a login, to be completed with a second factor.
+func (t *TokenIssuer) IssueChallenge(nip int32) (string, error) {
+	now := time.Now()
+	return t.Keys.Sign(jwt.MapClaims{
+		"sub": nip,
+		"typ": challengeTokenType,
+		"iat": now.Unix(),
+		"exp": now.Add(challengeTTL).Unix(),
+	})
+}
+
+// VerifyChallenge checks a challenge token and returns whose login it is.
+func (t *TokenIssuer) VerifyChallenge(tokenString string) (int32, error) {
+	mapClaims, err := t.parse(tokenString, challengeTokenType)
+	if err != nil {
+		return 0, err
+	}
+	return claimsFromMap(mapClaims).Nip, nil
+}
+
+func (t *TokenIssuer) parse(tokenString, tokenType string) (jwt.MapClaims, error) {
+	mapClaims, err := t.Keys.Parse(tokenString)
+	if err != nil || mapClaims["typ"] != tokenType {
+		return nil, ErrInvalidToken
+	}
+	return mapClaims, nil
+}
+
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// storeRefreshToken adds a new token to the family and returns it.
+func (t *TokenIssuer) storeRefreshToken(ctx context.Context, q querier, nip int32, familyId string, now time.Time) (string, error) {
+	token, err := randomHex(32)
+	if err != nil {
+		return "", err
+	}
+	_, err = q.Exec(ctx, `
+        INSERT INTO refresh_tokens (token_hash, family_id, nip, created_at, expires_at)
+        VALUES ($1, $2, $3, $4, $5)`, hashToken(token), familyId, nip, now, now.Add(t.RefreshTTL))
+	return token, err
+}
+
+// Issue starts a new session for nip, e.g. after a successful login.
+func (t *TokenIssuer) Issue(ctx context.Context, nip int32, client Client) (*TokenPair, error) {
+	now := time.Now()
+	claims, err := claimsFor(ctx, t.DB, nip)
+	if err != nil {
+		return nil, err
+	}
+	claims.SessionId, err = randomHex(16)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := t.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := t.createSession(ctx, tx, claims.SessionId, nip, client, now); err != nil {
+		return nil, err
+	}
+	refreshToken, err := t.storeRefreshToken(ctx, tx, nip, claims.SessionId, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := t.sign(claims, now)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{AccessToken: accessToken, RefreshToken: refreshToken, ExpiresIn: t.AccessTTL, SessionId: claims.SessionId}, nil
+}
+
+// Refresh exchanges a refresh token for a new pair in the same family. The
+// presented token stops working; presenting it again is treated as theft and
+// revokes the family, logging out both the thief and the legitimate user.
+// A successful refresh extends the session and records client as its latest
+// user agent and address.
+func (t *TokenIssuer) Refresh(ctx context.Context, refreshToken string, client Client) (*TokenPair, error) {
+	now := time.Now()
+	tx, err := t.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var id int64
+	var familyId string
+	var nip int32
+	var expiresAt time.Time
+	var rotatedAt, revokedAt *time.Time
+	err = tx.QueryRow(ctx, `
+        SELECT refresh_tokens.id, refresh_tokens.family_id, refresh_tokens.nip,
+               LEAST(refresh_tokens.expires_at, sessions.expires_at), refresh_tokens.rotated_at,
+               COALESCE(refresh_tokens.revoked_at, sessions.revoked_at)
+        FROM refresh_tokens
+        JOIN sessions ON sessions.session_id = refresh_tokens.family_id
+        WHERE refresh_tokens.token_hash = $1
+        FOR UPDATE OF refresh_tokens, sessions`, hashToken(refreshToken)).Scan(
+		&id, &familyId, &nip, &expiresAt, &rotatedAt, &revokedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case revokedAt != nil, now.After(expiresAt):
+		return nil, ErrInvalidToken
+	case rotatedAt != nil:
+		if err := revokeFamily(ctx, tx, familyId); err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+		log.Warn().Msgf("Refresh token reused for user %d, session %s revoked", nip, familyId)
+		return nil, ErrTokenReused
+	}
+
+	claims, err := claimsFor(ctx, tx, nip)
+	if err != nil {
+		return nil, err
+	}
+	claims.SessionId = familyId
+
+	if _, err := tx.Exec(ctx, "UPDATE refresh_tokens SET rotated_at = $1 WHERE id = $2", now, id); err != nil {
+		return nil, err
+	}
+	newRefreshToken, err := t.storeRefreshToken(ctx, tx, nip, familyId, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.touchSession(ctx, tx, familyId, client, now); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := t.sign(claims, now)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{AccessToken: accessToken, RefreshToken: newRefreshToken, ExpiresIn: t.AccessTTL, SessionId: familyId}, nil
+}
+
+// Reissue signs a new access token for a live session of nip, e.g. after
+// the claims of the user changed.
+func (t *TokenIssuer) Reissue(ctx context.Context, nip int32, sessionId string) (string, error) {
+	var live bool
+	err := t.DB.QueryRow(ctx, `
+        SELECT EXISTS(SELECT 1 FROM sessions
+                      WHERE session_id = $1 AND nip = $2 AND revoked_at IS NULL AND expires_at > NOW())`,
+		sessionId, nip).Scan(&live)
+	if err != nil {
+		return "", err
+	}
+	if !live {
+		return "", ErrInvalidToken
+	}
+
+	now := time.Now()
+	claims, err := claimsFor(ctx, t.DB, nip)
+	if err != nil {
+		return "", err
+	}
+	claims.SessionId = sessionId
+	return t.sign(claims, now)
+}
+
+// SessionOf returns the family of a refresh token.
+func (t *TokenIssuer) SessionOf(ctx context.Context, refreshToken string) (string, error) {
+	var familyId string
+	err := t.DB.QueryRow(ctx, "SELECT family_id FROM refresh_tokens WHERE token_hash = $1", hashToken(refreshToken)).Scan(&familyId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrInvalidToken
+	}
+	return familyId, err
+}
@@ -0,0 +1,286 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"asset-management-api/app/config"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrTOTPEnabled means the user already has two-factor authentication.
+	ErrTOTPEnabled = errors.New("two-factor authentication is already enabled")
+	// ErrTOTPNotPending means there is no enrollment to confirm.
+	ErrTOTPNotPending = errors.New("no two-factor enrollment in progress")
+	// ErrInvalidCode covers wrong, expired and replayed codes.
+	ErrInvalidCode = errors.New("invalid verification code")
+)
+
+// TOTP parameters, the defaults of RFC 6238 that authenticator apps expect.
+const (
+	totpDigits = 6
+	totpPeriod = 30
+	// totpSkew is how many periods either side of now are accepted, to allow
+	// for clock drift
+	totpSkew = 1
+
+	recoveryCodeCount = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// totpCode computes the code for one time step (RFC 4226 section 5.3).
+func totpCode(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000)
+}
+
+// checkTOTP returns the time step code matches for secret around now.
+func checkTOTP(secret, code string, now time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// normalizeCode strips the spaces and dashes users type or paste along with
+// a code.
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totpDigits {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// TwoFactorStatus is a user's two-factor setup and whether their role
+// requires it.
+type TwoFactorStatus struct {
+	Enabled           bool
+	Required          bool
+	RecoveryCodesLeft int32
+}
+
+// twoFactorDB is implemented by *pgxpool.Pool.
+type twoFactorDB interface {
+	querier
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// TwoFactor stores TOTP secrets and recovery codes and checks codes against
+// them.
+type TwoFactor struct {
+	DB twoFactorDB
+	// Issuer names the service in authenticator apps
+	Issuer string
+}
+
+func NewTwoFactor(db *pgxpool.Pool, cfg config.Auth) *TwoFactor {
+	return &TwoFactor{DB: db, Issuer: cfg.TOTPIssuer}
+}
+
+// Status returns the two-factor setup of nip.
+func (f *TwoFactor) Status(ctx context.Context, nip int32) (TwoFactorStatus, error) {
+	var status TwoFactorStatus
+	err := f.DB.QueryRow(ctx, `
+        SELECT COALESCE(user_totp.enabled_at IS NOT NULL, FALSE),
+               COALESCE(roles.mfa_required, FALSE),
+               (SELECT COUNT(*) FROM user_recovery_codes
+                WHERE user_recovery_codes.nip = users.nip AND used_at IS NULL)
+        FROM users
+        LEFT JOIN roles ON roles.role_id = users.role_id
+        LEFT JOIN user_totp ON user_totp.nip = users.nip
+        WHERE users.nip = $1`, nip).Scan(&status.Enabled, &status.Required, &status.RecoveryCodesLeft)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status, ErrInvalidToken
+	}
+	return status, err
+}
+
+// Begin starts an enrollment with a new secret, replacing any unconfirmed
+// one, and returns the secret and its otpauth:// URI for authenticator apps.
+func (f *TwoFactor) Begin(ctx context.Context, nip int32, account string) (string, string, error) {
+	key := make([]byte, 20)
+	if _, err := rand.Read(key); err != nil {
+		return "", "", err
+	}
+	secret := totpEncoding.EncodeToString(key)
+
+	tag, err := f.DB.Exec(ctx, `
+        INSERT INTO user_totp (nip, secret) VALUES ($1, $2)
+        ON CONFLICT (nip) DO UPDATE SET secret = EXCLUDED.secret, created_at = NOW()
+        WHERE user_totp.enabled_at IS NULL`, nip, secret)
+	if err != nil {
+		return "", "", err
+	}
+	if tag.RowsAffected() == 0 {
+		return "", "", ErrTOTPEnabled
+	}
+
+	label := url.PathEscape(f.Issuer) + ":" + url.PathEscape(account)
+	uri := fmt.Sprintf("otpauth://totp/%s?secret=%s&issuer=%s&algorithm=SHA1&digits=%d&period=%d",
+		label, secret, url.QueryEscape(f.Issuer), totpDigits, totpPeriod)
+	return secret, uri, nil
+}
+
+// Confirm enables two-factor authentication once the user proves their app
+// produces codes for the pending secret, and returns fresh recovery codes.
+func (f *TwoFactor) Confirm(ctx context.Context, nip int32, code string) ([]string, error) {
+	tx, err := f.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var secret string
+	err = tx.QueryRow(ctx, "SELECT secret FROM user_totp WHERE nip = $1 AND enabled_at IS NULL FOR UPDATE", nip).Scan(&secret)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTOTPNotPending
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	step, ok := checkTOTP(secret, normalizeCode(code), time.Now())
+	if !ok {
+		return nil, ErrInvalidCode
+	}
+	if _, err := tx.Exec(ctx, "UPDATE user_totp SET enabled_at = NOW(), last_used_step = $2 WHERE nip = $1", nip, step); err != nil {
+		return nil, err
+	}
+	codes, err := replaceRecoveryCodes(ctx, tx, nip)
+	if err != nil {
+		return nil, err
+	}
+	return codes, tx.Commit(ctx)
+}
+
+// Verify accepts either a current TOTP code, each usable once, or an unused
+// recovery code, which is then spent.
+func (f *TwoFactor) Verify(ctx context.Context, nip int32, code string) error {
+	code = normalizeCode(code)
+	if !isTOTPCode(code) {
+		tag, err := f.DB.Exec(ctx, `
+            UPDATE user_recovery_codes SET used_at = NOW()
+            WHERE nip = $1 AND code_hash = $2 AND used_at IS NULL`, nip, hashToken(code))
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrInvalidCode
+		}
+		return nil
+	}
+
+	var secret string
+	err := f.DB.QueryRow(ctx, "SELECT secret FROM user_totp WHERE nip = $1 AND enabled_at IS NOT NULL", nip).Scan(&secret)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrInvalidCode
+	}
+	if err != nil {
+		return err
+	}
+	step, ok := checkTOTP(secret, code, time.Now())
+	if !ok {
+		return ErrInvalidCode
+	}
+
+	// A code is only good once, even within its period
+	tag, err := f.DB.Exec(ctx, `
+        UPDATE user_totp SET last_used_step = $2
+        WHERE nip = $1 AND (last_used_step IS NULL OR last_used_step < $2)`, nip, step)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrInvalidCode
+	}
+	return nil
+}
+
+// Disable removes the secret and recovery codes of nip.
+func (f *TwoFactor) Disable(ctx context.Context, nip int32) error {
+	tx, err := f.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "DELETE FROM user_totp WHERE nip = $1", nip); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, "DELETE FROM user_recovery_codes WHERE nip = $1", nip); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes of nip.
+func (f *TwoFactor) RegenerateRecoveryCodes(ctx context.Context, nip int32) ([]string, error) {
+	tx, err := f.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	codes, err := replaceRecoveryCodes(ctx, tx, nip)
+	if err != nil {
+		return nil, err
+	}
+	return codes, tx.Commit(ctx)
+}
+
+// replaceRecoveryCodes stores new recovery codes, hashed, in place of the old
+// ones and returns them formatted as xxxxx-xxxxx.
+func replaceRecoveryCodes(ctx context.Context, q querier, nip int32) ([]string, error) {
+	if _, err := q.Exec(ctx, "DELETE FROM user_recovery_codes WHERE nip = $1", nip); err != nil {
+		return nil, err
+	}
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := randomHex(5)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := q.Exec(ctx, "INSERT INTO user_recovery_codes (nip, code_hash) VALUES ($1, $2)", nip, hashToken(code)); err != nil {
+			return nil, err
+		}
+		codes[i] = code[:5] + "-" + code[5:]
+	}
+	return codes, nil
+}
@@ -0,0 +1,234 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// rfc6238Key is the SHA-1 seed of the RFC 6238 test vectors.
+var rfc6238Key = []byte("12345678901234567890")
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 appendix B lists 8-digit codes; 6-digit codes are their last
+	// six digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		if got := totpCode(rfc6238Key, tt.unix/totpPeriod); got != tt.want {
+			t.Errorf("code at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestCheckTOTP(t *testing.T) {
+	secret := totpEncoding.EncodeToString(rfc6238Key)
+	now := time.Unix(1111111111, 0)
+	current := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		wantStep int64
+		wantOK   bool
+	}{
+		{"current step", secret, totpCode(rfc6238Key, current), current, true},
+		{"one step behind", secret, totpCode(rfc6238Key, current-1), current - 1, true},
+		{"one step ahead", secret, totpCode(rfc6238Key, current+1), current + 1, true},
+		{"two steps behind", secret, totpCode(rfc6238Key, current-2), 0, false},
+		{"two steps ahead", secret, totpCode(rfc6238Key, current+2), 0, false},
+		{"wrong code", secret, "000000", 0, false},
+		{"8 digits", secret, "07081804", 0, false},
+		{"bad secret", "not base32!", totpCode(rfc6238Key, current), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := checkTOTP(tt.secret, tt.code, now)
+			if step != tt.wantStep || ok != tt.wantOK {
+				t.Errorf("checkTOTP(%s) = %d, %v, want %d, %v", tt.code, step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+// fakeTOTP keeps one user's user_totp row and recovery codes in memory.
+type fakeTOTP struct {
+	mu           sync.Mutex
+	secret       string
+	enabled      bool
+	lastUsedStep int64
+	// recovery maps code hashes to whether they were used
+	recovery map[string]bool
+}
+
+func newFakeTOTP(enabled bool) *fakeTOTP {
+	return &fakeTOTP{secret: totpEncoding.EncodeToString(rfc6238Key), enabled: enabled, recovery: map[string]bool{}}
+}
+
+func (db *fakeTOTP) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	switch {
+	case strings.Contains(sql, "SET enabled_at"):
+		db.enabled, db.lastUsedStep = true, args[1].(int64)
+	case strings.Contains(sql, "SET last_used_step"):
+		step := args[1].(int64)
+		if db.lastUsedStep >= step {
+			return pgconn.NewCommandTag("UPDATE 0"), nil
+		}
+		db.lastUsedStep = step
+	case strings.Contains(sql, "UPDATE user_recovery_codes"):
+		if used, ok := db.recovery[args[1].(string)]; !ok || used {
+			return pgconn.NewCommandTag("UPDATE 0"), nil
+		}
+		db.recovery[args[1].(string)] = true
+	case strings.Contains(sql, "DELETE FROM user_recovery_codes"):
+		db.recovery = map[string]bool{}
+	case strings.Contains(sql, "INSERT INTO user_recovery_codes"):
+		db.recovery[args[1].(string)] = false
+	default:
+		return pgconn.CommandTag{}, errors.New("unexpected statement")
+	}
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (db *fakeTOTP) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	// Confirm reads the pending secret, Verify the enabled one
+	if strings.Contains(sql, "enabled_at IS NOT NULL") == db.enabled {
+		return stringRow{db.secret}
+	}
+	return stringRow(nil)
+}
+
+func (db *fakeTOTP) Begin(ctx context.Context) (pgx.Tx, error) {
+	return fakeTOTPTx{db: db}, nil
+}
+
+type fakeTOTPTx struct {
+	pgx.Tx
+	db *fakeTOTP
+}
+
+func (tx fakeTOTPTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return tx.db.Exec(ctx, sql, args...)
+}
+
+func (tx fakeTOTPTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return tx.db.QueryRow(ctx, sql, args...)
+}
+
+func (tx fakeTOTPTx) Commit(ctx context.Context) error   { return nil }
+func (tx fakeTOTPTx) Rollback(ctx context.Context) error { return nil }
+
+func TestTwoFactorVerifyReplay(t *testing.T) {
+	f := &TwoFactor{DB: newFakeTOTP(true)}
+	ctx := context.Background()
+	current := time.Now().Unix() / totpPeriod
+
+	if err := f.Verify(ctx, 1001, totpCode(rfc6238Key, current)); err != nil {
+		t.Fatalf("current code: %v", err)
+	}
+	if err := f.Verify(ctx, 1001, totpCode(rfc6238Key, current)); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("same code again: err = %v, want ErrInvalidCode", err)
+	}
+	// Still inside the skew, but older than the step already used
+	if err := f.Verify(ctx, 1001, totpCode(rfc6238Key, current-1)); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("previous step after the current one: err = %v, want ErrInvalidCode", err)
+	}
+	if err := f.Verify(ctx, 1001, totpCode(rfc6238Key, current+1)); err != nil {
+		t.Errorf("next step: %v", err)
+	}
+	if err := f.Verify(ctx, 1001, totpCode(rfc6238Key, current+1)); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("next step again: err = %v, want ErrInvalidCode", err)
+	}
+}
+
+func TestTwoFactorVerifyNotEnabled(t *testing.T) {
+	f := &TwoFactor{DB: newFakeTOTP(false)}
+	code := totpCode(rfc6238Key, time.Now().Unix()/totpPeriod)
+	if err := f.Verify(context.Background(), 1001, code); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("code of a pending enrollment: err = %v, want ErrInvalidCode", err)
+	}
+}
+
+func TestTwoFactorConfirm(t *testing.T) {
+	db := newFakeTOTP(false)
+	f := &TwoFactor{DB: db}
+	ctx := context.Background()
+	code := totpCode(rfc6238Key, time.Now().Unix()/totpPeriod)
+
+	if _, err := f.Confirm(ctx, 1001, "000000"); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("wrong code: err = %v, want ErrInvalidCode", err)
+	}
+	codes, err := f.Confirm(ctx, 1001, code[:3]+" "+code[3:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !db.enabled || len(codes) != recoveryCodeCount || len(db.recovery) != recoveryCodeCount {
+		t.Errorf("enabled %v with %d recovery codes (%d stored)", db.enabled, len(codes), len(db.recovery))
+	}
+	// The code that confirmed the enrollment cannot also sign in
+	if err := f.Verify(ctx, 1001, code); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("enrollment code reused: err = %v, want ErrInvalidCode", err)
+	}
+	if _, err := f.Confirm(ctx, 1001, code); !errors.Is(err, ErrTOTPNotPending) {
+		t.Errorf("second confirm: err = %v, want ErrTOTPNotPending", err)
+	}
+}
+
+func TestRecoveryCodesSingleUse(t *testing.T) {
+	db := newFakeTOTP(true)
+	f := &TwoFactor{DB: db}
+	ctx := context.Background()
+	codes, err := f.RegenerateRecoveryCodes(ctx, 1001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for _, code := range codes {
+		if len(code) != 11 || code[5] != '-' || seen[code] {
+			t.Errorf("recovery code %q", code)
+		}
+		seen[code] = true
+	}
+
+	if err := f.Verify(ctx, 1001, codes[0]); err != nil {
+		t.Fatalf("recovery code: %v", err)
+	}
+	if err := f.Verify(ctx, 1001, codes[0]); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("recovery code used twice: err = %v, want ErrInvalidCode", err)
+	}
+	// Typed without the dash and in capitals
+	if err := f.Verify(ctx, 1001, strings.ToUpper(strings.Replace(codes[1], "-", "", 1))); err != nil {
+		t.Errorf("recovery code typed differently: %v", err)
+	}
+	if err := f.Verify(ctx, 1001, "abcde-12345"); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("unknown recovery code: err = %v, want ErrInvalidCode", err)
+	}
+
+	// Regenerating voids the remaining codes
+	if _, err := f.RegenerateRecoveryCodes(ctx, 1001); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Verify(ctx, 1001, codes[2]); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("code from before regenerating: err = %v, want ErrInvalidCode", err)
+	}
+}
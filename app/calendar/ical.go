@@ -0,0 +1,212 @@
+package calendar
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+// Same role IDs as the services package; personal feeds follow the scoping
+// of the notification inbox.
+const (
+	roleArea   int32 = 5
+	roleOutlet int32 = 6
+)
+
+// horizon limits how far ahead a feed lists maintenance.
+const horizon = 1
+
+// NewToken returns a fresh feed token and the hash stored for it. Only the
+// hash is persisted, so a leaked database does not expose working feed URLs.
+func NewToken() (token, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = hex.EncodeToString(buf)
+	return token, HashToken(token), nil
+}
+
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// FeedURL is the public address of a feed for the given token.
+func FeedURL(baseURL, token string) string {
+	return strings.TrimRight(baseURL, "/") + "/api/calendar/" + token + ".ics"
+}
+
+type event struct {
+	assetId    int32
+	assetName  string
+	date       time.Time
+	outletName string
+	className  string
+	checklist  string
+}
+
+// Handler serves GET /api/calendar/{token}.ics. Calendar clients cannot log
+// in, so the token in the path is the only credential; rotating or revoking
+// it in calendar_feeds makes the old URL return 404.
+func Handler(db *pgxpool.Pool) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		token := strings.TrimSuffix(params["token"], ".ics")
+
+		events, name, err := feedEvents(r.Context(), db, token)
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to build calendar feed")
+			http.Error(w, "Failed to build calendar feed", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Write([]byte(render(name, events, time.Now())))
+	}
+}
+
+func feedEvents(ctx context.Context, db *pgxpool.Pool, token string) ([]event, string, error) {
+	var feedId, roleId, userOutletId, userAreaId int32
+	var outletId *int32
+	var name string
+	err := db.QueryRow(ctx, `
+        SELECT f.id, f.outlet_id, COALESCE(o.outlet_name, u.user_full_name), u.role_id,
+               COALESCE(u.outlet_id, 0), COALESCE(u.area_id, 0)
+        FROM calendar_feeds f
+        JOIN users u ON u.nip = f.nip
+        LEFT JOIN outlets o ON o.outlet_id = f.outlet_id
+        WHERE f.token_hash = $1 AND f.revoked_at IS NULL`, HashToken(token)).Scan(
+		&feedId, &outletId, &name, &roleId, &userOutletId, &userAreaId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := `
+        SELECT a.asset_id, a.asset_name, a.asset_maintenance_date, COALESCE(o.outlet_name, ''),
+               COALESCE(c.classification_name, ''), COALESCE(c.asset_healthy_param, '')
+        FROM assets a
+        LEFT JOIN outlets o ON o.outlet_id = a.outlet_id
+        LEFT JOIN classifications c ON c.classification_id = a.asset_classification
+        WHERE a.asset_maintenance_date >= CURRENT_DATE AND a.asset_maintenance_date < $1`
+	args := []interface{}{time.Now().AddDate(horizon, 0, 0)}
+	switch {
+	case outletId != nil:
+		args = append(args, *outletId)
+		query += fmt.Sprintf(" AND a.outlet_id = $%d", len(args))
+	case roleId == roleArea:
+		args = append(args, userAreaId)
+		query += fmt.Sprintf(" AND a.area_id = $%d", len(args))
+	case roleId == roleOutlet:
+		args = append(args, userOutletId)
+		query += fmt.Sprintf(" AND a.outlet_id = $%d", len(args))
+	}
+	query += " ORDER BY a.asset_maintenance_date, a.asset_id"
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var events []event
+	for rows.Next() {
+		var e event
+		if err := rows.Scan(&e.assetId, &e.assetName, &e.date, &e.outletName, &e.className, &e.checklist); err != nil {
+			return nil, "", err
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	_, err = db.Exec(ctx, `UPDATE calendar_feeds SET last_fetched_at = NOW() WHERE id = $1`, feedId)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to update calendar feed fetch time")
+	}
+	return events, name, nil
+}
+
+// render writes the feed as RFC 5545. Each asset has a stable UID, so when
+// UpdateAssetStatus moves the maintenance date the client moves the existing
+// event instead of adding a new one.
+func render(name string, events []event, now time.Time) string {
+	var b strings.Builder
+	line := func(s string) {
+		b.WriteString(fold(s))
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Asset Management API//Maintenance//ID")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + escape("Maintenance Aset - "+name))
+	line("REFRESH-INTERVAL;VALUE=DURATION:PT6H")
+
+	stamp := now.UTC().Format("20060102T150405Z")
+	for _, e := range events {
+		description := "Outlet: " + e.outletName + "\nKlasifikasi: " + e.className
+		if items := strings.Split(e.checklist, ","); e.checklist != "" {
+			description += "\n\nChecklist:"
+			for _, item := range items {
+				description += "\n- " + strings.TrimSpace(item)
+			}
+		}
+
+		line("BEGIN:VEVENT")
+		line(fmt.Sprintf("UID:asset-%d@asset-management-api", e.assetId))
+		line("DTSTAMP:" + stamp)
+		line("DTSTART;VALUE=DATE:" + e.date.Format("20060102"))
+		line("DTEND;VALUE=DATE:" + e.date.AddDate(0, 0, 1).Format("20060102"))
+		line("SUMMARY:" + escape("Maintenance: "+e.assetName))
+		line("LOCATION:" + escape(e.outletName))
+		line("DESCRIPTION:" + escape(description))
+		line("TRANSP:TRANSPARENT")
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return b.String()
+}
+
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// fold splits content lines longer than 75 octets without breaking UTF-8
+// sequences, as RFC 5545 section 3.1 requires.
+func fold(s string) string {
+	if len(s) <= 75 {
+		return s
+	}
+	var b strings.Builder
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		limit = 74
+	}
+	b.WriteString(s)
+	return b.String()
+}
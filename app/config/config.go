@@ -0,0 +1,503 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFile is read when CONFIG_FILE is not set. It is optional.
+const DefaultFile = "config.yaml"
+
+// Config holds every setting of the API. Values are resolved in order of
+// increasing precedence: defaults, the YAML file, then environment variables
+// (a .env file in the working directory is loaded into the environment first
+// and never overrides variables that are already set).
+type Config struct {
+	Database    Database    `yaml:"database"`
+	Server      Server      `yaml:"server"`
+	Auth        Auth        `yaml:"auth"`
+	Passwords   Passwords   `yaml:"passwords"`
+	LDAP        LDAP        `yaml:"ldap"`
+	OIDC        OIDC        `yaml:"oidc"`
+	Nextcloud   Nextcloud   `yaml:"nextcloud"`
+	Delivery    Delivery    `yaml:"delivery"`
+	Submissions Submissions `yaml:"submissions"`
+}
+
+type Database struct {
+	Host     string `yaml:"host" env:"DB_HOST" required:"true"`
+	Port     string `yaml:"port" env:"DB_PORT"`
+	User     string `yaml:"user" env:"DB_USER" required:"true"`
+	Password string `yaml:"password" env:"DB_PASSWORD"`
+	Name     string `yaml:"name" env:"DB_NAME" required:"true"`
+	SSLMode  string `yaml:"sslmode" env:"DB_SSLMODE"`
+	MaxConns int32  `yaml:"max_conns" env:"DB_MAX_CONNS"`
+}
+
+// DSN is the connection string for pgxpool, with every part escaped.
+func (d Database) DSN() string {
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(d.User, d.Password),
+		Host:   d.Host,
+		Path:   "/" + d.Name,
+	}
+	if d.Port != "" {
+		u.Host = net.JoinHostPort(d.Host, d.Port)
+	}
+	if d.SSLMode != "" {
+		u.RawQuery = url.Values{"sslmode": {d.SSLMode}}.Encode()
+	}
+	return u.String()
+}
+
+type Server struct {
+	// GRPCAddr, GatewayAddr and RESTAddr are the listen addresses.
+	GRPCAddr    string `yaml:"grpc_addr" env:"GRPC_ADDR" required:"true"`
+	GatewayAddr string `yaml:"gateway_addr" env:"GATEWAY_ADDR" required:"true"`
+	RESTAddr    string `yaml:"rest_addr" env:"REST_ADDR" required:"true"`
+	// GRPCEndpoint is where the gateway dials the gRPC server.
+	GRPCEndpoint string `yaml:"grpc_endpoint" env:"GRPC_ENDPOINT" required:"true"`
+	// AppBaseURL is the web app, used for links in emails; APIBaseURL is the
+	// public gateway address, used for feed and unsubscribe links.
+	AppBaseURL string `yaml:"app_base_url" env:"APP_BASE_URL"`
+	APIBaseURL string `yaml:"api_base_url" env:"API_BASE_URL"`
+	// ShutdownTimeout is how long in-flight requests may drain on shutdown.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+	// TrustedProxyHops is the number of reverse proxies in front of the
+	// gateway whose X-Forwarded-For entries are trusted for client addresses.
+	TrustedProxyHops int `yaml:"trusted_proxy_hops" env:"TRUSTED_PROXY_HOPS"`
+}
+
+type Auth struct {
+	// JWTSigningKey is a PEM file with the RSA or Ed25519 private key tokens
+	// are signed with. JWTVerificationKeys are further PEM files, public or
+	// private, whose tokens are still accepted, e.g. the previous signing key
+	// during a rotation. JWTSecret is the legacy HS256 secret: it signs when
+	// there is no signing key and otherwise only verifies older tokens.
+	JWTSigningKey       string   `yaml:"jwt_signing_key" env:"JWT_SIGNING_KEY"`
+	JWTVerificationKeys []string `yaml:"jwt_verification_keys" env:"JWT_VERIFICATION_KEYS"`
+	JWTSecret           string   `yaml:"jwt_secret" env:"JWT_SECRET"`
+	// AccessTokenTTL is the lifetime of the access tokens sent with every
+	// call; RefreshTokenTTL is how long a session can go without refreshing.
+	AccessTokenTTL  time.Duration `yaml:"access_token_ttl" env:"ACCESS_TOKEN_TTL"`
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl" env:"REFRESH_TOKEN_TTL"`
+	// PasswordResetTTL is how long an emailed reset link stays usable.
+	PasswordResetTTL time.Duration `yaml:"password_reset_ttl" env:"PASSWORD_RESET_TTL"`
+	// Failed logins are slowed down exponentially from LoginBackoff; after
+	// LoginMaxFailures failures for one account, or LoginMaxIPFailures from
+	// one address, further attempts are refused for LoginLockout.
+	LoginMaxFailures   int           `yaml:"login_max_failures" env:"LOGIN_MAX_FAILURES"`
+	LoginMaxIPFailures int           `yaml:"login_max_ip_failures" env:"LOGIN_MAX_IP_FAILURES"`
+	LoginBackoff       time.Duration `yaml:"login_backoff" env:"LOGIN_BACKOFF"`
+	LoginLockout       time.Duration `yaml:"login_lockout" env:"LOGIN_LOCKOUT"`
+	// TOTPIssuer names the service in authenticator apps.
+	TOTPIssuer string `yaml:"totp_issuer" env:"TOTP_ISSUER"`
+	// AdminRoleId is the role allowed to manage other users' sessions and
+	// accounts.
+	AdminRoleId int32 `yaml:"admin_role_id" env:"ADMIN_ROLE_ID"`
+	// Providers are tried in order for NIP and password logins: local, ldap;
+	// oidc enables single sign-on.
+	Providers []string `yaml:"providers" env:"AUTH_PROVIDERS"`
+	// AutoProvision creates users unknown locally on their first login
+	// through LDAP or OIDC, with DefaultRoleId unless a group maps a role.
+	AutoProvision bool  `yaml:"auto_provision" env:"AUTH_AUTO_PROVISION"`
+	DefaultRoleId int32 `yaml:"default_role_id" env:"AUTH_DEFAULT_ROLE_ID"`
+	// GroupRoles and GroupOutlets map directory groups to role and outlet IDs
+	// as group=id, the first matching entry winning. Groups are matched by
+	// name, or for LDAP DNs by their first component.
+	GroupRoles   []string `yaml:"group_roles" env:"AUTH_GROUP_ROLES"`
+	GroupOutlets []string `yaml:"group_outlets" env:"AUTH_GROUP_OUTLETS"`
+}
+
+// Passwords is the policy for passwords users choose.
+type Passwords struct {
+	// MinLength is counted in characters; Classes are the kinds of
+	// characters every password needs: lower, upper, letter, digit, symbol.
+	MinLength int      `yaml:"min_length" env:"PASSWORD_MIN_LENGTH"`
+	Classes   []string `yaml:"classes" env:"PASSWORD_CLASSES"`
+	// BreachedList is a file of leaked passwords to refuse, one per line in
+	// plain text or as SHA-1 hashes like the Have I Been Pwned downloads.
+	BreachedList string `yaml:"breached_list" env:"PASSWORD_BREACHED_LIST"`
+	// History is how many of a user's last passwords, the current one
+	// included, cannot be chosen again.
+	History int `yaml:"history" env:"PASSWORD_HISTORY"`
+}
+
+// PasswordClasses are the valid Passwords.Classes.
+var PasswordClasses = []string{"lower", "upper", "letter", "digit", "symbol"}
+
+// LDAP is the company directory users can log in with by binding as
+// themselves.
+type LDAP struct {
+	// URL is ldap://host:389 or ldaps://host:636; StartTLS upgrades ldap://.
+	URL      string `yaml:"url" env:"LDAP_URL"`
+	StartTLS bool   `yaml:"start_tls" env:"LDAP_START_TLS"`
+	// BindDN and BindPassword are the account users are searched with;
+	// anonymous when empty.
+	BindDN       string `yaml:"bind_dn" env:"LDAP_BIND_DN"`
+	BindPassword string `yaml:"bind_password" env:"LDAP_BIND_PASSWORD"`
+	BaseDN       string `yaml:"base_dn" env:"LDAP_BASE_DN"`
+	// Attributes holding the NIP users log in with, and their details.
+	NIPAttribute   string        `yaml:"nip_attribute" env:"LDAP_NIP_ATTRIBUTE"`
+	NameAttribute  string        `yaml:"name_attribute" env:"LDAP_NAME_ATTRIBUTE"`
+	EmailAttribute string        `yaml:"email_attribute" env:"LDAP_EMAIL_ATTRIBUTE"`
+	GroupAttribute string        `yaml:"group_attribute" env:"LDAP_GROUP_ATTRIBUTE"`
+	Timeout        time.Duration `yaml:"timeout" env:"LDAP_TIMEOUT"`
+}
+
+// OIDC is an OpenID Connect provider for single sign-on with the
+// authorization code flow.
+type OIDC struct {
+	Issuer       string `yaml:"issuer" env:"OIDC_ISSUER"`
+	ClientID     string `yaml:"client_id" env:"OIDC_CLIENT_ID"`
+	ClientSecret string `yaml:"client_secret" env:"OIDC_CLIENT_SECRET"`
+	// RedirectURL is the web app page that receives the code and state.
+	RedirectURL string   `yaml:"redirect_url" env:"OIDC_REDIRECT_URL"`
+	Scopes      []string `yaml:"scopes" env:"OIDC_SCOPES"`
+	// Claims holding the user's NIP and groups.
+	NIPClaim    string `yaml:"nip_claim" env:"OIDC_NIP_CLAIM"`
+	GroupsClaim string `yaml:"groups_claim" env:"OIDC_GROUPS_CLAIM"`
+}
+
+// GroupMapping is one group=id entry of Auth.GroupRoles or Auth.GroupOutlets.
+type GroupMapping struct {
+	Group string
+	Id    int32
+}
+
+// ParseGroupMappings parses group=id entries.
+func ParseGroupMappings(entries []string) ([]GroupMapping, error) {
+	mappings := make([]GroupMapping, 0, len(entries))
+	for _, entry := range entries {
+		i := strings.LastIndex(entry, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("%q is not group=id", entry)
+		}
+		id, err := strconv.ParseInt(strings.TrimSpace(entry[i+1:]), 10, 32)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("%q is not group=id", entry)
+		}
+		mappings = append(mappings, GroupMapping{Group: strings.TrimSpace(entry[:i]), Id: int32(id)})
+	}
+	return mappings, nil
+}
+
+type Nextcloud struct {
+	APIEndpoint string `yaml:"api_endpoint" env:"NEXTCLOUD_API_ENDPOINT"`
+	AssetPath   string `yaml:"asset_path" env:"NEXTCLOUD_ASSET_PATH"`
+	Username    string `yaml:"username" env:"NEXTCLOUD_USERNAME"`
+	Password    string `yaml:"password" env:"NEXTCLOUD_PASSWORD"`
+}
+
+type Delivery struct {
+	SMTPHost       string        `yaml:"smtp_host" env:"SMTP_HOST"`
+	SMTPPort       string        `yaml:"smtp_port" env:"SMTP_PORT"`
+	SenderEmail    string        `yaml:"sender_email" env:"SENDER_EMAIL"`
+	SenderPassword string        `yaml:"sender_password" env:"SENDER_PASSWORD"`
+	WebhookURL     string        `yaml:"webhook_url" env:"WEBHOOK_URL"`
+	WebhookSecret  string        `yaml:"webhook_secret" env:"WEBHOOK_SECRET"`
+	ChatWebhookURL string        `yaml:"chat_webhook_url" env:"CHAT_WEBHOOK_URL"`
+	MaxAttempts    int           `yaml:"max_attempts" env:"DELIVERY_MAX_ATTEMPTS"`
+	Backoff        time.Duration `yaml:"backoff" env:"DELIVERY_BACKOFF"`
+}
+
+type Submissions struct {
+	// ClosedStatuses are the statuses that finish a submission. Offboarding a
+	// user hands their submissions in any other status to a successor.
+	ClosedStatuses []string `yaml:"closed_statuses" env:"SUBMISSION_CLOSED_STATUSES"`
+}
+
+// Default returns the settings used when nothing overrides them.
+func Default() Config {
+	return Config{
+		Database: Database{
+			Port:     "5432",
+			SSLMode:  "disable",
+			MaxConns: 100,
+		},
+		Server: Server{
+			GRPCAddr:        ":50053",
+			GatewayAddr:     ":8080",
+			RESTAddr:        ":8081",
+			GRPCEndpoint:    "localhost:50053",
+			ShutdownTimeout: 30 * time.Second,
+		},
+		Auth: Auth{
+			AccessTokenTTL:     15 * time.Minute,
+			RefreshTokenTTL:    7 * 24 * time.Hour,
+			PasswordResetTTL:   time.Hour,
+			LoginMaxFailures:   5,
+			LoginMaxIPFailures: 30,
+			LoginBackoff:       time.Second,
+			LoginLockout:       15 * time.Minute,
+			TOTPIssuer:         "Asset Management",
+			AdminRoleId:        1,
+			Providers:          []string{"local"},
+		},
+		Passwords: Passwords{
+			MinLength: 8,
+			Classes:   []string{"letter", "digit"},
+			History:   5,
+		},
+		LDAP: LDAP{
+			NIPAttribute:   "employeeNumber",
+			NameAttribute:  "cn",
+			EmailAttribute: "mail",
+			GroupAttribute: "memberOf",
+			Timeout:        10 * time.Second,
+		},
+		OIDC: OIDC{
+			Scopes:      []string{"openid", "profile", "email"},
+			NIPClaim:    "nip",
+			GroupsClaim: "groups",
+		},
+		Delivery: Delivery{
+			SMTPPort:    "587",
+			MaxAttempts: 3,
+			Backoff:     2 * time.Second,
+		},
+		Submissions: Submissions{
+			// Setting a submission's status also sets its asset's, and an
+			// asset is back in service when it is Baik again
+			ClosedStatuses: []string{"Baik"},
+		},
+	}
+}
+
+// Load resolves the configuration and validates it. The YAML file is
+// CONFIG_FILE, or config.yaml when present.
+func Load() (Config, error) {
+	if err := godotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return Config{}, fmt.Errorf("config: load .env: %v", err)
+	}
+
+	cfg := Default()
+
+	path, explicit := os.LookupEnv("CONFIG_FILE")
+	if !explicit {
+		path = DefaultFile
+	}
+	if err := cfg.loadFile(path, explicit); err != nil {
+		return Config{}, err
+	}
+
+	if err := applyEnv(reflect.ValueOf(&cfg).Elem(), os.LookupEnv); err != nil {
+		return Config{}, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) loadFile(path string, required bool) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !required {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("config: read %s: %v", path, err)
+	}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return fmt.Errorf("config: parse %s: %v", path, err)
+	}
+	return nil
+}
+
+// Validate reports every missing required setting and invalid value at once.
+func (c Config) Validate() error {
+	var problems []string
+	walk(reflect.ValueOf(c), "", func(field reflect.StructField, value reflect.Value, path string) {
+		if field.Tag.Get("required") == "true" && value.IsZero() {
+			problems = append(problems, fmt.Sprintf("%s (%s) is required", path, field.Tag.Get("env")))
+		}
+	})
+
+	if c.Database.MaxConns <= 0 {
+		problems = append(problems, "database.max_conns (DB_MAX_CONNS) must be positive")
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		problems = append(problems, "server.shutdown_timeout (SHUTDOWN_TIMEOUT) must be positive")
+	}
+	if c.Server.TrustedProxyHops < 0 {
+		problems = append(problems, "server.trusted_proxy_hops (TRUSTED_PROXY_HOPS) must not be negative")
+	}
+	if c.Auth.JWTSigningKey == "" && c.Auth.JWTSecret == "" {
+		problems = append(problems, "auth.jwt_signing_key (JWT_SIGNING_KEY) or auth.jwt_secret (JWT_SECRET) is required")
+	}
+	if c.Auth.AccessTokenTTL <= 0 || c.Auth.RefreshTokenTTL <= c.Auth.AccessTokenTTL {
+		problems = append(problems, "auth.access_token_ttl (ACCESS_TOKEN_TTL) must be positive and shorter than auth.refresh_token_ttl (REFRESH_TOKEN_TTL)")
+	}
+	if c.Auth.PasswordResetTTL <= 0 {
+		problems = append(problems, "auth.password_reset_ttl (PASSWORD_RESET_TTL) must be positive")
+	}
+	if c.Auth.LoginMaxFailures <= 0 || c.Auth.LoginMaxIPFailures < c.Auth.LoginMaxFailures {
+		problems = append(problems, "auth.login_max_failures (LOGIN_MAX_FAILURES) must be positive and at most auth.login_max_ip_failures (LOGIN_MAX_IP_FAILURES)")
+	}
+	if c.Auth.LoginBackoff <= 0 || c.Auth.LoginLockout < c.Auth.LoginBackoff {
+		problems = append(problems, "auth.login_backoff (LOGIN_BACKOFF) must be positive and shorter than auth.login_lockout (LOGIN_LOCKOUT)")
+	}
+	problems = append(problems, c.validatePasswords()...)
+	problems = append(problems, c.validateProviders()...)
+	if c.Delivery.SMTPHost != "" && c.Delivery.SenderEmail == "" {
+		problems = append(problems, "delivery.sender_email (SENDER_EMAIL) is required when SMTP is configured")
+	}
+	if c.Delivery.MaxAttempts <= 0 {
+		problems = append(problems, "delivery.max_attempts (DELIVERY_MAX_ATTEMPTS) must be positive")
+	}
+	if len(c.Submissions.ClosedStatuses) == 0 {
+		problems = append(problems, "submissions.closed_statuses (SUBMISSION_CLOSED_STATUSES) must name at least one status")
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("config: invalid settings:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+	return nil
+}
+
+func (c Config) validatePasswords() []string {
+	var problems []string
+	// bcrypt only uses the first 72 bytes
+	if c.Passwords.MinLength <= 0 || c.Passwords.MinLength > 72 {
+		problems = append(problems, "passwords.min_length (PASSWORD_MIN_LENGTH) must be between 1 and 72")
+	}
+	for _, class := range c.Passwords.Classes {
+		known := false
+		for _, valid := range PasswordClasses {
+			known = known || class == valid
+		}
+		if !known {
+			problems = append(problems, fmt.Sprintf("passwords.classes (PASSWORD_CLASSES): unknown class %q, expected %s", class, strings.Join(PasswordClasses, ", ")))
+		}
+	}
+	if c.Passwords.History < 0 {
+		problems = append(problems, "passwords.history (PASSWORD_HISTORY) must not be negative")
+	}
+	return problems
+}
+
+func (c Config) validateProviders() []string {
+	var problems []string
+	if len(c.Auth.Providers) == 0 {
+		problems = append(problems, "auth.providers (AUTH_PROVIDERS) must name at least one provider")
+	}
+	for _, provider := range c.Auth.Providers {
+		switch provider {
+		case "local":
+		case "ldap":
+			if c.LDAP.URL == "" || c.LDAP.BaseDN == "" {
+				problems = append(problems, "ldap.url (LDAP_URL) and ldap.base_dn (LDAP_BASE_DN) are required for the ldap provider")
+			}
+			if c.LDAP.Timeout <= 0 {
+				problems = append(problems, "ldap.timeout (LDAP_TIMEOUT) must be positive")
+			}
+		case "oidc":
+			if c.OIDC.Issuer == "" || c.OIDC.ClientID == "" || c.OIDC.RedirectURL == "" {
+				problems = append(problems, "oidc.issuer (OIDC_ISSUER), oidc.client_id (OIDC_CLIENT_ID) and oidc.redirect_url (OIDC_REDIRECT_URL) are required for the oidc provider")
+			}
+		default:
+			problems = append(problems, fmt.Sprintf("auth.providers (AUTH_PROVIDERS): unknown provider %q, expected local, ldap or oidc", provider))
+		}
+	}
+	if c.Auth.AutoProvision && c.Auth.DefaultRoleId <= 0 {
+		problems = append(problems, "auth.default_role_id (AUTH_DEFAULT_ROLE_ID) is required when auth.auto_provision (AUTH_AUTO_PROVISION) is on")
+	}
+	if _, err := ParseGroupMappings(c.Auth.GroupRoles); err != nil {
+		problems = append(problems, fmt.Sprintf("auth.group_roles (AUTH_GROUP_ROLES): %v", err))
+	}
+	if _, err := ParseGroupMappings(c.Auth.GroupOutlets); err != nil {
+		problems = append(problems, fmt.Sprintf("auth.group_outlets (AUTH_GROUP_OUTLETS): %v", err))
+	}
+	return problems
+}
+
+// walk visits every leaf field with its dotted YAML path.
+func walk(v reflect.Value, prefix string, visit func(reflect.StructField, reflect.Value, string)) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		path := field.Tag.Get("yaml")
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		if field.Type.Kind() == reflect.Struct {
+			walk(v.Field(i), path, visit)
+			continue
+		}
+		visit(field, v.Field(i), path)
+	}
+}
+
+// applyEnv overrides fields from the variables named in their env tags.
+func applyEnv(v reflect.Value, lookup func(string) (string, bool)) error {
+	var err error
+	walk(v, "", func(field reflect.StructField, value reflect.Value, path string) {
+		name := field.Tag.Get("env")
+		raw, ok := lookup(name)
+		if name == "" || !ok || err != nil {
+			return
+		}
+		if setErr := setValue(value, raw); setErr != nil {
+			err = fmt.Errorf("config: %s: %v", name, setErr)
+		}
+	})
+	return err
+}
+
+func setValue(v reflect.Value, raw string) error {
+	switch v.Interface().(type) {
+	case string:
+		v.SetString(raw)
+	case time.Duration:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case int, int32, int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case []string:
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// MustLoad is Load for program entry points; it exits on invalid settings.
+func MustLoad() Config {
+	cfg, err := Load()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	return cfg
+}
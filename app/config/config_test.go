@@ -0,0 +1,267 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestDSN(t *testing.T) {
+	tests := []struct {
+		name string
+		db   Database
+	}{
+		{"plain", Database{Host: "db", Port: "5432", User: "asset", Password: "secret", Name: "assets", SSLMode: "disable"}},
+		{"password with URL characters", Database{Host: "db", Port: "5432", User: "asset", Password: "p@ss/w:rd#?%", Name: "assets", SSLMode: "require"}},
+		{"user with URL characters", Database{Host: "db", Port: "5432", User: "asset@corp", Password: "x", Name: "assets", SSLMode: "disable"}},
+		{"IPv6 host", Database{Host: "::1", Port: "6432", User: "asset", Password: "x", Name: "assets", SSLMode: "disable"}},
+		{"database name with spaces", Database{Host: "db", Port: "5432", User: "asset", Password: "x", Name: "asset db", SSLMode: "disable"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsn := tt.db.DSN()
+			cfg, err := pgconn.ParseConfig(dsn)
+			if err != nil {
+				t.Fatalf("ParseConfig(%q): %v", dsn, err)
+			}
+			if cfg.Host != tt.db.Host || strconv.Itoa(int(cfg.Port)) != tt.db.Port || cfg.User != tt.db.User ||
+				cfg.Password != tt.db.Password || cfg.Database != tt.db.Name {
+				t.Errorf("DSN %q parses as %s@%s:%d/%s with password %q", dsn, cfg.User, cfg.Host, cfg.Port, cfg.Database, cfg.Password)
+			}
+		})
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		check   func(Config) bool
+		wantErr string
+	}{
+		{"string", map[string]string{"DB_HOST": "db.internal"},
+			func(c Config) bool { return c.Database.Host == "db.internal" }, ""},
+		{"empty string clears", map[string]string{"DB_SSLMODE": ""},
+			func(c Config) bool { return c.Database.SSLMode == "" }, ""},
+		{"duration", map[string]string{"ACCESS_TOKEN_TTL": "5m"},
+			func(c Config) bool { return c.Auth.AccessTokenTTL == 5*time.Minute }, ""},
+		{"int", map[string]string{"TRUSTED_PROXY_HOPS": "2"},
+			func(c Config) bool { return c.Server.TrustedProxyHops == 2 }, ""},
+		{"int32", map[string]string{"DB_MAX_CONNS": "20"},
+			func(c Config) bool { return c.Database.MaxConns == 20 }, ""},
+		{"bool", map[string]string{"LDAP_START_TLS": "true"},
+			func(c Config) bool { return c.LDAP.StartTLS }, ""},
+		{"list", map[string]string{"AUTH_PROVIDERS": " ldap, ,local "},
+			func(c Config) bool { return reflect.DeepEqual(c.Auth.Providers, []string{"ldap", "local"}) }, ""},
+		{"unset keeps the value", nil,
+			func(c Config) bool { return c.Database.Port == "5432" }, ""},
+		{"bad duration", map[string]string{"ACCESS_TOKEN_TTL": "15"}, nil, "ACCESS_TOKEN_TTL"},
+		{"bad int", map[string]string{"TRUSTED_PROXY_HOPS": "two"}, nil, "TRUSTED_PROXY_HOPS"},
+		{"int32 overflow", map[string]string{"DB_MAX_CONNS": "3000000000"}, nil, "DB_MAX_CONNS"},
+		{"bad bool", map[string]string{"LDAP_START_TLS": "maybe"}, nil, "LDAP_START_TLS"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			lookup := func(name string) (string, bool) {
+				v, ok := tt.env[name]
+				return v, ok
+			}
+			err := applyEnv(reflect.ValueOf(&cfg).Elem(), lookup)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("err = %v, want one naming %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.check(cfg) {
+				t.Errorf("config after %v = %+v", tt.env, cfg)
+			}
+		})
+	}
+}
+
+// chdir runs the test in dir.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+}
+
+// unsetenv unsets name for the test, restoring it afterwards.
+func unsetenv(t *testing.T, name string) {
+	t.Helper()
+	t.Setenv(name, "")
+	os.Unsetenv(name)
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	const yamlFile = `
+database:
+  host: yaml-host
+  user: asset
+  name: yaml-db
+  port: "6000"
+auth:
+  jwt_secret: secret
+  access_token_ttl: 10m
+`
+	tests := []struct {
+		name     string
+		dotenv   string
+		env      map[string]string
+		wantHost string
+		wantName string
+		wantPort string
+	}{
+		{"file over defaults", "", nil, "yaml-host", "yaml-db", "6000"},
+		{".env over the file", "DB_PORT=7000\nDB_NAME=dotenv-db\n", nil, "yaml-host", "dotenv-db", "7000"},
+		{"environment over .env", "DB_PORT=7000\nDB_NAME=dotenv-db\n", map[string]string{"DB_PORT": "8000"}, "yaml-host", "dotenv-db", "8000"},
+		{"environment over the file", "", map[string]string{"DB_HOST": "env-host"}, "env-host", "yaml-db", "6000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(yamlFile), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if tt.dotenv != "" {
+				if err := os.WriteFile(filepath.Join(dir, ".env"), []byte(tt.dotenv), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			chdir(t, dir)
+			// Whatever .env loads is undone after the test
+			for _, name := range []string{"CONFIG_FILE", "DB_HOST", "DB_PORT", "DB_NAME", "DB_USER", "JWT_SECRET", "ACCESS_TOKEN_TTL"} {
+				unsetenv(t, name)
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			cfg, err := Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Database.Host != tt.wantHost || cfg.Database.Name != tt.wantName || cfg.Database.Port != tt.wantPort {
+				t.Errorf("database = %+v, want host %s, name %s, port %s", cfg.Database, tt.wantHost, tt.wantName, tt.wantPort)
+			}
+			// Defaults fill what nothing sets, and the file's other values stay
+			if cfg.Database.SSLMode != "disable" || cfg.Auth.AccessTokenTTL != 10*time.Minute {
+				t.Errorf("sslmode = %q, access TTL = %v", cfg.Database.SSLMode, cfg.Auth.AccessTokenTTL)
+			}
+		})
+	}
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	chdir(t, t.TempDir())
+	unsetenv(t, "CONFIG_FILE")
+	for _, name := range []string{"DB_HOST", "DB_USER", "DB_NAME", "JWT_SECRET"} {
+		t.Setenv(name, "x")
+	}
+	// config.yaml is optional
+	if _, err := Load(); err != nil {
+		t.Errorf("without config.yaml: %v", err)
+	}
+
+	// An explicit CONFIG_FILE is not
+	t.Setenv("CONFIG_FILE", "missing.yaml")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "missing.yaml") {
+		t.Errorf("missing CONFIG_FILE: err = %v", err)
+	}
+
+	if err := os.WriteFile("broken.yaml", []byte("database: [\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CONFIG_FILE", "broken.yaml")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "parse broken.yaml") {
+		t.Errorf("broken CONFIG_FILE: err = %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() Config {
+		cfg := Default()
+		cfg.Database.Host, cfg.Database.User, cfg.Database.Name = "db", "asset", "assets"
+		cfg.Auth.JWTSecret = "secret"
+		return cfg
+	}
+	if err := valid().Validate(); err != nil {
+		t.Fatalf("valid config: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(*Config)
+		want   []string
+	}{
+		{"nothing set", func(c *Config) { *c = Default() }, []string{
+			"database.host (DB_HOST) is required",
+			"database.user (DB_USER) is required",
+			"database.name (DB_NAME) is required",
+			"auth.jwt_signing_key (JWT_SIGNING_KEY) or auth.jwt_secret (JWT_SECRET) is required",
+		}},
+		{"durations", func(c *Config) {
+			c.Server.ShutdownTimeout = 0
+			c.Auth.AccessTokenTTL = 2 * time.Hour
+			c.Auth.RefreshTokenTTL = time.Hour
+		}, []string{"server.shutdown_timeout", "auth.access_token_ttl"}},
+		{"login limits", func(c *Config) {
+			c.Auth.LoginMaxFailures = 10
+			c.Auth.LoginMaxIPFailures = 5
+			c.Auth.LoginLockout = 0
+		}, []string{"auth.login_max_failures", "auth.login_backoff"}},
+		{"passwords", func(c *Config) {
+			c.Passwords.MinLength = 100
+			c.Passwords.Classes = []string{"digit", "emoji"}
+		}, []string{"passwords.min_length", `unknown class "emoji"`}},
+		{"providers", func(c *Config) {
+			c.Auth.Providers = []string{"ldap", "oidc", "saml"}
+			c.LDAP.Timeout = 0
+		}, []string{"ldap.url (LDAP_URL)", "ldap.timeout", "oidc.issuer", `unknown provider "saml"`}},
+		{"provisioning", func(c *Config) {
+			c.Auth.AutoProvision = true
+			c.Auth.GroupRoles = []string{"admins"}
+		}, []string{"auth.default_role_id", "auth.group_roles"}},
+		{"delivery", func(c *Config) {
+			c.Delivery.SMTPHost = "smtp.example.com"
+			c.Delivery.MaxAttempts = 0
+		}, []string{"delivery.sender_email", "delivery.max_attempts"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.change(&cfg)
+			err := cfg.Validate()
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			// Every problem is reported, one per line
+			lines := strings.Split(err.Error(), "\n")[1:]
+			if len(lines) != len(tt.want) {
+				t.Errorf("got %d problems, want %d:\n%v", len(lines), len(tt.want), err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error does not mention %q:\n%v", want, err)
+				}
+			}
+		})
+	}
+}
@@ -1,45 +1,27 @@
 package database
 
 import (
+	"asset-management-api/app/config"
 	"context"
-	"fmt"
-	"os"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/joho/godotenv"
 	"github.com/rs/zerolog/log"
 )
 
-func DBConn() *pgxpool.Pool {
-	// Load environment variables
-	err := godotenv.Load(".env")
-	if err != nil {
-		log.Fatal().Err(err).Msg("Error loading .env file")
-	}
-
-	// Format DSN
-	dsn := fmt.Sprintf(
-		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
-		os.Getenv("DB_USER"),
-		os.Getenv("DB_PASSWORD"),
-		os.Getenv("DB_HOST"),
-		os.Getenv("DB_PORT"),
-		os.Getenv("DB_NAME"),
-	)
-
+func DBConn(cfg config.Database) *pgxpool.Pool {
 	log.Info().Msg("Connecting to database...")
 
 	// Configure connection pool
-	config, err := pgxpool.ParseConfig(dsn)
+	poolConfig, err := pgxpool.ParseConfig(cfg.DSN())
 	if err != nil {
 		log.Fatal().Err(err).Msg("Unable to parse database configuration")
 	}
 
-	config.MaxConns = 100
+	poolConfig.MaxConns = cfg.MaxConns
 
 	// Create connection pool
-	dbpool, err := pgxpool.NewWithConfig(context.Background(), config)
+	dbpool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Unable to create connection pool")
 	}
@@ -0,0 +1,35 @@
+package delivery
+
+import "context"
+
+// Channel names as stored in user_channel_preferences.
+const (
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+	ChannelChat    = "chat"
+)
+
+// Recipient is the user a message is delivered to. Address is the
+// channel-specific destination (an email address or a webhook URL); when it is
+// empty the channel falls back to its configured default.
+type Recipient struct {
+	Nip     int32
+	Name    string
+	Address string
+	Locale  string
+}
+
+// Message is a rendered notification ready to be sent.
+type Message struct {
+	Template string
+	Subject  string
+	Text     string
+	HTML     string
+	Data     map[string]interface{}
+}
+
+// Channel delivers a message to a recipient over one transport.
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, to Recipient, msg Message) error
+}
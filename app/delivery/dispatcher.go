@@ -0,0 +1,244 @@
+package delivery
+
+import (
+	"asset-management-api/app/config"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+// querier is implemented by *pgxpool.Pool.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Dispatcher renders a template for each recipient and sends it over every
+// channel the recipient enabled, retrying with exponential backoff and
+// recording each outcome in notification_delivery_logs.
+type Dispatcher struct {
+	DB          querier
+	Templates   *Templates
+	MaxAttempts int
+	Backoff     time.Duration
+	channels    map[string]Channel
+}
+
+func NewDispatcher(db *pgxpool.Pool, channels ...Channel) *Dispatcher {
+	templates, err := LoadTemplates(templateFS)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load delivery templates")
+	}
+
+	d := &Dispatcher{
+		DB:          db,
+		Templates:   templates,
+		MaxAttempts: 3,
+		Backoff:     2 * time.Second,
+		channels:    make(map[string]Channel),
+	}
+	for _, ch := range channels {
+		d.channels[ch.Name()] = ch
+	}
+	return d
+}
+
+// ChannelsFromConfig builds the channels that have settings configured.
+func ChannelsFromConfig(cfg config.Delivery) []Channel {
+	var channels []Channel
+	if cfg.SMTPHost != "" {
+		channels = append(channels, &SMTPChannel{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SenderEmail,
+			Password: cfg.SenderPassword,
+			From:     cfg.SenderEmail,
+		})
+	}
+	if cfg.WebhookURL != "" {
+		channels = append(channels, &WebhookChannel{URL: cfg.WebhookURL, Secret: cfg.WebhookSecret})
+	}
+	if cfg.ChatWebhookURL != "" {
+		channels = append(channels, &ChatChannel{URL: cfg.ChatWebhookURL})
+	}
+	return channels
+}
+
+// Channel returns the configured channel with the given name.
+func (d *Dispatcher) Channel(name string) (Channel, bool) {
+	ch, ok := d.channels[name]
+	return ch, ok
+}
+
+type target struct {
+	channel   Channel
+	recipient Recipient
+}
+
+// Notify delivers the template to the given users. Errors are logged rather
+// than returned so callers can fire it off without blocking their request.
+func (d *Dispatcher) Notify(ctx context.Context, template string, nips []int32, data map[string]interface{}) {
+	targets, err := d.targets(ctx, nips, "")
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve notification recipients")
+		return
+	}
+	d.deliver(ctx, template, targets, data)
+}
+
+// NotifyChannel is Notify restricted to one channel, for callers such as rules
+// that name the channel explicitly.
+func (d *Dispatcher) NotifyChannel(ctx context.Context, channel, template string, nips []int32, data map[string]interface{}) {
+	targets, err := d.targets(ctx, nips, channel)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve notification recipients")
+		return
+	}
+	d.deliver(ctx, template, targets, data)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, template string, targets []target, data map[string]interface{}) {
+	for _, t := range targets {
+		msgData := make(map[string]interface{}, len(data)+1)
+		for k, v := range data {
+			msgData[k] = v
+		}
+		msgData["RecipientName"] = t.recipient.Name
+
+		msg, err := d.Templates.Render(template, t.recipient.Locale, msgData)
+		if err != nil {
+			log.Error().Err(err).Str("template", template).Msg("Failed to render notification")
+			continue
+		}
+		d.Send(ctx, t.channel, t.recipient, msg)
+	}
+}
+
+// Send delivers one message with retries and records the outcome.
+func (d *Dispatcher) Send(ctx context.Context, ch Channel, to Recipient, msg Message) error {
+	var err error
+	attempts := 1
+retry:
+	for ; ; attempts++ {
+		err = ch.Send(ctx, to, msg)
+		if err == nil || attempts >= d.MaxAttempts {
+			break
+		}
+
+		wait := d.Backoff << (attempts - 1)
+		log.Warn().Err(err).Str("channel", ch.Name()).Int32("nip", to.Nip).Msgf("Delivery failed, retrying in %s", wait)
+		select {
+		case <-ctx.Done():
+			break retry
+		case <-time.After(wait):
+		}
+	}
+
+	d.record(ch.Name(), to, msg, attempts, err)
+	return err
+}
+
+func (d *Dispatcher) record(channel string, to Recipient, msg Message, attempts int, sendErr error) {
+	status := "sent"
+	var errMsg *string
+	if sendErr != nil {
+		status = "failed"
+		e := sendErr.Error()
+		errMsg = &e
+		log.Error().Err(sendErr).Str("channel", channel).Int32("nip", to.Nip).Msg("Failed to deliver notification")
+	}
+
+	_, err := d.DB.Exec(context.Background(), `
+        INSERT INTO notification_delivery_logs (nip, channel, template, subject, address, status, attempts, error)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		to.Nip, channel, msg.Template, msg.Subject, to.Address, status, attempts, errMsg)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to record delivery log")
+	}
+}
+
+// targets resolves the enabled channels for each active user. Users without
+// stored preferences get email at their account address. When only is
+// non-empty the result is restricted to that channel.
+func (d *Dispatcher) targets(ctx context.Context, nips []int32, only string) ([]target, error) {
+	if len(nips) == 0 {
+		return nil, nil
+	}
+
+	rows, err := d.DB.Query(ctx, `
+        SELECT u.nip, u.user_full_name, COALESCE(u.user_email, ''), COALESCE(s.locale, ''),
+               p.channel, COALESCE(p.enabled, FALSE), COALESCE(p.address, '')
+        FROM users u
+        LEFT JOIN user_notification_settings s ON s.nip = u.nip
+        LEFT JOIN user_channel_preferences p ON p.nip = u.nip
+        WHERE u.nip = ANY($1) AND u.disabled_at IS NULL`, nips)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type prefs struct {
+		recipient Recipient
+		email     string
+		channels  map[string]string
+		hasPrefs  bool
+	}
+	users := make(map[int32]*prefs)
+	var order []int32
+	for rows.Next() {
+		var nip int32
+		var name, email, locale, address string
+		var channel *string
+		var enabled bool
+		if err := rows.Scan(&nip, &name, &email, &locale, &channel, &enabled, &address); err != nil {
+			return nil, err
+		}
+		u, ok := users[nip]
+		if !ok {
+			if locale == "" {
+				locale = DefaultLocale
+			}
+			u = &prefs{recipient: Recipient{Nip: nip, Name: name, Locale: locale}, email: email, channels: make(map[string]string)}
+			users[nip] = u
+			order = append(order, nip)
+		}
+		if channel != nil {
+			u.hasPrefs = true
+			if enabled {
+				u.channels[*channel] = address
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var targets []target
+	for _, nip := range order {
+		u := users[nip]
+		if !u.hasPrefs {
+			u.channels[ChannelEmail] = ""
+		}
+		for name, address := range u.channels {
+			if only != "" && name != only {
+				continue
+			}
+			ch, ok := d.channels[name]
+			if !ok {
+				continue
+			}
+			if name == ChannelEmail && address == "" {
+				address = u.email
+			}
+			r := u.recipient
+			r.Address = address
+			targets = append(targets, target{channel: ch, recipient: r})
+		}
+	}
+	return targets, nil
+}
@@ -0,0 +1,220 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// deliveryLog is a row written to notification_delivery_logs.
+type deliveryLog struct {
+	nip      int32
+	channel  string
+	template string
+	address  string
+	status   string
+	attempts int
+	err      *string
+}
+
+// fakeDB records delivery logs and answers QueryRow with a single row.
+type fakeDB struct {
+	mu   sync.Mutex
+	logs []deliveryLog
+	row  []interface{}
+}
+
+func (db *fakeDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	if !strings.Contains(sql, "notification_delivery_logs") {
+		return pgconn.CommandTag{}, fmt.Errorf("unexpected statement: %s", sql)
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.logs = append(db.logs, deliveryLog{
+		nip:      args[0].(int32),
+		channel:  args[1].(string),
+		template: args[2].(string),
+		address:  args[4].(string),
+		status:   args[5].(string),
+		attempts: args[6].(int),
+		err:      args[7].(*string),
+	})
+	return pgconn.NewCommandTag("INSERT 0 1"), nil
+}
+
+func (db *fakeDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return nil, errors.New("fakeDB does not support Query")
+}
+
+func (db *fakeDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return fakeRow(db.row)
+}
+
+func (db *fakeDB) written() []deliveryLog {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return append([]deliveryLog(nil), db.logs...)
+}
+
+type fakeRow []interface{}
+
+func (r fakeRow) Scan(dest ...interface{}) error {
+	if r == nil {
+		return pgx.ErrNoRows
+	}
+	for i, d := range dest {
+		*d.(*string) = r[i].(string)
+	}
+	return nil
+}
+
+func newTestDispatcher(db *fakeDB, channels ...Channel) *Dispatcher {
+	d := NewDispatcher(nil, channels...)
+	d.DB = db
+	d.Backoff = time.Millisecond
+	return d
+}
+
+func TestSendRetries(t *testing.T) {
+	tests := []struct {
+		name string
+		// failures is how many attempts fail before the channel accepts
+		failures     int
+		wantStatus   string
+		wantAttempts int
+	}{
+		{"first attempt", 0, "sent", 1},
+		{"after retries", 2, "sent", 3},
+		{"dead letter", 3, "failed", 3},
+	}
+
+	channels := map[string]func(t *testing.T, failures int) (Channel, func() int){
+		ChannelEmail: func(t *testing.T, failures int) (Channel, func() int) {
+			stub := newSMTPStub(t)
+			stub.setRefuse(failures)
+			return stub.channel(), func() int { return len(stub.received()) }
+		},
+		ChannelWebhook: func(t *testing.T, failures int) (Channel, func() int) {
+			var statuses []int
+			for i := 0; i < failures; i++ {
+				statuses = append(statuses, http.StatusServiceUnavailable)
+			}
+			receiver := newWebhookReceiver(t, statuses...)
+			return &WebhookChannel{URL: receiver.URL}, func() int { return len(receiver.received()) - failures }
+		},
+	}
+
+	for name, newChannel := range channels {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				ch, delivered := newChannel(t, tt.failures)
+				db := &fakeDB{}
+				to := Recipient{Nip: 1001, Address: "budi@example.com"}
+				if name == ChannelWebhook {
+					to.Address = ""
+				}
+
+				err := newTestDispatcher(db, ch).Send(context.Background(), ch, to, Message{Template: TemplateMaintenanceDue})
+				if (err != nil) != (tt.wantStatus == "failed") {
+					t.Errorf("Send() error = %v", err)
+				}
+				if want := map[bool]int{true: 1, false: 0}[tt.wantStatus == "sent"]; delivered() != want {
+					t.Errorf("delivered %d messages, want %d", delivered(), want)
+				}
+
+				logs := db.written()
+				if len(logs) != 1 {
+					t.Fatalf("got %d delivery logs, want 1", len(logs))
+				}
+				log := logs[0]
+				if log.nip != 1001 || log.channel != name || log.template != TemplateMaintenanceDue ||
+					log.status != tt.wantStatus || log.attempts != tt.wantAttempts {
+					t.Errorf("log = %+v, want status %s after %d attempts", log, tt.wantStatus, tt.wantAttempts)
+				}
+				if (log.err != nil) != (tt.wantStatus == "failed") {
+					t.Errorf("log error = %v", log.err)
+				}
+			})
+		}
+	}
+}
+
+func TestSendStopsRetryingWhenCancelled(t *testing.T) {
+	receiver := newWebhookReceiver(t, http.StatusInternalServerError, http.StatusInternalServerError)
+	ch := &WebhookChannel{URL: receiver.URL}
+	db := &fakeDB{}
+	d := newTestDispatcher(db, ch)
+	d.Backoff = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for len(receiver.received()) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+	}()
+
+	done := make(chan error, 1)
+	go func() { done <- d.Send(ctx, ch, Recipient{Nip: 1}, Message{}) }()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("expected the failure to be returned")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Send kept waiting after the context was cancelled")
+	}
+
+	logs := db.written()
+	if len(logs) != 1 || logs[0].status != "failed" || logs[0].attempts != 1 {
+		t.Errorf("logs = %+v, want one failed attempt", logs)
+	}
+}
+
+func TestMail(t *testing.T) {
+	stub := newSMTPStub(t)
+	db := &fakeDB{row: []interface{}{"Budi", "budi@example.com", "en"}}
+	d := newTestDispatcher(db, stub.channel(), &WebhookChannel{URL: "http://127.0.0.1:1"})
+
+	err := d.Mail(context.Background(), 1001, TemplatePasswordReset, map[string]interface{}{
+		"ResetLink":        "https://assets.example.com/reset-password?token=abc",
+		"ExpiresInMinutes": 60,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := stub.received()
+	if len(received) != 1 {
+		t.Fatalf("got %d messages, want 1", len(received))
+	}
+	data := received[0].data
+	for _, want := range []string{"Subject: Reset your password", "Hello Budi", "reset-password?token=abc"} {
+		if !strings.Contains(data, want) {
+			t.Errorf("message lacks %q:\n%s", want, data)
+		}
+	}
+	if logs := db.written(); len(logs) != 1 || logs[0].channel != ChannelEmail || logs[0].status != "sent" {
+		t.Errorf("logs = %+v", logs)
+	}
+}
+
+func TestMailErrors(t *testing.T) {
+	if err := newTestDispatcher(&fakeDB{}).Mail(context.Background(), 1, TemplatePasswordReset, nil); !errors.Is(err, ErrNoEmailChannel) {
+		t.Errorf("without email: err = %v, want ErrNoEmailChannel", err)
+	}
+
+	stub := newSMTPStub(t)
+	err := newTestDispatcher(&fakeDB{}, stub.channel()).Mail(context.Background(), 1, TemplatePasswordReset, nil)
+	if !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("unknown user: err = %v, want pgx.ErrNoRows", err)
+	}
+}
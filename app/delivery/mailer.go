@@ -0,0 +1,49 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoEmailChannel means email is not configured.
+var ErrNoEmailChannel = errors.New("email is not configured")
+
+// Mailer sends account email, such as password resets, to a user's own
+// address regardless of their notification preferences.
+type Mailer interface {
+	Mail(ctx context.Context, nip int32, template string, data map[string]interface{}) error
+}
+
+// Mail renders template in the user's locale and sends it over the email
+// channel to their account address.
+func (d *Dispatcher) Mail(ctx context.Context, nip int32, template string, data map[string]interface{}) error {
+	ch, ok := d.channels[ChannelEmail]
+	if !ok {
+		return ErrNoEmailChannel
+	}
+
+	to := Recipient{Nip: nip}
+	err := d.DB.QueryRow(ctx, `
+        SELECT u.user_full_name, COALESCE(u.user_email, ''), COALESCE(s.locale, '')
+        FROM users u
+        LEFT JOIN user_notification_settings s ON s.nip = u.nip
+        WHERE u.nip = $1`, nip).Scan(&to.Name, &to.Address, &to.Locale)
+	if err != nil {
+		return err
+	}
+	if to.Locale == "" {
+		to.Locale = DefaultLocale
+	}
+
+	msgData := make(map[string]interface{}, len(data)+1)
+	for k, v := range data {
+		msgData[k] = v
+	}
+	msgData["RecipientName"] = to.Name
+
+	msg, err := d.Templates.Render(template, to.Locale, msgData)
+	if err != nil {
+		return err
+	}
+	return d.Send(ctx, ch, to, msg)
+}
@@ -0,0 +1,66 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPChannel sends multipart plain-text and HTML email. Authentication is
+// skipped when Username is empty, which is what a local SMTP stub expects.
+type SMTPChannel struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+func (c *SMTPChannel) Name() string {
+	return ChannelEmail
+}
+
+func (c *SMTPChannel) Send(ctx context.Context, to Recipient, msg Message) error {
+	if to.Address == "" {
+		return errors.New("recipient has no email address")
+	}
+
+	var auth smtp.Auth
+	if c.Username != "" {
+		auth = smtp.PlainAuth("", c.Username, c.Password, c.Host)
+	}
+
+	boundary := fmt.Sprintf("asset-%d", time.Now().UnixNano())
+	var body strings.Builder
+	fmt.Fprintf(&body, "From: %s\r\n", c.From)
+	fmt.Fprintf(&body, "To: %s\r\n", to.Address)
+	fmt.Fprintf(&body, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&body, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	body.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&body, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+	fmt.Fprintf(&body, "--%s\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n%s\r\n", boundary, msg.Text)
+	if msg.HTML != "" {
+		fmt.Fprintf(&body, "--%s\r\nContent-Type: text/html; charset=utf-8\r\n\r\n%s\r\n", boundary, msg.HTML)
+	}
+	fmt.Fprintf(&body, "--%s--\r\n", boundary)
+
+	// net/smtp has no context support, so run it aside and honour cancellation
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(net.JoinHostPort(c.Host, c.Port), auth, c.From, []string{to.Address}, []byte(body.String()))
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("failed to send email: %v", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,207 @@
+package delivery
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// smtpStub is a local SMTP server that keeps the messages it accepts.
+type smtpStub struct {
+	host, port string
+
+	mu       sync.Mutex
+	messages []smtpMessage
+	// refuse is how many of the next transactions get a temporary error
+	refuse int
+}
+
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+func newSMTPStub(t *testing.T) *smtpStub {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	s := &smtpStub{}
+	s.host, s.port, _ = net.SplitHostPort(ln.Addr().String())
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+// channel returns an SMTPChannel sending to the stub without authentication.
+func (s *smtpStub) channel() *SMTPChannel {
+	return &SMTPChannel{Host: s.host, Port: s.port, From: "noreply@example.com"}
+}
+
+func (s *smtpStub) setRefuse(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refuse = n
+}
+
+func (s *smtpStub) received() []smtpMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]smtpMessage(nil), s.messages...)
+}
+
+func (s *smtpStub) serve(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost ESMTP stub")
+
+	var msg smtpMessage
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "MAIL":
+			s.mu.Lock()
+			refused := s.refuse > 0
+			if refused {
+				s.refuse--
+			}
+			s.mu.Unlock()
+			if refused {
+				tp.PrintfLine("451 4.3.0 try again later")
+				continue
+			}
+			msg = smtpMessage{from: strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")}
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			msg.to = append(msg.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 end with .")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			msg.data = string(data)
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK")
+		case "RSET", "NOOP":
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPChannelSend(t *testing.T) {
+	stub := newSMTPStub(t)
+	msg := Message{
+		Subject: "Perawatan AC – hari ini",
+		Text:    "Plain body",
+		HTML:    "<p>HTML body</p>",
+	}
+	if err := stub.channel().Send(context.Background(), Recipient{Nip: 1, Address: "budi@example.com"}, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	received := stub.received()
+	if len(received) != 1 {
+		t.Fatalf("got %d messages, want 1", len(received))
+	}
+	got := received[0]
+	if got.from != "noreply@example.com" || len(got.to) != 1 || got.to[0] != "budi@example.com" {
+		t.Errorf("envelope from %q to %v", got.from, got.to)
+	}
+
+	m, err := mail.ReadMessage(strings.NewReader(got.data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil || subject != msg.Subject {
+		t.Errorf("subject = %q (%v), want %q", subject, err, msg.Subject)
+	}
+	if to := m.Header.Get("To"); to != "budi@example.com" {
+		t.Errorf("To = %q", to)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("content type %q (%v)", mediaType, err)
+	}
+	parts := multipart.NewReader(m.Body, params["boundary"])
+	for _, want := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	} {
+		part, err := parts.NextPart()
+		if err != nil {
+			t.Fatalf("part %s: %v", want.contentType, err)
+		}
+		body, _ := io.ReadAll(part)
+		if part.Header.Get("Content-Type") != want.contentType || strings.TrimSpace(string(body)) != want.body {
+			t.Errorf("part %q = %q, want %q %q", part.Header.Get("Content-Type"), body, want.contentType, want.body)
+		}
+	}
+	if _, err := parts.NextPart(); err != io.EOF {
+		t.Errorf("expected two parts, next is %v", err)
+	}
+}
+
+func TestSMTPChannelTextOnly(t *testing.T) {
+	stub := newSMTPStub(t)
+	err := stub.channel().Send(context.Background(), Recipient{Address: "budi@example.com"}, Message{Subject: "Hi", Text: "Plain"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := stub.received()
+	if len(received) != 1 || strings.Contains(received[0].data, "text/html") {
+		t.Errorf("expected one message without an HTML part, got %+v", received)
+	}
+}
+
+func TestSMTPChannelErrors(t *testing.T) {
+	stub := newSMTPStub(t)
+
+	if err := stub.channel().Send(context.Background(), Recipient{}, Message{Text: "x"}); err == nil {
+		t.Error("expected an error without an address")
+	}
+
+	stub.setRefuse(1)
+	err := stub.channel().Send(context.Background(), Recipient{Address: "budi@example.com"}, Message{Text: "x"})
+	if err == nil || !strings.Contains(err.Error(), "451") {
+		t.Errorf("refused send: err = %v, want the server's 451", err)
+	}
+
+	if n := len(stub.received()); n != 0 {
+		t.Errorf("stub received %d messages, want 0", n)
+	}
+}
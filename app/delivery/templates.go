@@ -0,0 +1,103 @@
+package delivery
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+)
+
+// DefaultLocale is used when a user has not chosen a language.
+const DefaultLocale = "id"
+
+// Template names shipped in templates/<locale>/<name>.tmpl.
+const (
+	TemplateMaintenanceDue    = "maintenance_due"
+	TemplateMaintenanceLate   = "maintenance_late"
+	TemplateSubmissionCreated = "submission_created"
+	TemplateDigest            = "digest"
+
+	TemplateMaintenanceEscalated = "maintenance_escalated"
+	TemplateAssetRule            = "asset_rule"
+
+	TemplatePasswordReset = "password_reset"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// Templates holds the localized message templates. Each file defines
+// "subject", "text" and "html" blocks; the HTML block is escaped with
+// html/template while the others are rendered as plain text.
+type Templates struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+func LoadTemplates(fsys fs.FS) (*Templates, error) {
+	t := &Templates{
+		text: make(map[string]*texttemplate.Template),
+		html: make(map[string]*htmltemplate.Template),
+	}
+
+	files, err := fs.Glob(fsys, "templates/*/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		locale := path.Base(path.Dir(file))
+		key := locale + "/" + strings.TrimSuffix(path.Base(file), ".tmpl")
+
+		textTmpl, err := texttemplate.New(key).Option("missingkey=zero").Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %v", file, err)
+		}
+		htmlTmpl, err := htmltemplate.New(key).Option("missingkey=zero").Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %v", file, err)
+		}
+		t.text[key] = textTmpl
+		t.html[key] = htmlTmpl
+	}
+	return t, nil
+}
+
+// Render builds the message for a template, falling back to DefaultLocale
+// when the template is not translated into locale.
+func (t *Templates) Render(name, locale string, data map[string]interface{}) (Message, error) {
+	key := locale + "/" + name
+	if _, ok := t.text[key]; !ok {
+		key = DefaultLocale + "/" + name
+	}
+	textTmpl, ok := t.text[key]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown template %q", name)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := textTmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, err
+	}
+	if err := textTmpl.ExecuteTemplate(&text, "text", data); err != nil {
+		return Message{}, err
+	}
+	if err := t.html[key].ExecuteTemplate(&html, "html", data); err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		Template: name,
+		Subject:  strings.TrimSpace(subject.String()),
+		Text:     strings.TrimSpace(text.String()),
+		HTML:     strings.TrimSpace(html.String()),
+		Data:     data,
+	}, nil
+}
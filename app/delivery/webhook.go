@@ -0,0 +1,119 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WebhookChannel posts the message as JSON to a generic HTTP endpoint. When a
+// secret is configured the body is signed in the X-Signature-256 header.
+type WebhookChannel struct {
+	URL    string
+	Secret string
+	Client *http.Client
+}
+
+type webhookPayload struct {
+	Event     string                 `json:"event"`
+	Nip       int32                  `json:"nip"`
+	Subject   string                 `json:"subject"`
+	Text      string                 `json:"text"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	Timestamp string                 `json:"timestamp"`
+}
+
+func (c *WebhookChannel) Name() string {
+	return ChannelWebhook
+}
+
+func (c *WebhookChannel) Send(ctx context.Context, to Recipient, msg Message) error {
+	payload, err := json.Marshal(webhookPayload{
+		Event:     msg.Template,
+		Nip:       to.Nip,
+		Subject:   msg.Subject,
+		Text:      msg.Text,
+		Data:      msg.Data,
+		Timestamp: time.Now().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	headers := map[string]string{}
+	if c.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(c.Secret))
+		mac.Write(payload)
+		headers["X-Signature-256"] = "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	return postJSON(ctx, c.Client, firstNonEmpty(to.Address, c.URL), payload, headers)
+}
+
+// ChatChannel posts to chat incoming webhooks. Slack, Mattermost, Google Chat
+// and Microsoft Teams all accept a JSON body with a "text" field.
+type ChatChannel struct {
+	URL    string
+	Client *http.Client
+}
+
+func (c *ChatChannel) Name() string {
+	return ChannelChat
+}
+
+func (c *ChatChannel) Send(ctx context.Context, to Recipient, msg Message) error {
+	payload, err := json.Marshal(map[string]string{
+		"text": fmt.Sprintf("*%s*\n%s", msg.Subject, msg.Text),
+	})
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, c.Client, firstNonEmpty(to.Address, c.URL), payload, nil)
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, payload []byte, headers map[string]string) error {
+	if url == "" {
+		return errors.New("no webhook URL configured")
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
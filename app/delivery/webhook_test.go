@@ -0,0 +1,133 @@
+package delivery
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// webhookReceiver is an httptest server answering with the given statuses in
+// turn, then 200, and keeping the requests it got.
+type webhookReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	requests []webhookRequest
+}
+
+type webhookRequest struct {
+	path   string
+	header http.Header
+	body   []byte
+}
+
+func newWebhookReceiver(t *testing.T, statuses ...int) *webhookReceiver {
+	t.Helper()
+	r := &webhookReceiver{statuses: statuses}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		r.requests = append(r.requests, webhookRequest{path: req.URL.Path, header: req.Header.Clone(), body: body})
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		r.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *webhookReceiver) received() []webhookRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]webhookRequest(nil), r.requests...)
+}
+
+func TestWebhookChannelSend(t *testing.T) {
+	receiver := newWebhookReceiver(t)
+	ch := &WebhookChannel{URL: receiver.URL + "/hook", Secret: "s3cret"}
+	msg := Message{
+		Template: TemplateMaintenanceDue,
+		Subject:  "Maintenance due",
+		Text:     "AC needs maintenance",
+		Data:     map[string]interface{}{"AssetName": "AC"},
+	}
+	if err := ch.Send(context.Background(), Recipient{Nip: 1001}, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := receiver.received()
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+	req := requests[0]
+	if req.path != "/hook" || req.header.Get("Content-Type") != "application/json" {
+		t.Errorf("request to %s with content type %q", req.path, req.header.Get("Content-Type"))
+	}
+
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(req.body)
+	if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); req.header.Get("X-Signature-256") != want {
+		t.Errorf("signature = %q, want %q", req.header.Get("X-Signature-256"), want)
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Event != msg.Template || payload.Nip != 1001 || payload.Subject != msg.Subject ||
+		payload.Text != msg.Text || payload.Data["AssetName"] != "AC" || payload.Timestamp == "" {
+		t.Errorf("payload = %+v", payload)
+	}
+}
+
+func TestWebhookChannelRecipientAddress(t *testing.T) {
+	receiver := newWebhookReceiver(t)
+	ch := &WebhookChannel{URL: receiver.URL + "/default"}
+	if err := ch.Send(context.Background(), Recipient{Address: receiver.URL + "/own"}, Message{}); err != nil {
+		t.Fatal(err)
+	}
+	requests := receiver.received()
+	if len(requests) != 1 || requests[0].path != "/own" {
+		t.Errorf("requests = %+v, want one to /own", requests)
+	}
+	if requests[0].header.Get("X-Signature-256") != "" {
+		t.Error("unsigned webhook has a signature")
+	}
+}
+
+func TestWebhookChannelErrors(t *testing.T) {
+	receiver := newWebhookReceiver(t, http.StatusInternalServerError)
+
+	err := (&WebhookChannel{URL: receiver.URL}).Send(context.Background(), Recipient{}, Message{})
+	if err == nil || !strings.Contains(err.Error(), "500") {
+		t.Errorf("err = %v, want the 500 status", err)
+	}
+
+	if err := (&WebhookChannel{}).Send(context.Background(), Recipient{}, Message{}); err == nil {
+		t.Error("expected an error without a URL")
+	}
+}
+
+func TestChatChannelSend(t *testing.T) {
+	receiver := newWebhookReceiver(t)
+	ch := &ChatChannel{URL: receiver.URL}
+	if err := ch.Send(context.Background(), Recipient{}, Message{Subject: "Due", Text: "AC"}); err != nil {
+		t.Fatal(err)
+	}
+	requests := receiver.received()
+	if len(requests) != 1 || string(requests[0].body) != `{"text":"*Due*\nAC"}` {
+		t.Errorf("requests = %+v", requests)
+	}
+}
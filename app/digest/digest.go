@@ -0,0 +1,282 @@
+package digest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"asset-management-api/app/delivery"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+// Digest frequencies stored in user_notification_settings.digest_frequency.
+const (
+	FrequencyDaily  = "daily"
+	FrequencyWeekly = "weekly"
+	FrequencyOff    = "off"
+)
+
+// Defaults for users who never changed their digest settings.
+const (
+	DefaultFrequency = FrequencyDaily
+	DefaultHour      = 7
+	DefaultWeekday   = int(time.Monday)
+)
+
+// Same role IDs as the services package; the digest is scoped the same way
+// the notification inbox is.
+const (
+	roleArea   int32 = 5
+	roleOutlet int32 = 6
+)
+
+// Item is one notification line in a digest.
+type Item struct {
+	AssetName string
+	Date      string
+	Link      string
+}
+
+// OutletGroup holds an outlet's notifications split by status.
+type OutletGroup struct {
+	OutletName string
+	Waiting    []Item
+	Late       []Item
+	Submitted  []Item
+}
+
+// Digest is the data handed to the digest template.
+type Digest struct {
+	Frequency      string
+	Outlets        []*OutletGroup
+	TotalWaiting   int
+	TotalLate      int
+	TotalSubmitted int
+}
+
+// Empty reports whether there is nothing to tell the user.
+func (d *Digest) Empty() bool {
+	return d.TotalWaiting+d.TotalLate+d.TotalSubmitted == 0
+}
+
+// Settings are a user's digest preferences.
+type Settings struct {
+	Frequency  string
+	Hour       int
+	Weekday    int
+	LastSentAt *time.Time
+}
+
+// Due reports whether a digest should go out at now: the most recent
+// scheduled slot has passed and nothing was sent since.
+func (s Settings) Due(now time.Time) bool {
+	if s.Frequency == FrequencyOff {
+		return false
+	}
+	slot := time.Date(now.Year(), now.Month(), now.Day(), s.Hour, 0, 0, 0, now.Location())
+	if s.Frequency == FrequencyWeekly {
+		slot = slot.AddDate(0, 0, -((int(now.Weekday()) - s.Weekday + 7) % 7))
+	}
+	if slot.After(now) {
+		if s.Frequency == FrequencyWeekly {
+			slot = slot.AddDate(0, 0, -7)
+		} else {
+			slot = slot.AddDate(0, 0, -1)
+		}
+	}
+	return s.LastSentAt == nil || s.LastSentAt.Before(slot)
+}
+
+// Generator builds and sends digests from the notifications table.
+// AppURL is the web app used for asset links; APIURL is the public gateway
+// address used for the unsubscribe link.
+type Generator struct {
+	DB         *pgxpool.Pool
+	Dispatcher *delivery.Dispatcher
+	AppURL     string
+	APIURL     string
+}
+
+func NewGenerator(db *pgxpool.Pool, dispatcher *delivery.Dispatcher, appURL, apiURL string) *Generator {
+	return &Generator{
+		DB:         db,
+		Dispatcher: dispatcher,
+		AppURL:     strings.TrimRight(appURL, "/"),
+		APIURL:     strings.TrimRight(apiURL, "/"),
+	}
+}
+
+type recipient struct {
+	nip      int32
+	roleId   int32
+	outletId int32
+	areaId   int32
+	settings Settings
+}
+
+// Run sends every digest that is due now. It is meant to be scheduled hourly.
+func (g *Generator) Run(ctx context.Context) error {
+	now := time.Now()
+	recipients, err := g.recipients(ctx)
+	if err != nil {
+		return err
+	}
+
+	sent := 0
+	for _, r := range recipients {
+		if !r.settings.Due(now) {
+			continue
+		}
+		if err := g.send(ctx, r, now); err != nil {
+			log.Error().Err(err).Msgf("Failed to send digest to NIP %d", r.nip)
+			continue
+		}
+		sent++
+	}
+	if sent > 0 {
+		log.Info().Msgf("Sent %d notification digests", sent)
+	}
+	return nil
+}
+
+func (g *Generator) recipients(ctx context.Context) ([]recipient, error) {
+	rows, err := g.DB.Query(ctx, `
+        SELECT u.nip, u.role_id, COALESCE(u.outlet_id, 0), COALESCE(u.area_id, 0),
+               COALESCE(s.digest_frequency, $1), COALESCE(s.digest_hour, $2), COALESCE(s.digest_weekday, $3),
+               s.digest_last_sent_at
+        FROM users u
+        LEFT JOIN user_notification_settings s ON s.nip = u.nip
+        WHERE COALESCE(u.user_email, '') <> '' AND u.disabled_at IS NULL`,
+		DefaultFrequency, DefaultHour, DefaultWeekday)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recipients []recipient
+	for rows.Next() {
+		var r recipient
+		if err := rows.Scan(&r.nip, &r.roleId, &r.outletId, &r.areaId,
+			&r.settings.Frequency, &r.settings.Hour, &r.settings.Weekday, &r.settings.LastSentAt); err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, r)
+	}
+	return recipients, rows.Err()
+}
+
+func (g *Generator) send(ctx context.Context, r recipient, now time.Time) error {
+	d, err := g.Build(ctx, r.nip, r.roleId, r.outletId, r.areaId)
+	if err != nil {
+		return err
+	}
+	d.Frequency = r.settings.Frequency
+
+	// Nothing new is still a sent digest, so an empty day is not retried every hour
+	if !d.Empty() {
+		token, err := g.unsubscribeToken(ctx, r.nip)
+		if err != nil {
+			return err
+		}
+		g.Dispatcher.NotifyChannel(ctx, delivery.ChannelEmail, delivery.TemplateDigest, []int32{r.nip}, map[string]interface{}{
+			"Digest":          d,
+			"UnsubscribeLink": g.APIURL + "/api/notifications/digest/unsubscribe?token=" + url.QueryEscape(token),
+		})
+	}
+
+	_, err = g.DB.Exec(ctx, `
+        INSERT INTO user_notification_settings (nip, digest_last_sent_at) VALUES ($1, $2)
+        ON CONFLICT (nip) DO UPDATE SET digest_last_sent_at = EXCLUDED.digest_last_sent_at`, r.nip, now)
+	return err
+}
+
+// Build collects the waiting, late and submitted notifications visible to a
+// user, grouped by outlet. Area users see their area, outlet users their
+// outlet and everyone else sees all outlets.
+func (g *Generator) Build(ctx context.Context, nip, roleId, outletId, areaId int32) (*Digest, error) {
+	query := `
+        SELECT COALESCE(o.outlet_name, ''), n.asset_name, n.maintenance_or_submitted, n.status,
+               COALESCE(a.asset_id_hash, '')
+        FROM notifications n
+        LEFT JOIN outlets o ON o.outlet_id = n.outlet_id
+        LEFT JOIN assets a ON a.asset_id = n.asset_id
+        LEFT JOIN notification_deliveries d ON d.id_notification = n.id_notification AND d.nip = $1
+        WHERE n.status IN ('waiting', 'late', 'submitted') AND d.dismissed_at IS NULL`
+	params := []interface{}{nip}
+	if roleId == roleArea {
+		params = append(params, areaId)
+		query += fmt.Sprintf(" AND n.area_id = $%d", len(params))
+	} else if roleId == roleOutlet {
+		params = append(params, outletId)
+		query += fmt.Sprintf(" AND n.outlet_id = $%d", len(params))
+	}
+	query += " ORDER BY o.outlet_name, n.maintenance_or_submitted"
+
+	rows, err := g.DB.Query(ctx, query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	d := &Digest{}
+	groups := make(map[string]*OutletGroup)
+	for rows.Next() {
+		var outletName, assetName, date, status, hash string
+		if err := rows.Scan(&outletName, &assetName, &date, &status, &hash); err != nil {
+			return nil, err
+		}
+
+		group, ok := groups[outletName]
+		if !ok {
+			group = &OutletGroup{OutletName: outletName}
+			groups[outletName] = group
+			d.Outlets = append(d.Outlets, group)
+		}
+
+		item := Item{AssetName: assetName, Date: date}
+		if hash != "" && g.AppURL != "" {
+			item.Link = g.AppURL + "/assets/" + url.PathEscape(hash)
+		}
+		switch status {
+		case "waiting":
+			group.Waiting = append(group.Waiting, item)
+			d.TotalWaiting++
+		case "late":
+			group.Late = append(group.Late, item)
+			d.TotalLate++
+		case "submitted":
+			group.Submitted = append(group.Submitted, item)
+			d.TotalSubmitted++
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(d.Outlets, func(i, j int) bool {
+		return len(d.Outlets[i].Late) > len(d.Outlets[j].Late)
+	})
+	return d, nil
+}
+
+// unsubscribeToken returns the user's digest token, creating it on first use.
+func (g *Generator) unsubscribeToken(ctx context.Context, nip int32) (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	var token string
+	err := g.DB.QueryRow(ctx, `
+        INSERT INTO user_notification_settings (nip, digest_token) VALUES ($1, $2)
+        ON CONFLICT (nip) DO UPDATE SET digest_token = COALESCE(user_notification_settings.digest_token, EXCLUDED.digest_token)
+        RETURNING digest_token`, nip, hex.EncodeToString(buf)).Scan(&token)
+	return token, err
+}
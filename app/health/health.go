@@ -0,0 +1,206 @@
+// Package health reports whether the API is alive and ready to serve, both
+// over the standard grpc.health.v1 service and as /healthz and /readyz for
+// HTTP probes.
+//
+// Liveness only says the process is up. Readiness runs the registered
+// dependency checks (database, file storage, scheduler) and is withdrawn
+// while the server drains on shutdown. Each gRPC service has its own serving
+// status so that one can be taken out of rotation on its own.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	checkTimeout  = 3 * time.Second
+	checkInterval = 10 * time.Second
+)
+
+// Check reports whether one dependency is usable.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Report is the body of /readyz.
+type Report struct {
+	Status   string            `json:"status"`
+	Checks   map[string]string `json:"checks"`
+	Services map[string]string `json:"services"`
+}
+
+// Monitor runs the readiness checks and keeps the gRPC serving statuses.
+type Monitor struct {
+	server *grpchealth.Server
+
+	mu       sync.Mutex
+	checks   []namedCheck
+	services map[string]healthpb.HealthCheckResponse_ServingStatus
+	draining bool
+}
+
+func NewMonitor() *Monitor {
+	return &Monitor{
+		server:   grpchealth.NewServer(),
+		services: make(map[string]healthpb.HealthCheckResponse_ServingStatus),
+	}
+}
+
+// AddCheck registers a readiness check under name.
+func (m *Monitor) AddCheck(name string, check Check) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checks = append(m.checks, namedCheck{name: name, check: check})
+}
+
+// Register adds grpc.health.v1 to the server and marks every service already
+// registered on it as serving. Call it after the API services are registered.
+func (m *Monitor) Register(server *grpc.Server) {
+	for name := range server.GetServiceInfo() {
+		m.SetServing(name, true)
+	}
+	healthpb.RegisterHealthServer(server, m.server)
+}
+
+// SetServing changes the status of one gRPC service, e.g. "asset.ASSETService".
+// It has no effect once draining has begun.
+func (m *Monitor) SetServing(service string, serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.draining {
+		return
+	}
+	m.services[service] = status
+	m.server.SetServingStatus(service, status)
+}
+
+// Drain marks the server and every service as not serving for good, so load
+// balancers stop sending traffic while in-flight requests finish.
+func (m *Monitor) Drain() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.draining = true
+	for service := range m.services {
+		m.services[service] = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	m.server.Shutdown()
+	log.Info().Msg("Health status set to NOT_SERVING for shutdown")
+}
+
+// Ready runs every check concurrently and reports the outcome.
+func (m *Monitor) Ready(ctx context.Context) Report {
+	m.mu.Lock()
+	checks := append([]namedCheck(nil), m.checks...)
+	draining := m.draining
+	services := make(map[string]string, len(m.services))
+	for service, status := range m.services {
+		if service != "" {
+			services[service] = status.String()
+		}
+	}
+	m.mu.Unlock()
+
+	report := Report{Status: "ok", Checks: make(map[string]string, len(checks)), Services: services}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, c := range checks {
+		wg.Add(1)
+		go func(c namedCheck) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+
+			result := "ok"
+			if err := c.check(ctx); err != nil {
+				result = err.Error()
+			}
+			mu.Lock()
+			report.Checks[c.name] = result
+			if result != "ok" {
+				report.Status = "unavailable"
+			}
+			mu.Unlock()
+		}(c)
+	}
+	wg.Wait()
+
+	if draining {
+		report.Status = "draining"
+	}
+	return report
+}
+
+// Run re-checks readiness periodically until ctx is cancelled and publishes
+// the result as the overall ("") gRPC status.
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		report := m.Ready(ctx)
+		if report.Status != "ok" && report.Status != "draining" {
+			var failing []string
+			for name, result := range report.Checks {
+				if result != "ok" {
+					failing = append(failing, name+": "+result)
+				}
+			}
+			sort.Strings(failing)
+			log.Warn().Strs("checks", failing).Msg("Not ready")
+		}
+		m.SetServing("", report.Status == "ok")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// LivenessHandler serves /healthz: the process is up and handling requests.
+func (m *Monitor) LivenessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	}
+}
+
+// ReadinessHandler serves /readyz: 200 when every check passes, otherwise 503
+// with the failing checks.
+func (m *Monitor) ReadinessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := m.Ready(r.Context())
+		code := http.StatusOK
+		if report.Status != "ok" {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, report)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error().Err(err).Msg("Failed to write health response")
+	}
+}
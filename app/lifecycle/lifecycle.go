@@ -0,0 +1,203 @@
+// Package lifecycle runs the servers and background workers of the API as a
+// unit: they start together, and a shutdown signal or the failure of any
+// server stops all of them within a deadline before the shared resources
+// (the database pool) are closed.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+)
+
+type server struct {
+	name     string
+	serve    func() error
+	shutdown func(ctx context.Context) error
+}
+
+type worker struct {
+	name string
+	run  func(ctx context.Context)
+}
+
+// Manager owns the servers, workers and closers registered on it.
+type Manager struct {
+	// ShutdownTimeout bounds how long servers may drain in-flight requests
+	// and workers may finish their current run.
+	ShutdownTimeout time.Duration
+
+	servers   []server
+	workers   []worker
+	shutdowns []func()
+	closers   []func()
+	stopping  atomic.Bool
+}
+
+func New(shutdownTimeout time.Duration) *Manager {
+	return &Manager{ShutdownTimeout: shutdownTimeout}
+}
+
+// Serve registers a server. serve blocks while the server runs; shutdown
+// stops it gracefully and must give up when its context expires.
+func (m *Manager) Serve(name string, serve func() error, shutdown func(ctx context.Context) error) {
+	m.servers = append(m.servers, server{name: name, serve: serve, shutdown: shutdown})
+}
+
+// GRPC registers a gRPC server listening on addr. On shutdown in-flight calls
+// and streams are drained, then cut off at the deadline.
+func (m *Manager) GRPC(name string, s *grpc.Server, addr string) {
+	m.Serve(name, func() error {
+		lis, err := net.Listen("tcp", addr)
+		if err != nil {
+			return err
+		}
+		log.Info().Msgf("Serving %s on %s", name, addr)
+		return s.Serve(lis)
+	}, func(ctx context.Context) error {
+		done := make(chan struct{})
+		go func() {
+			s.GracefulStop()
+			close(done)
+		}()
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			s.Stop()
+			return ctx.Err()
+		}
+	})
+}
+
+// HTTP registers an HTTP server. On shutdown idle connections are closed and
+// active ones drained, then cut off at the deadline.
+func (m *Manager) HTTP(name string, s *http.Server) {
+	m.Serve(name, func() error {
+		log.Info().Msgf("Serving %s on %s", name, s.Addr)
+		err := s.ListenAndServe()
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}, func(ctx context.Context) error {
+		if err := s.Shutdown(ctx); err != nil {
+			s.Close()
+			return err
+		}
+		return nil
+	})
+}
+
+// Go registers a background worker. run must return once ctx is cancelled.
+func (m *Manager) Go(name string, run func(ctx context.Context)) {
+	m.workers = append(m.workers, worker{name: name, run: run})
+}
+
+// OnShutdown registers fn to run as soon as shutdown begins, before the
+// servers stop accepting requests.
+func (m *Manager) OnShutdown(fn func()) {
+	m.shutdowns = append(m.shutdowns, fn)
+}
+
+// OnClose registers fn to run last, after servers and workers have stopped.
+// Closers run in reverse order of registration.
+func (m *Manager) OnClose(fn func()) {
+	m.closers = append(m.closers, fn)
+}
+
+// Stopping reports whether shutdown has begun, so that readiness can be
+// withdrawn while requests drain.
+func (m *Manager) Stopping() bool {
+	return m.stopping.Load()
+}
+
+// Run starts everything and blocks until ctx is cancelled, SIGINT or SIGTERM
+// arrives, or a server stops on its own. It then shuts everything down and
+// returns the error of the server that failed, if any.
+func (m *Manager) Run(ctx context.Context) error {
+	ctx, stopSignals := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignals()
+
+	workerCtx, cancelWorkers := context.WithCancel(context.Background())
+	defer cancelWorkers()
+	var workers sync.WaitGroup
+	for _, w := range m.workers {
+		workers.Add(1)
+		go func(w worker) {
+			defer workers.Done()
+			w.run(workerCtx)
+			log.Info().Msgf("Stopped %s", w.name)
+		}(w)
+	}
+
+	failed := make(chan error, len(m.servers))
+	for _, s := range m.servers {
+		go func(s server) {
+			err := s.serve()
+			if m.stopping.Load() {
+				return
+			}
+			if err == nil {
+				err = errors.New("stopped unexpectedly")
+			}
+			failed <- fmt.Errorf("%s: %w", s.name, err)
+		}(s)
+	}
+
+	var failure error
+	select {
+	case <-ctx.Done():
+		log.Info().Msg("Shutting down")
+	case failure = <-failed:
+		log.Error().Err(failure).Msg("Server failed, shutting down")
+	}
+	m.stopping.Store(true)
+	for _, fn := range m.shutdowns {
+		fn()
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), m.ShutdownTimeout)
+	defer cancel()
+
+	// Stop in reverse order, so the gateway drains before the gRPC server it
+	// forwards to
+	for i := len(m.servers) - 1; i >= 0; i-- {
+		s := m.servers[i]
+		if err := s.shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msgf("Failed to stop %s gracefully", s.name)
+		} else {
+			log.Info().Msgf("Stopped %s", s.name)
+		}
+	}
+
+	cancelWorkers()
+	done := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-shutdownCtx.Done():
+		// Closing the pool would block on the connections they still hold
+		log.Error().Msg("Background workers did not stop in time, skipping cleanup")
+		return failure
+	}
+
+	for i := len(m.closers) - 1; i >= 0; i-- {
+		m.closers[i]()
+	}
+	log.Info().Msg("Shutdown complete")
+	return failure
+}
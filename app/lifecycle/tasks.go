@@ -0,0 +1,53 @@
+package lifecycle
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Tasks runs work that requests hand off, such as sending notifications, in
+// the background. Unlike bare goroutines, the tasks are cancelled when the
+// workers stop and waited for before the pool is closed. Register Run with
+// Manager.Go.
+type Tasks struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	stopped bool
+	running sync.WaitGroup
+}
+
+func NewTasks() *Tasks {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Tasks{ctx: ctx, cancel: cancel}
+}
+
+// Go runs fn in the background. Its context is cancelled on shutdown; tasks
+// handed off after that are dropped.
+func (t *Tasks) Go(name string, fn func(ctx context.Context)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stopped {
+		log.Warn().Msgf("Dropped %s, shutting down", name)
+		return
+	}
+	t.running.Add(1)
+	go func() {
+		defer t.running.Done()
+		fn(t.ctx)
+	}()
+}
+
+// Run blocks until ctx is cancelled, then cancels the running tasks and
+// waits for them to return.
+func (t *Tasks) Run(ctx context.Context) {
+	<-ctx.Done()
+	t.mu.Lock()
+	t.stopped = true
+	t.mu.Unlock()
+	t.cancel()
+	t.running.Wait()
+}
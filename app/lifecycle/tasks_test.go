@@ -0,0 +1,40 @@
+package lifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTasksDrainOnShutdown(t *testing.T) {
+	tasks := NewTasks()
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		tasks.Run(ctx)
+		close(stopped)
+	}()
+
+	started := make(chan struct{})
+	finished := make(chan struct{})
+	tasks.Go("blocking task", func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		close(finished)
+	})
+	<-started
+
+	cancel()
+	<-stopped
+	select {
+	case <-finished:
+	default:
+		t.Fatal("Run returned before the running task")
+	}
+
+	tasks.Go("late task", func(ctx context.Context) {
+		t.Error("task handed off after shutdown ran")
+	})
+	time.Sleep(10 * time.Millisecond)
+}
@@ -0,0 +1,59 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// noTableDB is a database that has never been migrated. It records the
+// statements run against it.
+type noTableDB struct {
+	statements []string
+}
+
+func (db *noTableDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	db.statements = append(db.statements, sql)
+	return nil, errors.New(`relation "schema_migrations" does not exist`)
+}
+
+func (db *noTableDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	db.statements = append(db.statements, sql)
+	return missingTable{}
+}
+
+type missingTable struct{}
+
+func (missingTable) Scan(dest ...interface{}) error {
+	*dest[0].(*bool) = false
+	return nil
+}
+
+func TestAppliedWithoutTable(t *testing.T) {
+	db := &noTableDB{}
+	versions, err := applied(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 0 {
+		t.Errorf("versions = %v, want none applied", versions)
+	}
+	// Only the existence check; no lock, no CREATE TABLE
+	if len(db.statements) != 1 {
+		t.Errorf("statements = %q", db.statements)
+	}
+}
+
+func TestLoadEmbedded(t *testing.T) {
+	migrations, err := load(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, mig := range migrations {
+		if mig.Version != int64(i+1) {
+			t.Errorf("migration %d has version %d, want %d", i, mig.Version, i+1)
+		}
+	}
+}
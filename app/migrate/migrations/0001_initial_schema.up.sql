@@ -0,0 +1,309 @@
+-- Initial schema: every table the services read and write.
+--
+-- Tables are created only when missing so that a database which predates the
+-- migrations can be adopted by running "migrate up" against it.
+
+-- Master data
+
+CREATE TABLE IF NOT EXISTS roles (
+    role_id   SERIAL PRIMARY KEY,
+    role_name VARCHAR(100) NOT NULL,
+    status    VARCHAR(50) NOT NULL DEFAULT 'active'
+);
+
+CREATE TABLE IF NOT EXISTS areas (
+    area_id   SERIAL PRIMARY KEY,
+    area_name VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS outlets (
+    outlet_id   SERIAL PRIMARY KEY,
+    outlet_name VARCHAR(255) NOT NULL
+);
+
+-- Every outlet belongs to exactly one area
+CREATE TABLE IF NOT EXISTS area_outlets (
+    outlet_id INTEGER PRIMARY KEY REFERENCES outlets (outlet_id),
+    area_id   INTEGER NOT NULL REFERENCES areas (area_id)
+);
+
+CREATE INDEX IF NOT EXISTS area_outlets_area_id_idx ON area_outlets (area_id);
+
+CREATE TABLE IF NOT EXISTS positions (
+    id            SERIAL PRIMARY KEY,
+    position_name VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS maintenance_periods (
+    period_id        SERIAL PRIMARY KEY,
+    period_name      VARCHAR(100) NOT NULL,
+    maintenance_date DATE
+);
+
+CREATE TABLE IF NOT EXISTS classifications (
+    classification_id             SERIAL PRIMARY KEY,
+    classification_name           VARCHAR(255) NOT NULL,
+    classification_economic_value INTEGER NOT NULL DEFAULT 0,
+    maintenance_period_id         INTEGER REFERENCES maintenance_periods (period_id),
+    -- JSON object of condition names to descriptions
+    asset_healthy_param           TEXT
+);
+
+CREATE TABLE IF NOT EXISTS mst_assets (
+    id_asset_naming   SERIAL PRIMARY KEY,
+    asset_naming      VARCHAR(255) NOT NULL,
+    classification_id INTEGER REFERENCES classifications (classification_id)
+);
+
+CREATE TABLE IF NOT EXISTS personal_responsibles (
+    personal_responsible_id SERIAL PRIMARY KEY,
+    name                    VARCHAR(255) NOT NULL
+);
+
+-- Users and authentication
+
+CREATE TABLE IF NOT EXISTS users (
+    nip            INTEGER PRIMARY KEY,
+    user_full_name VARCHAR(255) NOT NULL,
+    user_email     VARCHAR(255),
+    user_password  VARCHAR(255) NOT NULL,
+    role_id        INTEGER NOT NULL REFERENCES roles (role_id),
+    area_id        INTEGER REFERENCES areas (area_id),
+    outlet_id      INTEGER REFERENCES outlets (outlet_id)
+);
+
+CREATE TABLE IF NOT EXISTS token_stores (
+    token      TEXT PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    exp_token  TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS token_stores_exp_token_idx ON token_stores (exp_token);
+
+-- Assets
+
+CREATE TABLE IF NOT EXISTS assets (
+    asset_id                         INTEGER PRIMARY KEY,
+    asset_id_hash                    VARCHAR(255),
+    asset_name                       VARCHAR(255) NOT NULL,
+    asset_brand                      VARCHAR(255) NOT NULL DEFAULT '',
+    asset_specification              TEXT NOT NULL DEFAULT '',
+    asset_classification             INTEGER NOT NULL REFERENCES classifications (classification_id),
+    asset_status                     VARCHAR(100) NOT NULL DEFAULT '',
+    asset_condition                  VARCHAR(100) NOT NULL DEFAULT '',
+    asset_purchase_date              DATE NOT NULL,
+    -- role responsible for the asset
+    asset_pic                        INTEGER NOT NULL DEFAULT 0,
+    asset_image                      TEXT NOT NULL DEFAULT '',
+    personal_responsible             VARCHAR(255) NOT NULL DEFAULT '',
+    outlet_id                        INTEGER NOT NULL REFERENCES outlets (outlet_id),
+    area_id                          INTEGER NOT NULL REFERENCES areas (area_id),
+    asset_maintenance_date           DATE NOT NULL,
+    classification_acquisition_value INTEGER NOT NULL DEFAULT 0,
+    classification_last_book_value   INTEGER NOT NULL DEFAULT 0,
+    deprecation_value                INTEGER NOT NULL DEFAULT 0,
+    asset_quantity                   INTEGER NOT NULL DEFAULT 0,
+    asset_quantity_standard          INTEGER NOT NULL DEFAULT 0,
+    -- 0 when the asset has no master naming
+    id_asset_naming                  INTEGER,
+    asset_warranty_date              DATE,
+    position_id                      INTEGER REFERENCES positions (id),
+    created_at                       TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at                       TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- The warranty date was added to assets after the first deployments
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS asset_warranty_date DATE;
+
+CREATE INDEX IF NOT EXISTS assets_asset_id_hash_idx ON assets (asset_id_hash);
+CREATE INDEX IF NOT EXISTS assets_outlet_id_idx ON assets (outlet_id);
+CREATE INDEX IF NOT EXISTS assets_area_id_idx ON assets (area_id);
+
+-- Status history of every asset write
+CREATE TABLE IF NOT EXISTS asset_updates (
+    id           SERIAL PRIMARY KEY,
+    asset_id     INTEGER NOT NULL REFERENCES assets (asset_id),
+    asset_status VARCHAR(100) NOT NULL DEFAULT '',
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS asset_updates_asset_id_idx ON asset_updates (asset_id);
+
+-- Submissions
+
+CREATE TABLE IF NOT EXISTS submission_parents (
+    submission_parent_id INTEGER PRIMARY KEY,
+    nip                  VARCHAR(50) NOT NULL,
+    created_at           TIMESTAMP NOT NULL DEFAULT NOW(),
+    outlet_id            INTEGER,
+    area_id              INTEGER
+);
+
+CREATE TABLE IF NOT EXISTS submissions (
+    submission_id          INTEGER PRIMARY KEY,
+    submission_name        VARCHAR(255) NOT NULL,
+    submission_outlet      VARCHAR(255),
+    submission_area        VARCHAR(255),
+    submission_date        DATE,
+    submission_category    VARCHAR(100),
+    submission_status      VARCHAR(100) NOT NULL DEFAULT '',
+    submission_purpose     TEXT,
+    submission_quantity    INTEGER NOT NULL DEFAULT 0,
+    submission_asset_name  VARCHAR(255),
+    submission_description TEXT,
+    nip                    INTEGER,
+    asset_id               INTEGER REFERENCES assets (asset_id),
+    attachment             TEXT,
+    validator_id           INTEGER,
+    validator_type         VARCHAR(100),
+    submission_price       INTEGER,
+    submission_role_name   VARCHAR(100),
+    submission_pr_name     VARCHAR(255),
+    outlet_id              INTEGER,
+    area_id                INTEGER,
+    submission_parent_id   INTEGER REFERENCES submission_parents (submission_parent_id),
+    created_at             TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS submissions_parent_id_idx ON submissions (submission_parent_id);
+CREATE INDEX IF NOT EXISTS submissions_category_idx ON submissions (submission_category);
+
+CREATE TABLE IF NOT EXISTS submission_logs (
+    id            SERIAL PRIMARY KEY,
+    submission_id INTEGER NOT NULL REFERENCES submissions (submission_id),
+    status        VARCHAR(100) NOT NULL,
+    description   TEXT,
+    pr_name       VARCHAR(255),
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Notifications
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id_notification          SERIAL PRIMARY KEY,
+    asset_id                 INTEGER NOT NULL REFERENCES assets (asset_id),
+    submission_id            INTEGER REFERENCES submissions (submission_id),
+    asset_name               VARCHAR(255) NOT NULL DEFAULT '',
+    outlet_id                INTEGER NOT NULL DEFAULT 0,
+    area_id                  INTEGER NOT NULL DEFAULT 0,
+    maintenance_or_submitted DATE,
+    -- waiting, late or submitted
+    status                   VARCHAR(20) NOT NULL
+);
+
+-- Escalation state was added to notifications after the first deployments
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS escalation_level INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS notifications_asset_id_idx ON notifications (asset_id);
+CREATE INDEX IF NOT EXISTS notifications_status_idx ON notifications (status);
+
+-- Per-user inbox state of a notification
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id_notification INTEGER NOT NULL REFERENCES notifications (id_notification) ON DELETE CASCADE,
+    nip             INTEGER NOT NULL,
+    read_at         TIMESTAMP,
+    dismissed_at    TIMESTAMP,
+    snoozed_until   TIMESTAMP,
+    PRIMARY KEY (id_notification, nip)
+);
+
+CREATE INDEX IF NOT EXISTS notification_deliveries_nip_idx ON notification_deliveries (nip);
+
+CREATE TABLE IF NOT EXISTS user_notification_settings (
+    nip                 INTEGER PRIMARY KEY REFERENCES users (nip) ON DELETE CASCADE,
+    locale              VARCHAR(10),
+    digest_frequency    VARCHAR(10),
+    digest_hour         INTEGER,
+    digest_weekday      INTEGER,
+    digest_last_sent_at TIMESTAMP,
+    digest_token        VARCHAR(64) UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS user_channel_preferences (
+    nip     INTEGER NOT NULL REFERENCES users (nip) ON DELETE CASCADE,
+    -- email, webhook or chat
+    channel VARCHAR(20) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    address TEXT,
+    PRIMARY KEY (nip, channel)
+);
+
+CREATE TABLE IF NOT EXISTS notification_delivery_logs (
+    id         BIGSERIAL PRIMARY KEY,
+    nip        INTEGER NOT NULL,
+    channel    VARCHAR(20) NOT NULL,
+    template   VARCHAR(100) NOT NULL,
+    subject    TEXT,
+    address    TEXT,
+    -- sent or failed
+    status     VARCHAR(10) NOT NULL,
+    attempts   INTEGER NOT NULL DEFAULT 0,
+    error      TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS notification_delivery_logs_nip_idx ON notification_delivery_logs (nip);
+
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    id              SERIAL PRIMARY KEY,
+    nip             INTEGER NOT NULL REFERENCES users (nip) ON DELETE CASCADE,
+    outlet_id       INTEGER REFERENCES outlets (outlet_id),
+    -- SHA-256 of the feed token; the token itself is never stored
+    token_hash      VARCHAR(64) NOT NULL UNIQUE,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_fetched_at TIMESTAMP,
+    revoked_at      TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS calendar_feeds_nip_idx ON calendar_feeds (nip);
+
+-- Escalation of late maintenance
+
+CREATE TABLE IF NOT EXISTS escalation_rules (
+    id                    SERIAL PRIMARY KEY,
+    name                  VARCHAR(255) NOT NULL,
+    level                 INTEGER NOT NULL,
+    min_days_late         INTEGER NOT NULL,
+    target_role_id        INTEGER NOT NULL REFERENCES roles (role_id),
+    classification_id     INTEGER REFERENCES classifications (classification_id),
+    min_acquisition_value INTEGER,
+    max_acquisition_value INTEGER,
+    enabled               BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+-- The rules that have fired for a late notification
+CREATE TABLE IF NOT EXISTS notification_escalations (
+    id_notification INTEGER NOT NULL REFERENCES notifications (id_notification) ON DELETE CASCADE,
+    rule_id         INTEGER NOT NULL REFERENCES escalation_rules (id),
+    level           INTEGER NOT NULL,
+    days_late       INTEGER NOT NULL,
+    target_role_id  INTEGER NOT NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id_notification, rule_id)
+);
+
+CREATE INDEX IF NOT EXISTS notification_escalations_rule_id_idx ON notification_escalations (rule_id);
+
+-- Notification rules on asset events
+
+CREATE TABLE IF NOT EXISTS notification_rules (
+    id            SERIAL PRIMARY KEY,
+    name          VARCHAR(255) NOT NULL,
+    condition     VARCHAR(50) NOT NULL,
+    value         TEXT,
+    -- role, outlet, area or user
+    audience_type VARCHAR(20) NOT NULL,
+    audience_id   INTEGER,
+    -- NULL delivers over each recipient's preferred channels
+    channel       VARCHAR(20),
+    enabled       BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+-- Assets a rule has fired for, so it fires again only after the condition clears
+CREATE TABLE IF NOT EXISTS notification_rule_firings (
+    rule_id  INTEGER NOT NULL REFERENCES notification_rules (id),
+    asset_id INTEGER NOT NULL REFERENCES assets (asset_id),
+    fired_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (rule_id, asset_id)
+);
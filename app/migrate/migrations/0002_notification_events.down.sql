@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS notifications_change ON notifications;
+DROP FUNCTION IF EXISTS notify_notification_change();
+DROP TABLE IF EXISTS notification_events;
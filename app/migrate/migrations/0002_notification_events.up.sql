@@ -0,0 +1,39 @@
+-- Event log and trigger that publish every change on the notifications table
+-- to the realtime hub, which LISTENs on the notification_events channel.
+
+CREATE TABLE IF NOT EXISTS notification_events (
+    event_id        BIGSERIAL PRIMARY KEY,
+    op              VARCHAR(10) NOT NULL,
+    id_notification INTEGER NOT NULL,
+    outlet_id       INTEGER,
+    area_id         INTEGER,
+    payload         JSONB NOT NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE OR REPLACE FUNCTION notify_notification_change() RETURNS trigger AS $$
+DECLARE
+    body JSONB;
+    evt_id BIGINT;
+    evt_at TIMESTAMP := NOW();
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        body := to_jsonb(OLD);
+    ELSE
+        body := to_jsonb(NEW);
+    END IF;
+
+    INSERT INTO notification_events (op, id_notification, outlet_id, area_id, payload, created_at)
+    VALUES (TG_OP, (body->>'id_notification')::INTEGER, (body->>'outlet_id')::INTEGER, (body->>'area_id')::INTEGER, body, evt_at)
+    RETURNING event_id INTO evt_id;
+
+    PERFORM pg_notify('notification_events', json_build_object(
+        'event_id', evt_id, 'op', TG_OP, 'payload', body, 'created_at', evt_at)::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS notifications_change ON notifications;
+CREATE TRIGGER notifications_change
+    AFTER INSERT OR UPDATE OR DELETE ON notifications
+    FOR EACH ROW EXECUTE FUNCTION notify_notification_change();
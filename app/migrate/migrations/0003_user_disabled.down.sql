@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS token_stores_nip_idx;
+
+ALTER TABLE token_stores DROP COLUMN IF EXISTS nip;
+
+ALTER TABLE users DROP COLUMN IF EXISTS disabled_at;
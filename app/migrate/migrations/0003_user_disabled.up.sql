@@ -0,0 +1,8 @@
+-- Disabled users can no longer log in; their stored tokens are removed, which
+-- needs the token's owner on each token_stores row.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMP;
+
+ALTER TABLE token_stores ADD COLUMN IF NOT EXISTS nip INTEGER;
+
+CREATE INDEX IF NOT EXISTS token_stores_nip_idx ON token_stores (nip);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS token_stores (
+    token      TEXT PRIMARY KEY,
+    nip        INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    exp_token  TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS token_stores_exp_token_idx ON token_stores (exp_token);
+CREATE INDEX IF NOT EXISTS token_stores_nip_idx ON token_stores (nip);
+
+DROP TABLE IF EXISTS refresh_tokens;
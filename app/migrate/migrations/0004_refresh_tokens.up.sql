@@ -0,0 +1,23 @@
+-- Rotating refresh tokens. Access tokens are verified statelessly; refresh
+-- tokens are stored as SHA-256 hashes and chained by family: every login
+-- starts a family, every refresh replaces the family's current token, and
+-- presenting a replaced token again revokes the whole family.
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id          BIGSERIAL PRIMARY KEY,
+    token_hash  VARCHAR(64) NOT NULL UNIQUE,
+    family_id   VARCHAR(32) NOT NULL,
+    nip         INTEGER NOT NULL REFERENCES users (nip) ON DELETE CASCADE,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at  TIMESTAMP NOT NULL,
+    -- set when the token is exchanged for a new one
+    rotated_at  TIMESTAMP,
+    revoked_at  TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_family_id_idx ON refresh_tokens (family_id);
+CREATE INDEX IF NOT EXISTS refresh_tokens_nip_idx ON refresh_tokens (nip);
+CREATE INDEX IF NOT EXISTS refresh_tokens_expires_at_idx ON refresh_tokens (expires_at);
+
+-- Login tokens are no longer stored
+DROP TABLE IF EXISTS token_stores;
@@ -0,0 +1,28 @@
+-- A session is one login: its refresh token family plus where it was used
+-- from. Refreshing extends the session and records when it was last seen.
+
+CREATE TABLE IF NOT EXISTS sessions (
+    session_id   VARCHAR(32) PRIMARY KEY,
+    nip          INTEGER NOT NULL REFERENCES users (nip) ON DELETE CASCADE,
+    user_agent   TEXT NOT NULL DEFAULT '',
+    ip_address   VARCHAR(64) NOT NULL DEFAULT '',
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMP NOT NULL,
+    revoked_at   TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS sessions_nip_idx ON sessions (nip);
+CREATE INDEX IF NOT EXISTS sessions_expires_at_idx ON sessions (expires_at);
+
+-- Refresh token families issued before sessions existed
+INSERT INTO sessions (session_id, nip, created_at, last_seen_at, expires_at, revoked_at)
+SELECT family_id, MIN(nip), MIN(created_at), MAX(created_at), MAX(expires_at),
+       CASE WHEN BOOL_AND(revoked_at IS NOT NULL) THEN MAX(revoked_at) END
+FROM refresh_tokens
+GROUP BY family_id
+ON CONFLICT (session_id) DO NOTHING;
+
+ALTER TABLE refresh_tokens
+    ADD CONSTRAINT refresh_tokens_family_id_fkey
+    FOREIGN KEY (family_id) REFERENCES sessions (session_id) ON DELETE CASCADE;
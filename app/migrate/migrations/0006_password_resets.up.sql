@@ -0,0 +1,13 @@
+-- One-time password reset tokens, stored hashed like refresh tokens
+
+CREATE TABLE IF NOT EXISTS password_resets (
+    id           BIGSERIAL PRIMARY KEY,
+    token_hash   VARCHAR(64) NOT NULL UNIQUE,
+    nip          INTEGER NOT NULL REFERENCES users (nip) ON DELETE CASCADE,
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMP NOT NULL,
+    used_at      TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS password_resets_nip_idx ON password_resets (nip);
+CREATE INDEX IF NOT EXISTS password_resets_expires_at_idx ON password_resets (expires_at);
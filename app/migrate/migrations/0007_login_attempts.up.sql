@@ -0,0 +1,24 @@
+-- Failed login counters per account and per client address, and an audit
+-- trail of every login attempt
+
+CREATE TABLE IF NOT EXISTS login_throttles (
+    scope           VARCHAR(16) NOT NULL,
+    key             VARCHAR(64) NOT NULL,
+    failures        INTEGER NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP NOT NULL,
+    blocked_until   TIMESTAMP,
+    PRIMARY KEY (scope, key)
+);
+
+CREATE TABLE IF NOT EXISTS login_audit (
+    id          BIGSERIAL PRIMARY KEY,
+    nip         INTEGER NOT NULL,
+    success     BOOLEAN NOT NULL,
+    reason      VARCHAR(32) NOT NULL,
+    ip_address  VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent  TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS login_audit_nip_idx ON login_audit (nip, created_at);
+CREATE INDEX IF NOT EXISTS login_audit_created_at_idx ON login_audit (created_at);
@@ -0,0 +1,22 @@
+-- TOTP two-factor authentication. A secret is pending until the user
+-- confirms it with a first code; last_used_step stops a code being replayed.
+
+CREATE TABLE IF NOT EXISTS user_totp (
+    nip            INTEGER PRIMARY KEY REFERENCES users (nip) ON DELETE CASCADE,
+    secret         VARCHAR(64) NOT NULL,
+    created_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+    enabled_at     TIMESTAMP,
+    last_used_step BIGINT
+);
+
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+    id         BIGSERIAL PRIMARY KEY,
+    nip        INTEGER NOT NULL REFERENCES users (nip) ON DELETE CASCADE,
+    code_hash  VARCHAR(64) NOT NULL,
+    used_at    TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS user_recovery_codes_nip_idx ON user_recovery_codes (nip);
+
+-- Roles whose users must have two-factor authentication to log in
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS mfa_required BOOLEAN NOT NULL DEFAULT FALSE;
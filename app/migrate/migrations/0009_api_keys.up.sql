@@ -0,0 +1,17 @@
+-- API keys for the REST server, stored hashed. prefix is the start of the
+-- secret, kept to tell keys apart.
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id           SERIAL PRIMARY KEY,
+    prefix       VARCHAR(16) NOT NULL,
+    key_hash     VARCHAR(64) NOT NULL UNIQUE,
+    label        VARCHAR(100) NOT NULL,
+    nip          INTEGER NOT NULL REFERENCES users (nip) ON DELETE CASCADE,
+    scopes       TEXT[] NOT NULL,
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at   TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_nip_idx ON api_keys (nip);
@@ -0,0 +1,10 @@
+-- Single sign-on logins waiting for the OpenID Connect provider to redirect
+-- back. Each state is used once.
+
+CREATE TABLE IF NOT EXISTS oidc_states (
+    state         VARCHAR(64) PRIMARY KEY,
+    nonce         VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at    TIMESTAMP NOT NULL
+);
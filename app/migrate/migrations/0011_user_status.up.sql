@@ -0,0 +1,10 @@
+-- Users are deactivated or deleted instead of removed, so submissions and
+-- audit entries keep pointing at them. disabled_at is set exactly when the
+-- user is not active; every login and token check goes by it.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active';
+
+UPDATE users SET status = 'deactivated' WHERE disabled_at IS NOT NULL;
+
+ALTER TABLE users ADD CONSTRAINT users_status_check
+    CHECK (status IN ('active', 'deactivated', 'deleted') AND (status = 'active') = (disabled_at IS NULL));
@@ -0,0 +1,4 @@
+-- Users with a password someone else chose, e.g. generated by an import, are
+-- asked to replace it when they log in.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS must_change_password BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,11 @@
+-- Previous password hashes of each user, so that recent passwords cannot be
+-- chosen again.
+
+CREATE TABLE IF NOT EXISTS password_history (
+    id            BIGSERIAL PRIMARY KEY,
+    nip           INTEGER NOT NULL REFERENCES users (nip) ON DELETE CASCADE,
+    password_hash VARCHAR(255) NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS password_history_nip_idx ON password_history (nip, id);
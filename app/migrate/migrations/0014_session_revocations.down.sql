@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS sessions_revoked ON sessions;
+DROP FUNCTION IF EXISTS notify_session_revoked();
@@ -0,0 +1,17 @@
+-- Ending a session notifies the session_revocations channel with its id, so
+-- that every server stops accepting the session's access tokens without
+-- looking sessions up on each call. Deactivating a user ends their sessions,
+-- which covers their tokens too.
+
+CREATE OR REPLACE FUNCTION notify_session_revoked() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('session_revocations', NEW.session_id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS sessions_revoked ON sessions;
+CREATE TRIGGER sessions_revoked
+    AFTER UPDATE OF revoked_at ON sessions
+    FOR EACH ROW WHEN (OLD.revoked_at IS NULL AND NEW.revoked_at IS NOT NULL)
+    EXECUTE FUNCTION notify_session_revoked();
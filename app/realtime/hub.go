@@ -0,0 +1,276 @@
+package realtime
+
+import (
+	"asset-management-api/assetpb"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+// Channel is the Postgres NOTIFY channel fed by the notifications trigger.
+const Channel = "notification_events"
+
+const (
+	subscriberBuffer = 64
+	replayLimit      = 500
+	eventRetention   = 7 * 24 * time.Hour
+)
+
+// Event is a change on the notifications table.
+type Event struct {
+	EventID      int64
+	Type         string
+	Notification *assetpb.Notification
+	OccurredAt   time.Time
+}
+
+// Scope restricts which notifications a subscriber receives. Zero values mean
+// no restriction, matching how area and outlet roles are scoped elsewhere.
+type Scope struct {
+	OutletId int32
+	AreaId   int32
+}
+
+func (sc Scope) allows(n *assetpb.Notification) bool {
+	if sc.OutletId != 0 && n.GetOutletId() != sc.OutletId {
+		return false
+	}
+	if sc.AreaId != 0 && n.GetAreaId() != sc.AreaId {
+		return false
+	}
+	return true
+}
+
+// Subscription receives live events. C is closed when the subscriber falls too
+// far behind; the client is expected to reconnect with its last event ID.
+type Subscription struct {
+	C     chan Event
+	scope Scope
+	hub   *Hub
+}
+
+// Close detaches the subscription from the hub.
+func (sub *Subscription) Close() {
+	sub.hub.unsubscribe(sub)
+}
+
+// Hub listens for notification changes and fans them out to subscribers.
+type Hub struct {
+	DB *pgxpool.Pool
+
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+func NewHub(db *pgxpool.Pool) *Hub {
+	return &Hub{DB: db, subs: make(map[*Subscription]struct{})}
+}
+
+// Subscribe registers a new subscriber for the given scope.
+func (h *Hub) Subscribe(scope Scope) *Subscription {
+	sub := &Subscription{C: make(chan Event, subscriberBuffer), scope: scope, hub: h}
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+	return sub
+}
+
+func (h *Hub) unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.C)
+	}
+}
+
+func (h *Hub) publish(evt Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		if !sub.scope.allows(evt.Notification) {
+			continue
+		}
+		select {
+		case sub.C <- evt:
+		default:
+			log.Warn().Int64("event_id", evt.EventID).Msg("Notification subscriber lagging, dropping it")
+			delete(h.subs, sub)
+			close(sub.C)
+		}
+	}
+}
+
+// Replay returns the events after lastEventID that are visible in scope.
+func (h *Hub) Replay(ctx context.Context, lastEventID int64, scope Scope) ([]Event, error) {
+	query := `SELECT event_id, op, payload, created_at FROM notification_events WHERE event_id > $1`
+	params := []interface{}{lastEventID}
+	if scope.OutletId != 0 {
+		params = append(params, scope.OutletId)
+		query += fmt.Sprintf(" AND outlet_id = $%d", len(params))
+	}
+	if scope.AreaId != 0 {
+		params = append(params, scope.AreaId)
+		query += fmt.Sprintf(" AND area_id = $%d", len(params))
+	}
+	params = append(params, replayLimit)
+	query += fmt.Sprintf(" ORDER BY event_id ASC LIMIT $%d", len(params))
+
+	rows, err := h.DB.Query(ctx, query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []Event
+	for rows.Next() {
+		var eventID int64
+		var op string
+		var payload []byte
+		var createdAt time.Time
+		if err := rows.Scan(&eventID, &op, &payload, &createdAt); err != nil {
+			return nil, err
+		}
+		notification, err := decodeNotification(payload)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, Event{EventID: eventID, Type: eventType(op), Notification: notification, OccurredAt: createdAt})
+	}
+	return events, rows.Err()
+}
+
+// Run listens until ctx is cancelled, reconnecting with a capped backoff
+// whenever the listening connection drops. The event log and the trigger
+// feeding it are installed by the notification_events migration.
+func (h *Hub) Run(ctx context.Context) {
+	go h.prune(ctx)
+
+	backoff := time.Second
+	for ctx.Err() == nil {
+		err := h.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Error().Err(err).Msgf("Notification listener stopped, reconnecting in %s", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+func (h *Hub) listen(ctx context.Context) error {
+	conn, err := h.DB.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return err
+	}
+	log.Info().Msgf("Listening on %s", Channel)
+
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		evt, err := decodeEvent(n.Payload)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to decode notification event")
+			continue
+		}
+		h.publish(evt)
+	}
+}
+
+func (h *Hub) prune(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := h.DB.Exec(ctx, `DELETE FROM notification_events WHERE created_at < $1`, time.Now().Add(-eventRetention))
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to prune notification events")
+			}
+		}
+	}
+}
+
+type notifyPayload struct {
+	EventID   int64           `json:"event_id"`
+	Op        string          `json:"op"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt string          `json:"created_at"`
+}
+
+type notificationRow struct {
+	IdNotification         int32  `json:"id_notification"`
+	AssetId                int32  `json:"asset_id"`
+	SubmissionId           *int32 `json:"submission_id"`
+	AssetName              string `json:"asset_name"`
+	OutletId               int32  `json:"outlet_id"`
+	AreaId                 int32  `json:"area_id"`
+	MaintenanceOrSubmitted string `json:"maintenance_or_submitted"`
+	Status                 string `json:"status"`
+	EscalationLevel        int32  `json:"escalation_level"`
+}
+
+func decodeEvent(raw string) (Event, error) {
+	var p notifyPayload
+	if err := json.Unmarshal([]byte(raw), &p); err != nil {
+		return Event{}, err
+	}
+	notification, err := decodeNotification(p.Payload)
+	if err != nil {
+		return Event{}, err
+	}
+	occurredAt, _ := time.Parse("2006-01-02T15:04:05.999999", p.CreatedAt)
+	return Event{EventID: p.EventID, Type: eventType(p.Op), Notification: notification, OccurredAt: occurredAt}, nil
+}
+
+func decodeNotification(payload []byte) (*assetpb.Notification, error) {
+	var row notificationRow
+	if err := json.Unmarshal(payload, &row); err != nil {
+		return nil, err
+	}
+	n := &assetpb.Notification{
+		IdNotification:         row.IdNotification,
+		AssetId:                row.AssetId,
+		AssetName:              row.AssetName,
+		OutletId:               row.OutletId,
+		AreaId:                 row.AreaId,
+		MaintenanceOrSubmitted: row.MaintenanceOrSubmitted,
+		Status:                 row.Status,
+		EscalationLevel:        row.EscalationLevel,
+	}
+	if row.SubmissionId != nil {
+		n.SubmissionId = *row.SubmissionId
+	}
+	return n, nil
+}
+
+func eventType(op string) string {
+	switch op {
+	case "INSERT":
+		return "created"
+	case "DELETE":
+		return "deleted"
+	default:
+		return "updated"
+	}
+}
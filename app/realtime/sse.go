@@ -0,0 +1,95 @@
+package realtime
+
+import (
+	"asset-management-api/assetpb"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// SSEHandler bridges StreamNotifications to Server-Sent Events for the web app.
+// Browsers' EventSource cannot set headers, so the token may also be passed as
+// the access_token query parameter. Resuming uses the standard Last-Event-ID
+// header, or the last_event_id query parameter on the first connection.
+func SSEHandler(client assetpb.NOTIFICATIONServiceClient) http.HandlerFunc {
+	marshaler := protojson.MarshalOptions{UseProtoNames: true}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		token := r.Header.Get("Authorization")
+		if token == "" && r.URL.Query().Get("access_token") != "" {
+			token = "Bearer " + r.URL.Query().Get("access_token")
+		}
+
+		lastEventID := r.Header.Get("Last-Event-ID")
+		if lastEventID == "" {
+			lastEventID = r.URL.Query().Get("last_event_id")
+		}
+		req := &assetpb.StreamNotificationsRequest{}
+		if lastEventID != "" {
+			id, err := strconv.ParseInt(lastEventID, 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid last event ID", http.StatusBadRequest)
+				return
+			}
+			req.LastEventId = id
+		}
+		if hb := r.URL.Query().Get("heartbeat_seconds"); hb != "" {
+			seconds, _ := strconv.Atoi(hb)
+			req.HeartbeatSeconds = int32(seconds)
+		}
+
+		ctx := metadata.AppendToOutgoingContext(r.Context(), "authorization", token)
+		stream, err := client.StreamNotifications(ctx, req)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to open notification stream")
+			http.Error(w, "Failed to open notification stream", http.StatusBadGateway)
+			return
+		}
+
+		// The first Recv surfaces authentication errors before any headers go out
+		evt, err := stream.Recv()
+		if err != nil {
+			log.Warn().Err(err).Msg("Notification stream rejected")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+
+		for {
+			if evt.GetType() == "heartbeat" {
+				fmt.Fprint(w, ": heartbeat\n\n")
+			} else {
+				data, err := marshaler.Marshal(evt)
+				if err != nil {
+					log.Error().Err(err).Msg("Failed to encode notification event")
+					return
+				}
+				fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", evt.GetEventId(), evt.GetType(), data)
+			}
+			flusher.Flush()
+
+			evt, err = stream.Recv()
+			if err != nil {
+				if err != io.EOF && r.Context().Err() == nil {
+					log.Warn().Err(err).Msg("Notification stream closed")
+				}
+				return
+			}
+		}
+	}
+}
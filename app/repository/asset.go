@@ -0,0 +1,369 @@
+package repository
+
+import (
+	"asset-management-api/assetpb"
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AssetFilter selects assets for List and Count.
+type AssetFilter struct {
+	// Query matches the asset name, and the brand too with MatchBrand
+	Query      string
+	MatchBrand bool
+	Scope      Scope
+	// PicRoleId keeps only the assets that role is responsible for
+	PicRoleId int32
+	// ClassificationId keeps only that classification;
+	// ExcludeClassificationId drops it
+	ClassificationId        int32
+	ExcludeClassificationId int32
+	// SortById orders by asset ID instead of name
+	SortById bool
+	// Limit 0 returns every match
+	Offset int32
+	Limit  int32
+}
+
+// AssetChanges maps asset columns to their new values.
+type AssetChanges map[string]interface{}
+
+type AssetRepository interface {
+	Get(ctx context.Context, id int32) (*assetpb.Asset, error)
+	GetByHash(ctx context.Context, hash string) (*assetpb.Asset, error)
+	List(ctx context.Context, filter AssetFilter) ([]*assetpb.Asset, error)
+	Count(ctx context.Context, filter AssetFilter) (int32, error)
+	// LastId is the highest asset ID in use; IDs are assigned by the caller
+	LastId(ctx context.Context) (int32, error)
+	Create(ctx context.Context, asset *assetpb.Asset) error
+	// Update applies the changes and records the asset's status in the
+	// asset update history
+	Update(ctx context.Context, id int32, changes AssetChanges) error
+	// SetDepreciation stores recomputed values without touching the history
+	SetDepreciation(ctx context.Context, id int32, deprecationValue, lastBookValue int32) error
+
+	// Reference data the asset rules depend on
+	Classification(ctx context.Context, id int32) (*assetpb.Classification, error)
+	OutletArea(ctx context.Context, outletId int32) (int32, error)
+	PositionExists(ctx context.Context, id int32) (bool, error)
+	AssetNamingExists(ctx context.Context, idAssetNaming int32) (bool, error)
+	ListAssetNamings(ctx context.Context, offset, limit int32) ([]*assetpb.MstAsset, error)
+}
+
+type pgxAssetRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAssetRepository(db *pgxpool.Pool) AssetRepository {
+	return &pgxAssetRepository{db: db}
+}
+
+// assetSelect is the asset with its reference names, shared by every read.
+const assetSelect = `
+        SELECT
+            assets.asset_id,
+            assets.asset_id_hash,
+            assets.asset_name,
+            assets.asset_brand,
+            assets.asset_specification,
+            assets.asset_classification,
+            assets.asset_status,
+            assets.asset_condition,
+            assets.asset_purchase_date,
+            assets.asset_pic,
+            assets.asset_image,
+            assets.personal_responsible,
+            assets.outlet_id,
+            assets.area_id,
+            assets.asset_maintenance_date,
+            assets.classification_acquisition_value,
+            assets.classification_last_book_value,
+            assets.created_at,
+            assets.updated_at,
+            assets.deprecation_value,
+            assets.asset_quantity,
+            assets.asset_quantity_standard,
+            assets.id_asset_naming,
+            assets.asset_warranty_date,
+            assets.position_id,
+            positions.position_name,
+            maintenance_periods.period_name AS maintenance_period_name,
+            areas.area_name AS area_name,
+            outlets.outlet_name AS outlet_name,
+            roles.role_name AS asset_pic_name,
+            classifications.classification_name AS asset_classification_name,
+            EXTRACT(MONTH FROM AGE(CURRENT_DATE, assets.asset_purchase_date)) AS asset_age
+        FROM assets
+        LEFT JOIN areas ON assets.area_id = areas.area_id
+        LEFT JOIN outlets ON assets.outlet_id = outlets.outlet_id
+        LEFT JOIN roles ON assets.asset_pic = roles.role_id
+        LEFT JOIN classifications ON assets.asset_classification = classifications.classification_id
+        LEFT JOIN maintenance_periods ON classifications.maintenance_period_id = maintenance_periods.period_id
+        LEFT JOIN positions ON assets.position_id = positions.id`
+
+func scanAsset(row pgx.Row) (*assetpb.Asset, error) {
+	var asset assetpb.Asset
+	var assetIdHash, maintenancePeriodName, areaName, outletName, assetPicName, assetClassificationName, positionName sql.NullString
+	var assetAge sql.NullInt64
+	var idAssetNaming, positionId sql.NullInt32
+	var warrantyDate sql.NullTime
+	var assetPurchaseDate, assetMaintenanceDate, createdAt, updatedAt time.Time
+
+	err := row.Scan(
+		&asset.AssetId, &assetIdHash, &asset.AssetName, &asset.AssetBrand, &asset.AssetSpecification, &asset.AssetClassification,
+		&asset.AssetStatus, &asset.AssetCondition, &assetPurchaseDate, &asset.AssetPic, &asset.AssetImage, &asset.PersonalResponsible,
+		&asset.OutletId, &asset.AreaId, &assetMaintenanceDate, &asset.ClassificationAcquisitionValue, &asset.ClassificationLastBookValue,
+		&createdAt, &updatedAt, &asset.DeprecationValue, &asset.AssetQuantity, &asset.AssetQuantityStandard, &idAssetNaming, &warrantyDate,
+		&positionId, &positionName, &maintenancePeriodName, &areaName, &outletName, &assetPicName, &assetClassificationName, &assetAge,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	asset.AssetIdHash = assetIdHash.String
+	asset.MaintenancePeriodName = maintenancePeriodName.String
+	asset.AreaName = areaName.String
+	asset.OutletName = outletName.String
+	asset.AssetPicName = assetPicName.String
+	asset.AssetClassificationName = assetClassificationName.String
+	asset.AssetPurchaseDate = assetPurchaseDate.Format("2006-01-02")
+	asset.AssetMaintenanceDate = assetMaintenanceDate.Format("2006-01-02")
+	asset.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+	asset.UpdatedAt = updatedAt.Format("2006-01-02 15:04:05")
+	asset.PositionId = positionId.Int32
+	asset.PositionName = positionName.String
+	asset.AssetAge = int32(assetAge.Int64)
+	asset.IdAssetNaming = idAssetNaming.Int32
+	if warrantyDate.Valid {
+		asset.AssetWarrantyDate = warrantyDate.Time.Format("2006-01-02")
+	}
+	return &asset, nil
+}
+
+func (r *pgxAssetRepository) Get(ctx context.Context, id int32) (*assetpb.Asset, error) {
+	asset, err := scanAsset(r.db.QueryRow(ctx, assetSelect+` WHERE assets.asset_id = $1`, id))
+	return asset, notFound(err)
+}
+
+func (r *pgxAssetRepository) GetByHash(ctx context.Context, hash string) (*assetpb.Asset, error) {
+	asset, err := scanAsset(r.db.QueryRow(ctx, assetSelect+` WHERE assets.asset_id_hash = $1`, hash))
+	return asset, notFound(err)
+}
+
+func (f AssetFilter) conditions() *conditions {
+	c := &conditions{}
+	if f.Query != "" && f.MatchBrand {
+		c.add("(assets.asset_name ILIKE $%[1]d OR assets.asset_brand ILIKE $%[1]d)", "%"+f.Query+"%")
+	} else if f.Query != "" {
+		c.add("assets.asset_name ILIKE $%d", "%"+f.Query+"%")
+	}
+	if f.PicRoleId != 0 {
+		c.add("assets.asset_pic = $%d", f.PicRoleId)
+	}
+	if f.Scope.OutletId != 0 {
+		c.add("assets.outlet_id = $%d", f.Scope.OutletId)
+	}
+	if f.Scope.AreaId != 0 {
+		c.add("assets.area_id = $%d", f.Scope.AreaId)
+	}
+	if f.ClassificationId != 0 {
+		c.add("assets.asset_classification = $%d", f.ClassificationId)
+	}
+	if f.ExcludeClassificationId != 0 {
+		c.add("assets.asset_classification <> $%d", f.ExcludeClassificationId)
+	}
+	return c
+}
+
+func (r *pgxAssetRepository) List(ctx context.Context, filter AssetFilter) ([]*assetpb.Asset, error) {
+	c := filter.conditions()
+	query := assetSelect + c.where() + " ORDER BY assets.asset_name ASC"
+	if filter.SortById {
+		query = assetSelect + c.where() + " ORDER BY assets.asset_id"
+	}
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %s OFFSET %s", c.next(filter.Limit), c.next(filter.Offset))
+	}
+
+	rows, err := r.db.Query(ctx, query, c.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assets []*assetpb.Asset
+	for rows.Next() {
+		asset, err := scanAsset(rows)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, asset)
+	}
+	return assets, rows.Err()
+}
+
+func (r *pgxAssetRepository) Count(ctx context.Context, filter AssetFilter) (int32, error) {
+	c := filter.conditions()
+	var count int32
+	err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM assets"+c.where(), c.args...).Scan(&count)
+	return count, err
+}
+
+func (r *pgxAssetRepository) LastId(ctx context.Context) (int32, error) {
+	var id int32
+	err := r.db.QueryRow(ctx, "SELECT COALESCE(MAX(asset_id), 0) FROM assets").Scan(&id)
+	return id, err
+}
+
+func (r *pgxAssetRepository) Create(ctx context.Context, a *assetpb.Asset) error {
+	_, err := r.db.Exec(ctx, `
+        INSERT INTO assets (
+            asset_id, asset_id_hash, asset_name, asset_brand, asset_specification,
+            asset_classification, asset_condition, asset_pic, asset_purchase_date,
+            asset_maintenance_date, asset_status, classification_acquisition_value,
+            classification_last_book_value, deprecation_value, outlet_id, area_id,
+            id_asset_naming, asset_image, asset_quantity, asset_quantity_standard,
+            personal_responsible, position_id, asset_warranty_date
+        ) VALUES (
+            $1, $2, $3, $4, $5,
+            $6, $7, $8, $9, $10,
+            $11, $12, $13, $14, $15,
+            $16, $17, $18, $19, $20,
+            $21, $22, NULLIF($23, '')::DATE
+        )`,
+		a.AssetId, a.AssetIdHash, a.AssetName, a.AssetBrand, a.AssetSpecification,
+		a.AssetClassification, a.AssetCondition, a.AssetPic, a.AssetPurchaseDate,
+		a.AssetMaintenanceDate, a.AssetStatus, a.ClassificationAcquisitionValue,
+		a.ClassificationLastBookValue, a.DeprecationValue, a.OutletId, a.AreaId,
+		a.IdAssetNaming, a.AssetImage, a.AssetQuantity, a.AssetQuantityStandard,
+		a.PersonalResponsible, a.PositionId, a.AssetWarrantyDate,
+	)
+	return err
+}
+
+func (r *pgxAssetRepository) Update(ctx context.Context, id int32, changes AssetChanges) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	// Sorted so the statement text is stable
+	columns := make([]string, 0, len(changes))
+	for column := range changes {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	var set []string
+	var args []interface{}
+	for _, column := range columns {
+		args = append(args, changes[column])
+		set = append(set, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	args = append(args, id)
+	query := fmt.Sprintf("UPDATE assets SET %s WHERE asset_id = $%d", strings.Join(set, ", "), len(args))
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	status, _ := changes["asset_status"].(string)
+	if err := recordAssetUpdate(ctx, tx, id, status); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *pgxAssetRepository) SetDepreciation(ctx context.Context, id int32, deprecationValue, lastBookValue int32) error {
+	tag, err := r.db.Exec(ctx, `
+        UPDATE assets SET deprecation_value = $1, classification_last_book_value = $2
+        WHERE asset_id = $3`, deprecationValue, lastBookValue, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// recordAssetUpdate appends to the asset's status history.
+func recordAssetUpdate(ctx context.Context, q querier, assetId int32, status string) error {
+	_, err := q.Exec(ctx, "INSERT INTO asset_updates (asset_id, asset_status) VALUES ($1, $2)", assetId, status)
+	return err
+}
+
+func (r *pgxAssetRepository) Classification(ctx context.Context, id int32) (*assetpb.Classification, error) {
+	var c assetpb.Classification
+	err := r.db.QueryRow(ctx, `
+        SELECT classification_id, classification_name, classification_economic_value, maintenance_period_id
+        FROM classifications WHERE classification_id = $1`, id).Scan(
+		&c.ClassificationId, &c.ClassificationName, &c.ClassificationEconomicValue, &c.MaintenancePeriodId)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &c, nil
+}
+
+func (r *pgxAssetRepository) OutletArea(ctx context.Context, outletId int32) (int32, error) {
+	var areaId int32
+	err := r.db.QueryRow(ctx, "SELECT area_id FROM area_outlets WHERE outlet_id = $1", outletId).Scan(&areaId)
+	return areaId, notFound(err)
+}
+
+func (r *pgxAssetRepository) PositionExists(ctx context.Context, id int32) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM positions WHERE id = $1)", id).Scan(&exists)
+	return exists, err
+}
+
+func (r *pgxAssetRepository) AssetNamingExists(ctx context.Context, idAssetNaming int32) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM mst_assets WHERE id_asset_naming = $1)", idAssetNaming).Scan(&exists)
+	return exists, err
+}
+
+func (r *pgxAssetRepository) ListAssetNamings(ctx context.Context, offset, limit int32) ([]*assetpb.MstAsset, error) {
+	query := `
+        SELECT id_asset_naming, asset_naming, classification_id
+        FROM mst_assets
+        ORDER BY asset_naming ASC
+        OFFSET $1`
+	args := []interface{}{offset}
+	if limit > 0 {
+		query += " LIMIT $2"
+		args = append(args, limit)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var namings []*assetpb.MstAsset
+	for rows.Next() {
+		var naming assetpb.MstAsset
+		if err := rows.Scan(&naming.IdAssetNaming, &naming.AssetNaming, &naming.ClassificationId); err != nil {
+			return nil, err
+		}
+		namings = append(namings, &naming)
+	}
+	return namings, rows.Err()
+}
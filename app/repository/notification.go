@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"asset-management-api/assetpb"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NotificationFilter describes which notifications a user sees in the inbox.
+// Dismissed and currently snoozed notifications of Nip are always hidden.
+type NotificationFilter struct {
+	// Query matches the asset name
+	Query      string
+	Scope      Scope
+	Nip        int32
+	UnreadOnly bool
+	// Status and EscalationLevel, when set, keep only that status or level
+	Status          string
+	EscalationLevel int32
+}
+
+// DeliveryState is a per-user state of a notification.
+type DeliveryState string
+
+const (
+	DeliveryRead      DeliveryState = "read_at"
+	DeliveryDismissed DeliveryState = "dismissed_at"
+	DeliverySnoozed   DeliveryState = "snoozed_until"
+)
+
+type NotificationRepository interface {
+	// Get returns the notification with Nip's read state
+	Get(ctx context.Context, id, nip int32) (*assetpb.Notification, error)
+	List(ctx context.Context, filter NotificationFilter, offset, limit int32) ([]*assetpb.Notification, error)
+	Count(ctx context.Context, filter NotificationFilter) (int32, error)
+	// EscalationCounts counts the matching notifications at each escalation
+	// level above zero; the filter's EscalationLevel is ignored
+	EscalationCounts(ctx context.Context, filter NotificationFilter) ([]*assetpb.EscalationCount, error)
+	// SetDeliveryState records a read, dismiss or snooze by nip. A
+	// notification outside scope is ErrNotFound, like a missing one
+	SetDeliveryState(ctx context.Context, id, nip int32, scope Scope, state DeliveryState, at time.Time) error
+	// MarkAllRead marks every matching notification read for the filter's Nip
+	MarkAllRead(ctx context.Context, filter NotificationFilter) (int64, error)
+
+	// ForAsset returns the asset's notification, submitted ones included
+	// only when submitted is set
+	ForAsset(ctx context.Context, assetId int32, submitted bool) (*assetpb.Notification, error)
+	Create(ctx context.Context, notification *assetpb.Notification) error
+	// UpdateStatus moves the notification to a new date and status
+	UpdateStatus(ctx context.Context, id int32, date, status string) error
+	// ResetDeliveries makes the notification unread and undismissed for everyone
+	ResetDeliveries(ctx context.Context, id int32) error
+	// ResetEscalation clears the escalation level and history
+	ResetEscalation(ctx context.Context, id int32) error
+	// Delete removes the notification with its deliveries and escalations
+	Delete(ctx context.Context, id int32) error
+}
+
+type pgxNotificationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewNotificationRepository(db *pgxpool.Pool) NotificationRepository {
+	return &pgxNotificationRepository{db: db}
+}
+
+// notificationFrom joins the user's delivery state; the NIP is always $1.
+const notificationFrom = `
+        FROM notifications n
+        LEFT JOIN notification_deliveries d ON d.id_notification = n.id_notification AND d.nip = $1`
+
+const notificationSelect = `
+        SELECT n.id_notification, n.asset_id, n.submission_id, n.status,
+               n.asset_name, n.outlet_id, n.area_id, n.maintenance_or_submitted,
+               d.read_at, n.escalation_level, n.escalated_at` + notificationFrom
+
+func scanNotification(row pgx.Row) (*assetpb.Notification, error) {
+	var n assetpb.Notification
+	var submissionId sql.NullInt32
+	var maintenanceOrSubmitted, readAt, escalatedAt sql.NullTime
+	err := row.Scan(&n.IdNotification, &n.AssetId, &submissionId, &n.Status, &n.AssetName, &n.OutletId, &n.AreaId,
+		&maintenanceOrSubmitted, &readAt, &n.EscalationLevel, &escalatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	n.SubmissionId = submissionId.Int32
+	if maintenanceOrSubmitted.Valid {
+		n.MaintenanceOrSubmitted = maintenanceOrSubmitted.Time.Format("2006-01-02")
+	}
+	n.IsRead = readAt.Valid
+	if readAt.Valid {
+		n.ReadAt = readAt.Time.Format("2006-01-02 15:04:05")
+	}
+	if escalatedAt.Valid {
+		n.EscalatedAt = escalatedAt.Time.Format("2006-01-02 15:04:05")
+	}
+	return &n, nil
+}
+
+func (f NotificationFilter) conditions() *conditions {
+	c := &conditions{args: []interface{}{f.Nip}}
+	c.raw("d.dismissed_at IS NULL")
+	c.raw("(d.snoozed_until IS NULL OR d.snoozed_until <= NOW())")
+	if f.Query != "" {
+		c.add("n.asset_name LIKE $%d", "%"+f.Query+"%")
+	}
+	if f.Scope.OutletId != 0 {
+		c.add("n.outlet_id = $%d", f.Scope.OutletId)
+	}
+	if f.Scope.AreaId != 0 {
+		c.add("n.area_id = $%d", f.Scope.AreaId)
+	}
+	if f.UnreadOnly {
+		c.raw("d.read_at IS NULL")
+	}
+	if f.Status != "" {
+		c.add("n.status = $%d", f.Status)
+	}
+	if f.EscalationLevel > 0 {
+		c.add("n.escalation_level = $%d", f.EscalationLevel)
+	}
+	return c
+}
+
+func (r *pgxNotificationRepository) Get(ctx context.Context, id, nip int32) (*assetpb.Notification, error) {
+	n, err := scanNotification(r.db.QueryRow(ctx, notificationSelect+` WHERE n.id_notification = $2`, nip, id))
+	return n, notFound(err)
+}
+
+func (r *pgxNotificationRepository) List(ctx context.Context, filter NotificationFilter, offset, limit int32) ([]*assetpb.Notification, error) {
+	c := filter.conditions()
+	query := notificationSelect + c.where() +
+		fmt.Sprintf(" ORDER BY n.id_notification DESC LIMIT %s OFFSET %s", c.next(limit), c.next(offset))
+
+	rows, err := r.db.Query(ctx, query, c.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*assetpb.Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, rows.Err()
+}
+
+func (r *pgxNotificationRepository) Count(ctx context.Context, filter NotificationFilter) (int32, error) {
+	c := filter.conditions()
+	var count int32
+	err := r.db.QueryRow(ctx, "SELECT COUNT(*)"+notificationFrom+c.where(), c.args...).Scan(&count)
+	return count, err
+}
+
+func (r *pgxNotificationRepository) EscalationCounts(ctx context.Context, filter NotificationFilter) ([]*assetpb.EscalationCount, error) {
+	filter.EscalationLevel = 0
+	c := filter.conditions()
+	c.raw("n.escalation_level > 0")
+	rows, err := r.db.Query(ctx, "SELECT n.escalation_level, COUNT(*)"+notificationFrom+c.where()+`
+        GROUP BY n.escalation_level
+        ORDER BY n.escalation_level`, c.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []*assetpb.EscalationCount
+	for rows.Next() {
+		var count assetpb.EscalationCount
+		if err := rows.Scan(&count.Level, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, &count)
+	}
+	return counts, rows.Err()
+}
+
+func (r *pgxNotificationRepository) SetDeliveryState(ctx context.Context, id, nip int32, scope Scope, state DeliveryState, at time.Time) error {
+	switch state {
+	case DeliveryRead, DeliveryDismissed, DeliverySnoozed:
+	default:
+		return fmt.Errorf("unknown delivery state %q", state)
+	}
+
+	tag, err := r.db.Exec(ctx, fmt.Sprintf(`
+        INSERT INTO notification_deliveries (id_notification, nip, %[1]s)
+        SELECT id_notification, $2, $3 FROM notifications
+        WHERE id_notification = $1 AND ($4 = 0 OR outlet_id = $4) AND ($5 = 0 OR area_id = $5)
+        ON CONFLICT (id_notification, nip) DO UPDATE SET %[1]s = EXCLUDED.%[1]s`, state),
+		id, nip, at, scope.OutletId, scope.AreaId)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *pgxNotificationRepository) MarkAllRead(ctx context.Context, filter NotificationFilter) (int64, error) {
+	filter.UnreadOnly = true
+	c := filter.conditions()
+	tag, err := r.db.Exec(ctx, `
+        INSERT INTO notification_deliveries (id_notification, nip, read_at)
+        SELECT n.id_notification, $1, NOW()`+notificationFrom+c.where()+`
+        ON CONFLICT (id_notification, nip) DO UPDATE SET read_at = EXCLUDED.read_at`, c.args...)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *pgxNotificationRepository) ForAsset(ctx context.Context, assetId int32, submitted bool) (*assetpb.Notification, error) {
+	query := notificationSelect + ` WHERE n.asset_id = $2 AND n.status <> 'submitted'`
+	if submitted {
+		query = notificationSelect + ` WHERE n.asset_id = $2 AND n.status = 'submitted'`
+	}
+	n, err := scanNotification(r.db.QueryRow(ctx, query+` LIMIT 1`, 0, assetId))
+	return n, notFound(err)
+}
+
+func (r *pgxNotificationRepository) Create(ctx context.Context, n *assetpb.Notification) error {
+	_, err := r.db.Exec(ctx, `
+        INSERT INTO notifications (asset_id, submission_id, asset_name, outlet_id, area_id, maintenance_or_submitted, status)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		n.AssetId, nullableId(n.SubmissionId), n.AssetName, n.OutletId, n.AreaId, n.MaintenanceOrSubmitted, n.Status)
+	return err
+}
+
+func (r *pgxNotificationRepository) UpdateStatus(ctx context.Context, id int32, date, status string) error {
+	_, err := r.db.Exec(ctx, `UPDATE notifications SET maintenance_or_submitted = $1, status = $2 WHERE id_notification = $3`,
+		date, status, id)
+	return err
+}
+
+func (r *pgxNotificationRepository) ResetDeliveries(ctx context.Context, id int32) error {
+	_, err := r.db.Exec(ctx, `UPDATE notification_deliveries SET read_at = NULL, dismissed_at = NULL WHERE id_notification = $1`, id)
+	return err
+}
+
+func (r *pgxNotificationRepository) ResetEscalation(ctx context.Context, id int32) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `UPDATE notifications SET escalation_level = 0, escalated_at = NULL WHERE id_notification = $1`, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM notification_escalations WHERE id_notification = $1`, id); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *pgxNotificationRepository) Delete(ctx context.Context, id int32) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, query := range []string{
+		`DELETE FROM notification_deliveries WHERE id_notification = $1`,
+		`DELETE FROM notification_escalations WHERE id_notification = $1`,
+		`DELETE FROM notifications WHERE id_notification = $1`,
+	} {
+		if _, err := tx.Exec(ctx, query, id); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,87 @@
+// Package repository holds the SQL behind the services. Each repository is an
+// interface with a pgx implementation, so services can be exercised against
+// in-memory fakes instead of Postgres.
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrNotFound is returned when the requested row does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Scope limits results to an outlet and/or an area. Zero fields are ignored,
+// so the zero Scope is unrestricted.
+type Scope struct {
+	OutletId int32
+	AreaId   int32
+}
+
+// querier is implemented by both *pgxpool.Pool and pgx.Tx.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// notFound maps pgx.ErrNoRows to ErrNotFound.
+func notFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// collectInt32 reads a single int32 column from every row.
+func collectInt32(rows pgx.Rows, err error) ([]int32, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []int32
+	for rows.Next() {
+		var v int32
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, rows.Err()
+}
+
+// conditions builds a WHERE clause with numbered placeholders. Each condition
+// takes one argument and contains a single %d for its placeholder number.
+type conditions struct {
+	clauses []string
+	args    []interface{}
+}
+
+func (c *conditions) add(clause string, arg interface{}) {
+	c.args = append(c.args, arg)
+	c.clauses = append(c.clauses, fmt.Sprintf(clause, len(c.args)))
+}
+
+// raw adds a condition without an argument.
+func (c *conditions) raw(clause string) {
+	c.clauses = append(c.clauses, clause)
+}
+
+// next returns the placeholder for an argument appended after the conditions.
+func (c *conditions) next(arg interface{}) string {
+	c.args = append(c.args, arg)
+	return fmt.Sprintf("$%d", len(c.args))
+}
+
+func (c *conditions) where() string {
+	if len(c.clauses) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(c.clauses, " AND ")
+}
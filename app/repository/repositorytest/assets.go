@@ -0,0 +1,276 @@
+// Package repositorytest provides in-memory fakes of the repositories, so
+// services can be tested without Postgres. The fakes keep their data in
+// exported fields that tests fill and inspect directly; reads return copies.
+package repositorytest
+
+import (
+	"asset-management-api/app/repository"
+	"asset-management-api/assetpb"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AssetUpdate is an entry of the asset status history.
+type AssetUpdate struct {
+	AssetId int32
+	Status  string
+}
+
+// Assets is an in-memory repository.AssetRepository.
+type Assets struct {
+	mu              sync.Mutex
+	Items           map[int32]*assetpb.Asset
+	Classifications map[int32]*assetpb.Classification
+	// OutletAreas maps outlets to their area
+	OutletAreas map[int32]int32
+	Positions   map[int32]bool
+	Namings     []*assetpb.MstAsset
+	// Updates is the status history written by Update
+	Updates []AssetUpdate
+}
+
+var _ repository.AssetRepository = (*Assets)(nil)
+
+func NewAssets(assets ...*assetpb.Asset) *Assets {
+	r := &Assets{
+		Items:           make(map[int32]*assetpb.Asset),
+		Classifications: make(map[int32]*assetpb.Classification),
+		OutletAreas:     make(map[int32]int32),
+		Positions:       make(map[int32]bool),
+	}
+	for _, asset := range assets {
+		r.Items[asset.AssetId] = asset
+	}
+	return r
+}
+
+func (r *Assets) Get(ctx context.Context, id int32) (*assetpb.Asset, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	asset, ok := r.Items[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return proto.Clone(asset).(*assetpb.Asset), nil
+}
+
+func (r *Assets) GetByHash(ctx context.Context, hash string) (*assetpb.Asset, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, asset := range r.Items {
+		if asset.AssetIdHash == hash {
+			return proto.Clone(asset).(*assetpb.Asset), nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// matches applies the filter the way AssetFilter's SQL conditions do.
+func matches(f repository.AssetFilter, a *assetpb.Asset) bool {
+	if f.Query != "" {
+		query := strings.ToLower(f.Query)
+		name := strings.Contains(strings.ToLower(a.AssetName), query)
+		brand := f.MatchBrand && strings.Contains(strings.ToLower(a.AssetBrand), query)
+		if !name && !brand {
+			return false
+		}
+	}
+	switch {
+	case f.Scope.OutletId != 0 && a.OutletId != f.Scope.OutletId,
+		f.Scope.AreaId != 0 && a.AreaId != f.Scope.AreaId,
+		f.PicRoleId != 0 && a.AssetPic != f.PicRoleId,
+		f.ClassificationId != 0 && a.AssetClassification != f.ClassificationId,
+		f.ExcludeClassificationId != 0 && a.AssetClassification == f.ExcludeClassificationId:
+		return false
+	}
+	return true
+}
+
+func (r *Assets) List(ctx context.Context, filter repository.AssetFilter) ([]*assetpb.Asset, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var assets []*assetpb.Asset
+	for _, asset := range r.Items {
+		if matches(filter, asset) {
+			assets = append(assets, proto.Clone(asset).(*assetpb.Asset))
+		}
+	}
+	sort.Slice(assets, func(i, j int) bool {
+		if filter.SortById || assets[i].AssetName == assets[j].AssetName {
+			return assets[i].AssetId < assets[j].AssetId
+		}
+		return assets[i].AssetName < assets[j].AssetName
+	})
+	if filter.Limit > 0 {
+		assets = page(assets, filter.Offset, filter.Limit)
+	}
+	return assets, nil
+}
+
+func (r *Assets) Count(ctx context.Context, filter repository.AssetFilter) (int32, error) {
+	filter.Offset, filter.Limit = 0, 0
+	assets, err := r.List(ctx, filter)
+	return int32(len(assets)), err
+}
+
+func (r *Assets) LastId(ctx context.Context) (int32, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var last int32
+	for id := range r.Items {
+		if id > last {
+			last = id
+		}
+	}
+	return last, nil
+}
+
+func (r *Assets) Create(ctx context.Context, asset *assetpb.Asset) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.Items[asset.AssetId]; ok {
+		return fmt.Errorf("asset %d already exists", asset.AssetId)
+	}
+	r.Items[asset.AssetId] = proto.Clone(asset).(*assetpb.Asset)
+	return nil
+}
+
+// Update sets the asset fields named like the changed columns; a column
+// without such a field is an error, as it would be in SQL.
+func (r *Assets) Update(ctx context.Context, id int32, changes repository.AssetChanges) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	asset, ok := r.Items[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	updated := proto.Clone(asset).(*assetpb.Asset)
+	message := updated.ProtoReflect()
+	for column, value := range changes {
+		field := message.Descriptor().Fields().ByName(protoreflect.Name(column))
+		if field == nil {
+			return fmt.Errorf("column %q does not exist", column)
+		}
+		v, err := fieldValue(field, value)
+		if err != nil {
+			return fmt.Errorf("column %q: %w", column, err)
+		}
+		message.Set(field, v)
+	}
+	r.Items[id] = updated
+
+	status, _ := changes["asset_status"].(string)
+	r.Updates = append(r.Updates, AssetUpdate{AssetId: id, Status: status})
+	return nil
+}
+
+// fieldValue converts a column value to the type of field.
+func fieldValue(field protoreflect.FieldDescriptor, value interface{}) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		if s, ok := value.(string); ok {
+			return protoreflect.ValueOfString(s), nil
+		}
+	case protoreflect.Int32Kind:
+		switch n := value.(type) {
+		case int32:
+			return protoreflect.ValueOfInt32(n), nil
+		case int:
+			return protoreflect.ValueOfInt32(int32(n)), nil
+		case int64:
+			return protoreflect.ValueOfInt32(int32(n)), nil
+		}
+	}
+	return protoreflect.Value{}, fmt.Errorf("cannot store %T as %s", value, field.Kind())
+}
+
+func (r *Assets) SetDepreciation(ctx context.Context, id int32, deprecationValue, lastBookValue int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	asset, ok := r.Items[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	updated := proto.Clone(asset).(*assetpb.Asset)
+	updated.DeprecationValue = deprecationValue
+	updated.ClassificationLastBookValue = lastBookValue
+	r.Items[id] = updated
+	return nil
+}
+
+func (r *Assets) Classification(ctx context.Context, id int32) (*assetpb.Classification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	classification, ok := r.Classifications[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return proto.Clone(classification).(*assetpb.Classification), nil
+}
+
+func (r *Assets) OutletArea(ctx context.Context, outletId int32) (int32, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	areaId, ok := r.OutletAreas[outletId]
+	if !ok {
+		return 0, repository.ErrNotFound
+	}
+	return areaId, nil
+}
+
+func (r *Assets) PositionExists(ctx context.Context, id int32) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.Positions[id], nil
+}
+
+func (r *Assets) AssetNamingExists(ctx context.Context, idAssetNaming int32) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, naming := range r.Namings {
+		if naming.IdAssetNaming == idAssetNaming {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *Assets) ListAssetNamings(ctx context.Context, offset, limit int32) ([]*assetpb.MstAsset, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	namings := make([]*assetpb.MstAsset, 0, len(r.Namings))
+	for _, naming := range r.Namings {
+		namings = append(namings, proto.Clone(naming).(*assetpb.MstAsset))
+	}
+	sort.Slice(namings, func(i, j int) bool { return namings[i].AssetNaming < namings[j].AssetNaming })
+	if limit <= 0 {
+		return page(namings, offset, int32(len(namings))), nil
+	}
+	return page(namings, offset, limit), nil
+}
+
+// page returns limit items from offset, like OFFSET and LIMIT.
+func page[T any](items []T, offset, limit int32) []T {
+	if offset < 0 {
+		offset = 0
+	}
+	if int(offset) >= len(items) {
+		return nil
+	}
+	items = items[offset:]
+	if int(limit) < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
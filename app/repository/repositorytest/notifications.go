@@ -0,0 +1,251 @@
+package repositorytest
+
+import (
+	"asset-management-api/app/repository"
+	"asset-management-api/assetpb"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Delivery is the state of a notification for one user.
+type Delivery struct {
+	ReadAt       *time.Time
+	DismissedAt  *time.Time
+	SnoozedUntil *time.Time
+}
+
+type deliveryKey struct {
+	id, nip int32
+}
+
+// Notifications is an in-memory repository.NotificationRepository.
+type Notifications struct {
+	mu         sync.Mutex
+	Items      map[int32]*assetpb.Notification
+	deliveries map[deliveryKey]*Delivery
+	// Now is the time snoozes are compared with
+	Now    func() time.Time
+	nextId int32
+}
+
+var _ repository.NotificationRepository = (*Notifications)(nil)
+
+func NewNotifications(notifications ...*assetpb.Notification) *Notifications {
+	r := &Notifications{
+		Items:      make(map[int32]*assetpb.Notification),
+		deliveries: make(map[deliveryKey]*Delivery),
+		Now:        time.Now,
+	}
+	for _, n := range notifications {
+		r.Items[n.IdNotification] = n
+		if n.IdNotification > r.nextId {
+			r.nextId = n.IdNotification
+		}
+	}
+	return r
+}
+
+// Delivery returns the state of notification id for nip, nil if they never
+// touched it.
+func (r *Notifications) Delivery(id, nip int32) *Delivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.deliveries[deliveryKey{id, nip}]
+}
+
+// withDelivery copies n with the read state of nip.
+func (r *Notifications) withDelivery(n *assetpb.Notification, nip int32) *assetpb.Notification {
+	n = proto.Clone(n).(*assetpb.Notification)
+	n.IsRead, n.ReadAt = false, ""
+	if d := r.deliveries[deliveryKey{n.IdNotification, nip}]; d != nil && d.ReadAt != nil {
+		n.IsRead, n.ReadAt = true, d.ReadAt.Format("2006-01-02 15:04:05")
+	}
+	return n
+}
+
+// matching returns the notifications the filter selects, newest first, with
+// the read state of the filter's Nip.
+func (r *Notifications) matching(f repository.NotificationFilter) []*assetpb.Notification {
+	now := r.Now()
+	var notifications []*assetpb.Notification
+	for _, n := range r.Items {
+		d := r.deliveries[deliveryKey{n.IdNotification, f.Nip}]
+		if d == nil {
+			d = &Delivery{}
+		}
+		switch {
+		case d.DismissedAt != nil,
+			d.SnoozedUntil != nil && d.SnoozedUntil.After(now),
+			f.Query != "" && !strings.Contains(n.AssetName, f.Query),
+			f.Scope.OutletId != 0 && n.OutletId != f.Scope.OutletId,
+			f.Scope.AreaId != 0 && n.AreaId != f.Scope.AreaId,
+			f.UnreadOnly && d.ReadAt != nil,
+			f.Status != "" && n.Status != f.Status,
+			f.EscalationLevel > 0 && n.EscalationLevel != f.EscalationLevel:
+			continue
+		}
+		notifications = append(notifications, r.withDelivery(n, f.Nip))
+	}
+	sort.Slice(notifications, func(i, j int) bool {
+		return notifications[i].IdNotification > notifications[j].IdNotification
+	})
+	return notifications
+}
+
+func (r *Notifications) Get(ctx context.Context, id, nip int32) (*assetpb.Notification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n, ok := r.Items[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return r.withDelivery(n, nip), nil
+}
+
+func (r *Notifications) List(ctx context.Context, filter repository.NotificationFilter, offset, limit int32) ([]*assetpb.Notification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return page(r.matching(filter), offset, limit), nil
+}
+
+func (r *Notifications) Count(ctx context.Context, filter repository.NotificationFilter) (int32, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return int32(len(r.matching(filter))), nil
+}
+
+func (r *Notifications) EscalationCounts(ctx context.Context, filter repository.NotificationFilter) ([]*assetpb.EscalationCount, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	filter.EscalationLevel = 0
+	byLevel := make(map[int32]int32)
+	for _, n := range r.matching(filter) {
+		if n.EscalationLevel > 0 {
+			byLevel[n.EscalationLevel]++
+		}
+	}
+	var counts []*assetpb.EscalationCount
+	for level, count := range byLevel {
+		counts = append(counts, &assetpb.EscalationCount{Level: level, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].Level < counts[j].Level })
+	return counts, nil
+}
+
+func (r *Notifications) SetDeliveryState(ctx context.Context, id, nip int32, scope repository.Scope, state repository.DeliveryState, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n, ok := r.Items[id]
+	if !ok || scope.OutletId != 0 && n.OutletId != scope.OutletId || scope.AreaId != 0 && n.AreaId != scope.AreaId {
+		return repository.ErrNotFound
+	}
+	d := r.delivery(id, nip)
+	switch state {
+	case repository.DeliveryRead:
+		d.ReadAt = &at
+	case repository.DeliveryDismissed:
+		d.DismissedAt = &at
+	case repository.DeliverySnoozed:
+		d.SnoozedUntil = &at
+	default:
+		return fmt.Errorf("unknown delivery state %q", state)
+	}
+	return nil
+}
+
+func (r *Notifications) delivery(id, nip int32) *Delivery {
+	key := deliveryKey{id, nip}
+	d, ok := r.deliveries[key]
+	if !ok {
+		d = &Delivery{}
+		r.deliveries[key] = d
+	}
+	return d
+}
+
+func (r *Notifications) MarkAllRead(ctx context.Context, filter repository.NotificationFilter) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	filter.UnreadOnly = true
+	now := r.Now()
+	unread := r.matching(filter)
+	for _, n := range unread {
+		r.delivery(n.IdNotification, filter.Nip).ReadAt = &now
+	}
+	return int64(len(unread)), nil
+}
+
+func (r *Notifications) ForAsset(ctx context.Context, assetId int32, submitted bool) (*assetpb.Notification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var found *assetpb.Notification
+	for _, n := range r.Items {
+		if n.AssetId == assetId && (n.Status == "submitted") == submitted {
+			if found == nil || n.IdNotification < found.IdNotification {
+				found = n
+			}
+		}
+	}
+	if found == nil {
+		return nil, repository.ErrNotFound
+	}
+	return r.withDelivery(found, 0), nil
+}
+
+// Create stores the notification under the next free ID.
+func (r *Notifications) Create(ctx context.Context, n *assetpb.Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextId++
+	n = proto.Clone(n).(*assetpb.Notification)
+	n.IdNotification = r.nextId
+	r.Items[n.IdNotification] = n
+	return nil
+}
+
+func (r *Notifications) UpdateStatus(ctx context.Context, id int32, date, status string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n, ok := r.Items[id]; ok {
+		n.MaintenanceOrSubmitted, n.Status = date, status
+	}
+	return nil
+}
+
+func (r *Notifications) ResetDeliveries(ctx context.Context, id int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, d := range r.deliveries {
+		if key.id == id {
+			d.ReadAt, d.DismissedAt = nil, nil
+		}
+	}
+	return nil
+}
+
+func (r *Notifications) ResetEscalation(ctx context.Context, id int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n, ok := r.Items[id]; ok {
+		n.EscalationLevel, n.EscalatedAt = 0, ""
+	}
+	return nil
+}
+
+func (r *Notifications) Delete(ctx context.Context, id int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.Items, id)
+	for key := range r.deliveries {
+		if key.id == id {
+			delete(r.deliveries, key)
+		}
+	}
+	return nil
+}
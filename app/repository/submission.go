@@ -0,0 +1,307 @@
+package repository
+
+import (
+	"asset-management-api/assetpb"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Grouping selects submissions by whether they belong to a submission parent.
+type Grouping int
+
+const (
+	AnyGrouping Grouping = iota
+	Ungrouped
+	Grouped
+)
+
+// SubmissionFilter selects submissions for List and Count.
+type SubmissionFilter struct {
+	// Query matches the submission name
+	Query    string
+	Scope    Scope
+	Grouping Grouping
+	ParentId int32
+	// Limit 0 returns every match
+	Offset int32
+	Limit  int32
+}
+
+// SubmissionParentFilter selects submission parents for ListParents and CountParents.
+type SubmissionParentFilter struct {
+	// Query matches the NIP partially, Nip exactly
+	Query  string
+	Nip    string
+	Offset int32
+	Limit  int32
+}
+
+type SubmissionRepository interface {
+	Get(ctx context.Context, id int32) (*assetpb.Submission, error)
+	List(ctx context.Context, filter SubmissionFilter) ([]*assetpb.Submission, error)
+	Count(ctx context.Context, filter SubmissionFilter) (int32, error)
+	CountByCategory(ctx context.Context, category string) (int32, error)
+	// Create stores the submission under the next free ID and returns it
+	Create(ctx context.Context, submission *assetpb.Submission) (int32, error)
+	// UpdateStatus sets the status of the submission and its asset, logging
+	// both changes, in one transaction
+	UpdateStatus(ctx context.Context, id int32, status string) error
+
+	// CreateParent groups the submissions under a new parent created by nip
+	// for the scope's outlet and area, and returns its ID
+	CreateParent(ctx context.Context, nip string, scope Scope, submissionIds []int32) (int32, error)
+	ListParents(ctx context.Context, filter SubmissionParentFilter) ([]*assetpb.SubmissionParent, error)
+	CountParents(ctx context.Context, filter SubmissionParentFilter) (int32, error)
+}
+
+type pgxSubmissionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSubmissionRepository(db *pgxpool.Pool) SubmissionRepository {
+	return &pgxSubmissionRepository{db: db}
+}
+
+const submissionSelect = `
+        SELECT
+            submission_id, submission_name, submission_outlet, submission_area, created_at,
+            submission_category, submission_status, submission_purpose, submission_quantity,
+            submission_asset_name, submission_description, nip, asset_id, attachment,
+            validator_id, validator_type, submission_price, submission_role_name,
+            outlet_id, area_id, submission_pr_name, submission_parent_id
+        FROM submissions`
+
+func scanSubmission(row pgx.Row) (*assetpb.Submission, error) {
+	var submission assetpb.Submission
+	var createdAt time.Time
+	var nip, assetId, validatorId, submissionPrice, outletId, areaId, submissionParentId sql.NullInt32
+	var submissionOutlet, submissionArea, submissionCategory, submissionPurpose, submissionAssetName,
+		submissionDescription, attachment, validatorType, submissionRoleName, submissionPrName sql.NullString
+
+	err := row.Scan(
+		&submission.SubmissionId, &submission.SubmissionName, &submissionOutlet, &submissionArea, &createdAt,
+		&submissionCategory, &submission.SubmissionStatus, &submissionPurpose, &submission.SubmissionQuantity,
+		&submissionAssetName, &submissionDescription, &nip, &assetId, &attachment,
+		&validatorId, &validatorType, &submissionPrice, &submissionRoleName,
+		&outletId, &areaId, &submissionPrName, &submissionParentId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	submission.SubmissionOutlet = submissionOutlet.String
+	submission.SubmissionArea = submissionArea.String
+	submission.SubmissionCategory = submissionCategory.String
+	submission.SubmissionPurpose = submissionPurpose.String
+	submission.SubmissionAssetName = submissionAssetName.String
+	submission.SubmissionDescription = submissionDescription.String
+	submission.Attachment = attachment.String
+	submission.ValidatorType = validatorType.String
+	submission.SubmissionRoleName = submissionRoleName.String
+	submission.SubmissionPrName = submissionPrName.String
+	submission.Nip = nip.Int32
+	submission.AssetId = assetId.Int32
+	submission.ValidatorId = validatorId.Int32
+	submission.SubmissionPrice = submissionPrice.Int32
+	submission.OutletId = outletId.Int32
+	submission.AreaId = areaId.Int32
+	submission.SubmissionParentId = submissionParentId.Int32
+	submission.SubmissionDate = createdAt.Format(time.RFC3339)
+	return &submission, nil
+}
+
+func (r *pgxSubmissionRepository) Get(ctx context.Context, id int32) (*assetpb.Submission, error) {
+	submission, err := scanSubmission(r.db.QueryRow(ctx, submissionSelect+` WHERE submission_id = $1`, id))
+	return submission, notFound(err)
+}
+
+func (f SubmissionFilter) conditions() *conditions {
+	c := &conditions{}
+	if f.Query != "" {
+		c.add("submission_name ILIKE $%d", "%"+f.Query+"%")
+	}
+	if f.Scope.OutletId != 0 {
+		c.add("outlet_id = $%d", f.Scope.OutletId)
+	}
+	if f.Scope.AreaId != 0 {
+		c.add("area_id = $%d", f.Scope.AreaId)
+	}
+	if f.ParentId != 0 {
+		c.add("submission_parent_id = $%d", f.ParentId)
+	}
+	switch f.Grouping {
+	case Grouped:
+		c.raw("submission_parent_id IS NOT NULL")
+	case Ungrouped:
+		c.raw("submission_parent_id IS NULL")
+	}
+	return c
+}
+
+func (r *pgxSubmissionRepository) List(ctx context.Context, filter SubmissionFilter) ([]*assetpb.Submission, error) {
+	c := filter.conditions()
+	query := submissionSelect + c.where() + " ORDER BY created_at DESC"
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %s OFFSET %s", c.next(filter.Limit), c.next(filter.Offset))
+	}
+
+	rows, err := r.db.Query(ctx, query, c.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var submissions []*assetpb.Submission
+	for rows.Next() {
+		submission, err := scanSubmission(rows)
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, submission)
+	}
+	return submissions, rows.Err()
+}
+
+func (r *pgxSubmissionRepository) Count(ctx context.Context, filter SubmissionFilter) (int32, error) {
+	c := filter.conditions()
+	var count int32
+	err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM submissions"+c.where(), c.args...).Scan(&count)
+	return count, err
+}
+
+func (r *pgxSubmissionRepository) CountByCategory(ctx context.Context, category string) (int32, error) {
+	var count int32
+	err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM submissions WHERE submission_category = $1", category).Scan(&count)
+	return count, err
+}
+
+func (r *pgxSubmissionRepository) Create(ctx context.Context, s *assetpb.Submission) (int32, error) {
+	var id int32
+	err := r.db.QueryRow(ctx, `
+        INSERT INTO submissions (
+            submission_id, submission_name, submission_outlet, outlet_id, area_id, submission_area,
+            submission_date, submission_category, submission_status, submission_purpose,
+            submission_asset_name, submission_quantity, submission_description, nip, asset_id,
+            submission_pr_name, submission_role_name, attachment, submission_price
+        )
+        VALUES (
+            (SELECT COALESCE(MAX(submission_id), 0) + 1 FROM submissions),
+            $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+        )
+        RETURNING submission_id`,
+		s.SubmissionName, s.SubmissionOutlet, s.OutletId, s.AreaId, s.SubmissionArea,
+		s.SubmissionDate, s.SubmissionCategory, s.SubmissionStatus, s.SubmissionPurpose,
+		s.SubmissionAssetName, s.SubmissionQuantity, s.SubmissionDescription, s.Nip, s.AssetId,
+		s.SubmissionPrName, s.SubmissionRoleName, s.Attachment, s.SubmissionPrice,
+	).Scan(&id)
+	return id, err
+}
+
+func (r *pgxSubmissionRepository) UpdateStatus(ctx context.Context, id int32, status string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var submissionName, submissionPrName sql.NullString
+	var assetId int32
+	err = tx.QueryRow(ctx, `
+        UPDATE submissions SET submission_status = $1 WHERE submission_id = $2
+        RETURNING submission_name, submission_pr_name, asset_id`, status, id).Scan(&submissionName, &submissionPrName, &assetId)
+	if err != nil {
+		return notFound(err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO submission_logs (submission_id, status, description, pr_name) VALUES ($1, $2, $3, $4)",
+		id, status, "Status updated by "+submissionName.String, submissionPrName.String)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, "UPDATE assets SET asset_status = $1 WHERE asset_id = $2", status, assetId); err != nil {
+		return err
+	}
+	if err := recordAssetUpdate(ctx, tx, assetId, status); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *pgxSubmissionRepository) CreateParent(ctx context.Context, nip string, scope Scope, submissionIds []int32) (int32, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var id int32
+	err = tx.QueryRow(ctx, `
+        INSERT INTO submission_parents (submission_parent_id, nip, created_at, outlet_id, area_id)
+        VALUES ((SELECT COALESCE(MAX(submission_parent_id), 0) + 1 FROM submission_parents), $1, NOW(), $2, $3)
+        RETURNING submission_parent_id`, nip, scope.OutletId, scope.AreaId).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE submissions SET submission_parent_id = $1 WHERE submission_id = ANY($2)", id, submissionIds)
+	if err != nil {
+		return 0, err
+	}
+	return id, tx.Commit(ctx)
+}
+
+func (f SubmissionParentFilter) conditions() *conditions {
+	c := &conditions{}
+	if f.Query != "" {
+		c.add("submission_parents.nip LIKE $%d", "%"+f.Query+"%")
+	}
+	if f.Nip != "" {
+		c.add("submission_parents.nip = $%d", f.Nip)
+	}
+	return c
+}
+
+func (r *pgxSubmissionRepository) ListParents(ctx context.Context, filter SubmissionParentFilter) ([]*assetpb.SubmissionParent, error) {
+	c := filter.conditions()
+	query := `
+        SELECT submission_parents.submission_parent_id, submission_parents.nip, submission_parents.created_at,
+               COALESCE(outlets.outlet_name, ''), COALESCE(areas.area_name, '')
+        FROM submission_parents
+        LEFT JOIN outlets ON outlets.outlet_id = submission_parents.outlet_id
+        LEFT JOIN areas ON areas.area_id = submission_parents.area_id` + c.where() +
+		" ORDER BY submission_parents.submission_parent_id ASC"
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %s OFFSET %s", c.next(filter.Limit), c.next(filter.Offset))
+	}
+
+	rows, err := r.db.Query(ctx, query, c.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var parents []*assetpb.SubmissionParent
+	for rows.Next() {
+		var parent assetpb.SubmissionParent
+		var createdAt time.Time
+		if err := rows.Scan(&parent.SubmissionParentId, &parent.Nip, &createdAt, &parent.OutletName, &parent.AreaName); err != nil {
+			return nil, err
+		}
+		parent.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		parents = append(parents, &parent)
+	}
+	return parents, rows.Err()
+}
+
+func (r *pgxSubmissionRepository) CountParents(ctx context.Context, filter SubmissionParentFilter) (int32, error) {
+	c := filter.conditions()
+	var count int32
+	err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM submission_parents"+c.where(), c.args...).Scan(&count)
+	return count, err
+}
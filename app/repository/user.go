@@ -0,0 +1,337 @@
+package repository
+
+import (
+	"asset-management-api/assetpb"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Role IDs whose users are tied to one area or one outlet.
+const (
+	RoleArea   int32 = 5
+	RoleOutlet int32 = 6
+)
+
+// User statuses. Deleted users are kept for the records that refer to them
+// but are otherwise treated as gone.
+const (
+	UserActive      = "active"
+	UserDeactivated = "deactivated"
+	UserDeleted     = "deleted"
+)
+
+// ErrInvalidSuccessor means the user to hand work over to does not exist or
+// is not active.
+var ErrInvalidSuccessor = errors.New("successor not found or not active")
+
+// UserFilter selects users for List and Count.
+type UserFilter struct {
+	// Query matches the full name or email
+	Query string
+	// Status is UserActive or UserDeactivated; both when empty
+	Status string
+	Offset int32
+	Limit  int32
+}
+
+// Reassignment counts what offboarding a user handed to their successor.
+type Reassignment struct {
+	Submissions       int32
+	SubmissionParents int32
+	NotificationRules int32
+}
+
+type UserRepository interface {
+	Get(ctx context.Context, nip int32) (*assetpb.User, error)
+	// GetByEmail matches the email address case-insensitively
+	GetByEmail(ctx context.Context, email string) (*assetpb.User, error)
+	List(ctx context.Context, filter UserFilter) ([]*assetpb.User, error)
+	Count(ctx context.Context, filter UserFilter) (int32, error)
+	Exists(ctx context.Context, nip int32) (bool, error)
+	// Create stores the user with an already hashed password, which they
+	// must change at their first login
+	Create(ctx context.Context, user *assetpb.User, passwordHash string) error
+	// Update changes name, email and role; area and outlet only when non-zero
+	Update(ctx context.Context, user *assetpb.User) error
+	// Delete marks the user deleted, which also deactivates them
+	Delete(ctx context.Context, nip int32) error
+	// Deactivate blocks the user from logging in and revokes their sessions
+	Deactivate(ctx context.Context, nip int32) error
+	// Reactivate lets a deactivated user log in again
+	Reactivate(ctx context.Context, nip int32) error
+	// Offboard hands the user's submissions whose status is not one of
+	// closedStatuses, and the notification rules addressed to them, to
+	// successor, then deactivates the user. The successor must be active.
+	Offboard(ctx context.Context, nip, successor int32, closedStatuses []string) (Reassignment, error)
+
+	// InScope returns the outlet users of outletId and the area users of
+	// areaId, i.e. the people responsible for an asset located there.
+	InScope(ctx context.Context, outletId, areaId int32) ([]int32, error)
+	// WithRole returns the users of roleId who should hear about an asset at
+	// outletId/areaId: outlet and area roles are limited to their own outlet
+	// or area, any other role (e.g. HQ) is included regardless of location.
+	WithRole(ctx context.Context, roleId, outletId, areaId int32) ([]int32, error)
+}
+
+type pgxUserRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewUserRepository(db *pgxpool.Pool) UserRepository {
+	return &pgxUserRepository{db: db}
+}
+
+const userSelect = `
+        SELECT users.nip, users.user_full_name, users.user_email, users.role_id,
+               users.area_id, users.outlet_id, roles.role_name, users.status
+        FROM users
+        LEFT JOIN roles ON users.role_id = roles.role_id`
+
+func scanUser(row pgx.Row) (*assetpb.User, error) {
+	var user assetpb.User
+	var areaId, outletId sql.NullInt32
+	var roleName sql.NullString
+	if err := row.Scan(&user.Nip, &user.UserFullName, &user.UserEmail, &user.RoleId, &areaId, &outletId, &roleName, &user.Status); err != nil {
+		return nil, err
+	}
+	user.AreaId = areaId.Int32
+	user.OutletId = outletId.Int32
+	user.RoleName = roleName.String
+	return &user, nil
+}
+
+func (r *pgxUserRepository) Get(ctx context.Context, nip int32) (*assetpb.User, error) {
+	user, err := scanUser(r.db.QueryRow(ctx, userSelect+` WHERE users.nip = $1 AND users.status <> 'deleted'`, nip))
+	return user, notFound(err)
+}
+
+func (r *pgxUserRepository) GetByEmail(ctx context.Context, email string) (*assetpb.User, error) {
+	user, err := scanUser(r.db.QueryRow(ctx, userSelect+` WHERE LOWER(users.user_email) = LOWER($1) AND users.status <> 'deleted' LIMIT 1`, email))
+	return user, notFound(err)
+}
+
+func (f UserFilter) conditions() *conditions {
+	c := &conditions{}
+	if f.Status != "" {
+		c.add("users.status = $%d", f.Status)
+	} else {
+		c.add("users.status <> $%d", UserDeleted)
+	}
+	if f.Query != "" {
+		c.add("(users.user_full_name LIKE $%[1]d OR users.user_email LIKE $%[1]d)", "%"+f.Query+"%")
+	}
+	return c
+}
+
+func (r *pgxUserRepository) List(ctx context.Context, filter UserFilter) ([]*assetpb.User, error) {
+	c := filter.conditions()
+	query := userSelect + c.where() + " ORDER BY users.nip ASC"
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %s OFFSET %s", c.next(filter.Limit), c.next(filter.Offset))
+	}
+
+	rows, err := r.db.Query(ctx, query, c.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*assetpb.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+func (r *pgxUserRepository) Count(ctx context.Context, filter UserFilter) (int32, error) {
+	c := filter.conditions()
+	var count int32
+	err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM users"+c.where(), c.args...).Scan(&count)
+	return count, err
+}
+
+func (r *pgxUserRepository) Exists(ctx context.Context, nip int32) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE nip = $1 AND status <> 'deleted')", nip).Scan(&exists)
+	return exists, err
+}
+
+// nullableId stores 0 as NULL for the optional area and outlet references.
+func nullableId(id int32) *int32 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func (r *pgxUserRepository) Create(ctx context.Context, user *assetpb.User, passwordHash string) error {
+	_, err := r.db.Exec(ctx, `
+        INSERT INTO users (nip, user_full_name, user_email, user_password, role_id, area_id, outlet_id, must_change_password)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, TRUE)`,
+		user.Nip, user.UserFullName, user.UserEmail, passwordHash, user.RoleId, nullableId(user.AreaId), nullableId(user.OutletId))
+	return err
+}
+
+func (r *pgxUserRepository) Update(ctx context.Context, user *assetpb.User) error {
+	tag, err := r.db.Exec(ctx, `
+        UPDATE users SET user_full_name = $1, user_email = $2, role_id = $3,
+               area_id = COALESCE($4, area_id), outlet_id = COALESCE($5, outlet_id)
+        WHERE nip = $6 AND status <> 'deleted'`,
+		user.UserFullName, user.UserEmail, user.RoleId, nullableId(user.AreaId), nullableId(user.OutletId), user.Nip)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *pgxUserRepository) Delete(ctx context.Context, nip int32) error {
+	return r.inTx(ctx, func(tx pgx.Tx) error {
+		return DeactivateUser(ctx, tx, nip, UserDeleted)
+	})
+}
+
+func (r *pgxUserRepository) Deactivate(ctx context.Context, nip int32) error {
+	return r.inTx(ctx, func(tx pgx.Tx) error {
+		return DeactivateUser(ctx, tx, nip, UserDeactivated)
+	})
+}
+
+// DeactivateUser sets a status other than active and revokes the user's
+// sessions within tx. A deleted user stays deleted.
+func DeactivateUser(ctx context.Context, tx pgx.Tx, nip int32, status string) error {
+	tag, err := tx.Exec(ctx, `
+        UPDATE users SET status = $2, disabled_at = COALESCE(disabled_at, NOW())
+        WHERE nip = $1 AND status <> 'deleted'`, nip, status)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	if _, err := tx.Exec(ctx, "UPDATE sessions SET revoked_at = NOW() WHERE nip = $1 AND revoked_at IS NULL", nip); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, "UPDATE refresh_tokens SET revoked_at = NOW() WHERE nip = $1 AND revoked_at IS NULL", nip); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *pgxUserRepository) Reactivate(ctx context.Context, nip int32) error {
+	tag, err := r.db.Exec(ctx, `
+        UPDATE users SET status = 'active', disabled_at = NULL
+        WHERE nip = $1 AND status = 'deactivated'`, nip)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *pgxUserRepository) Offboard(ctx context.Context, nip, successor int32, closedStatuses []string) (Reassignment, error) {
+	if closedStatuses == nil {
+		// A NULL array would match no submission at all
+		closedStatuses = []string{}
+	}
+	var result Reassignment
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		// Locked so the successor cannot be deactivated meanwhile
+		var found int32
+		err := tx.QueryRow(ctx, "SELECT nip FROM users WHERE nip = $1 AND status = 'active' FOR SHARE", successor).Scan(&found)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidSuccessor
+		}
+		if err != nil {
+			return err
+		}
+
+		// The open submissions, each with a log entry so its history shows the
+		// handover
+		ids, err := collectInt32(tx.Query(ctx, `
+            UPDATE submissions SET nip = $2
+            WHERE nip = $1 AND NOT (submission_status = ANY($3::text[]))
+            RETURNING submission_id`, nip, successor, closedStatuses))
+		if err != nil {
+			return err
+		}
+		result.Submissions = int32(len(ids))
+		_, err = tx.Exec(ctx, `
+            INSERT INTO submission_logs (submission_id, status, description, pr_name)
+            SELECT submission_id, submission_status, $2::text, submission_pr_name
+            FROM submissions WHERE submission_id = ANY($1::int[])`,
+			ids, fmt.Sprintf("Reassigned from user %d to user %d", nip, successor))
+		if err != nil {
+			return err
+		}
+
+		// submission_parents.nip is text
+		tag, err := tx.Exec(ctx, `
+            UPDATE submission_parents SET nip = $2
+            WHERE nip = $1 AND submission_parent_id IN (
+                SELECT submission_parent_id FROM submissions WHERE submission_id = ANY($3::int[]))`,
+			strconv.Itoa(int(nip)), strconv.Itoa(int(successor)), ids)
+		if err != nil {
+			return err
+		}
+		result.SubmissionParents = int32(tag.RowsAffected())
+
+		tag, err = tx.Exec(ctx, `
+            UPDATE notification_rules SET audience_id = $2
+            WHERE audience_type = 'user' AND audience_id = $1`, nip, successor)
+		if err != nil {
+			return err
+		}
+		result.NotificationRules = int32(tag.RowsAffected())
+
+		return DeactivateUser(ctx, tx, nip, UserDeactivated)
+	})
+	return result, err
+}
+
+func (r *pgxUserRepository) inTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *pgxUserRepository) InScope(ctx context.Context, outletId, areaId int32) ([]int32, error) {
+	return collectInt32(r.db.Query(ctx, `
+        SELECT nip FROM users
+        WHERE status = 'active' AND ((role_id = $1 AND outlet_id = $2) OR (role_id = $3 AND area_id = $4))`,
+		RoleOutlet, outletId, RoleArea, areaId))
+}
+
+func (r *pgxUserRepository) WithRole(ctx context.Context, roleId, outletId, areaId int32) ([]int32, error) {
+	query := `SELECT nip FROM users WHERE role_id = $1 AND status = 'active'`
+	args := []interface{}{roleId}
+	switch roleId {
+	case RoleOutlet:
+		query += ` AND outlet_id = $2`
+		args = append(args, outletId)
+	case RoleArea:
+		query += ` AND area_id = $2`
+		args = append(args, areaId)
+	}
+	return collectInt32(r.db.Query(ctx, query, args...))
+}
@@ -0,0 +1,485 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"asset-management-api/app/delivery"
+	"asset-management-api/app/repository"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+// Conditions a rule can watch for. Transition conditions compare an asset
+// before and after a write; state conditions hold for as long as the asset
+// is in that state and are also checked by the periodic sweep.
+const (
+	ConditionStatusChangedTo       = "status_changed_to"
+	ConditionConditionDegraded     = "condition_degraded"
+	ConditionBookValueZero         = "book_value_zero"
+	ConditionQuantityBelowStandard = "quantity_below_standard"
+	ConditionWarrantyExpiring      = "warranty_expiring"
+)
+
+// Audiences a rule can notify.
+const (
+	AudienceRole   = "role"
+	AudienceOutlet = "outlet"
+	AudienceArea   = "area"
+	AudienceUser   = "user"
+)
+
+// DefaultWarrantyDays is used by warranty_expiring rules without a value.
+const DefaultWarrantyDays = 30
+
+// conditionRank orders asset_condition values from best to worst so that
+// condition_degraded can tell a step down. Unknown values never match.
+var conditionRank = map[string]int{
+	"baik":         0,
+	"cukup":        1,
+	"kurang baik":  2,
+	"rusak ringan": 2,
+	"rusak":        3,
+	"rusak berat":  4,
+}
+
+// Rule is a notification rule as stored in notification_rules.
+type Rule struct {
+	Id           int32
+	Name         string
+	Condition    string
+	Value        string
+	AudienceType string
+	AudienceId   int32
+	Channel      string
+	Enabled      bool
+}
+
+// Transition reports whether the condition is evaluated only on writes.
+func (r Rule) Transition() bool {
+	return r.Condition == ConditionStatusChangedTo || r.Condition == ConditionConditionDegraded
+}
+
+// Validate checks a rule before it is stored.
+func (r Rule) Validate() error {
+	switch r.Condition {
+	case ConditionStatusChangedTo:
+		if r.Value == "" {
+			return fmt.Errorf("%s needs a status value", r.Condition)
+		}
+	case ConditionWarrantyExpiring:
+		if r.Value != "" {
+			if days, err := strconv.Atoi(r.Value); err != nil || days <= 0 {
+				return fmt.Errorf("%s value must be a positive number of days", r.Condition)
+			}
+		}
+	case ConditionConditionDegraded, ConditionBookValueZero, ConditionQuantityBelowStandard:
+	default:
+		return fmt.Errorf("unknown condition %q", r.Condition)
+	}
+
+	switch r.AudienceType {
+	case AudienceRole, AudienceUser:
+		if r.AudienceId == 0 {
+			return fmt.Errorf("%s audience needs an ID", r.AudienceType)
+		}
+	case AudienceOutlet, AudienceArea:
+	default:
+		return fmt.Errorf("unknown audience %q", r.AudienceType)
+	}
+
+	switch r.Channel {
+	case "", delivery.ChannelEmail, delivery.ChannelWebhook, delivery.ChannelChat:
+	default:
+		return fmt.Errorf("unknown channel %q", r.Channel)
+	}
+	return nil
+}
+
+// Asset is the part of an asset the rules look at.
+type Asset struct {
+	Id               int32
+	Name             string
+	Status           string
+	Condition        string
+	BookValue        int32
+	Quantity         int32
+	QuantityStandard int32
+	WarrantyDate     *time.Time
+	OutletId         int32
+	AreaId           int32
+}
+
+// Match reports whether the rule applies to the change from before to
+// after. before is nil for a new asset or the periodic sweep.
+func (r Rule) Match(before, after *Asset, now time.Time) (bool, string) {
+	switch r.Condition {
+	case ConditionStatusChangedTo:
+		if before != nil && before.Status != after.Status && strings.EqualFold(after.Status, r.Value) {
+			return true, fmt.Sprintf("Status berubah dari %s menjadi %s", before.Status, after.Status)
+		}
+	case ConditionConditionDegraded:
+		if before == nil {
+			return false, ""
+		}
+		from, okFrom := conditionRank[strings.ToLower(before.Condition)]
+		to, okTo := conditionRank[strings.ToLower(after.Condition)]
+		if okFrom && okTo && to > from {
+			return true, fmt.Sprintf("Kondisi menurun dari %s menjadi %s", before.Condition, after.Condition)
+		}
+	case ConditionBookValueZero:
+		if after.BookValue <= 0 {
+			return true, "Nilai buku telah mencapai 0"
+		}
+	case ConditionQuantityBelowStandard:
+		if after.QuantityStandard > 0 && after.Quantity < after.QuantityStandard {
+			return true, fmt.Sprintf("Jumlah %d di bawah standar %d", after.Quantity, after.QuantityStandard)
+		}
+	case ConditionWarrantyExpiring:
+		if after.WarrantyDate == nil {
+			return false, ""
+		}
+		days := DefaultWarrantyDays
+		if n, err := strconv.Atoi(r.Value); err == nil && n > 0 {
+			days = n
+		}
+		if !after.WarrantyDate.Before(now.Truncate(24*time.Hour)) && after.WarrantyDate.Before(now.AddDate(0, 0, days)) {
+			return true, "Garansi berakhir pada " + after.WarrantyDate.Format("2006-01-02")
+		}
+	}
+	return false, ""
+}
+
+// Engine evaluates the rules in notification_rules. Rules are read from the
+// database on every evaluation, so edits take effect without a deploy.
+type Engine struct {
+	DB         *pgxpool.Pool
+	Dispatcher *delivery.Dispatcher
+}
+
+func NewEngine(db *pgxpool.Pool, dispatcher *delivery.Dispatcher) *Engine {
+	return &Engine{DB: db, Dispatcher: dispatcher}
+}
+
+const assetColumns = `asset_id, asset_name, COALESCE(asset_status, ''), COALESCE(asset_condition, ''),
+        COALESCE(classification_last_book_value, 0), COALESCE(asset_quantity, 0), COALESCE(asset_quantity_standard, 0),
+        asset_warranty_date, COALESCE(outlet_id, 0), COALESCE(area_id, 0)`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAsset(row scanner) (*Asset, error) {
+	var a Asset
+	err := row.Scan(&a.Id, &a.Name, &a.Status, &a.Condition, &a.BookValue, &a.Quantity, &a.QuantityStandard,
+		&a.WarrantyDate, &a.OutletId, &a.AreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// Snapshot loads the current state of an asset for a later Evaluate.
+func (e *Engine) Snapshot(ctx context.Context, assetId int32) (*Asset, error) {
+	return scanAsset(e.DB.QueryRow(ctx, `SELECT `+assetColumns+` FROM assets WHERE asset_id = $1`, assetId))
+}
+
+// Rules returns the stored rules, optionally only the enabled ones.
+func (e *Engine) Rules(ctx context.Context, enabledOnly bool) ([]Rule, error) {
+	query := `SELECT id, name, condition, COALESCE(value, ''), audience_type, COALESCE(audience_id, 0),
+                     COALESCE(channel, ''), enabled
+              FROM notification_rules`
+	if enabledOnly {
+		query += " WHERE enabled"
+	}
+	query += " ORDER BY id"
+
+	rows, err := e.DB.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []Rule
+	for rows.Next() {
+		var r Rule
+		if err := rows.Scan(&r.Id, &r.Name, &r.Condition, &r.Value, &r.AudienceType, &r.AudienceId, &r.Channel, &r.Enabled); err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+	return rules, rows.Err()
+}
+
+// Evaluate runs the rules against one asset write. before is nil for a newly
+// created asset. Errors are logged, so callers can run it in the background.
+func (e *Engine) Evaluate(ctx context.Context, before, after *Asset) {
+	rules, err := e.Rules(ctx, true)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load notification rules")
+		return
+	}
+	now := time.Now()
+	for _, rule := range rules {
+		e.apply(ctx, rule, before, after, now)
+	}
+}
+
+// firingKey identifies a state rule that has fired for an asset.
+type firingKey struct {
+	ruleId  int32
+	assetId int32
+}
+
+// firing is a state rule that newly matches an asset.
+type firing struct {
+	rule   Rule
+	asset  *Asset
+	detail string
+}
+
+// Sweep checks the state conditions for every asset. It catches what writes
+// cannot, such as a warranty date drawing near. The assets are streamed and
+// only the firings that change are kept; they are written in one transaction.
+func (e *Engine) Sweep(ctx context.Context) error {
+	rules, err := e.Rules(ctx, true)
+	if err != nil {
+		return err
+	}
+	var stateRules []Rule
+	var ruleIds []int32
+	for _, rule := range rules {
+		if !rule.Transition() {
+			stateRules = append(stateRules, rule)
+			ruleIds = append(ruleIds, rule.Id)
+		}
+	}
+	if len(stateRules) == 0 {
+		return nil
+	}
+
+	fired := make(map[firingKey]bool)
+	rows, err := e.DB.Query(ctx, `SELECT rule_id, asset_id FROM notification_rule_firings WHERE rule_id = ANY($1)`, ruleIds)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var key firingKey
+		if err := rows.Scan(&key.ruleId, &key.assetId); err != nil {
+			rows.Close()
+			return err
+		}
+		fired[key] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Matches that have fired before are taken out of fired, so what is left
+	// there afterwards has cleared
+	var matches []firing
+	now := time.Now()
+	rows, err = e.DB.Query(ctx, `SELECT `+assetColumns+` FROM assets`)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		a, err := scanAsset(rows)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		for _, rule := range stateRules {
+			matched, detail := rule.Match(nil, a, now)
+			if !matched {
+				continue
+			}
+			key := firingKey{rule.Id, a.Id}
+			if fired[key] {
+				delete(fired, key)
+			} else {
+				matches = append(matches, firing{rule: rule, asset: a, detail: detail})
+			}
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(fired) == 0 && len(matches) == 0 {
+		return nil
+	}
+
+	tx, err := e.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if len(fired) > 0 {
+		var clearedRules, clearedAssets []int32
+		for key := range fired {
+			clearedRules = append(clearedRules, key.ruleId)
+			clearedAssets = append(clearedAssets, key.assetId)
+		}
+		_, err := tx.Exec(ctx, `
+            DELETE FROM notification_rule_firings f
+            USING unnest($1::int[], $2::int[]) AS c (rule_id, asset_id)
+            WHERE f.rule_id = c.rule_id AND f.asset_id = c.asset_id`, clearedRules, clearedAssets)
+		if err != nil {
+			return fmt.Errorf("clear firings: %w", err)
+		}
+	}
+
+	// A write may have recorded the same firing meanwhile; only the rows
+	// inserted here are delivered
+	inserted := make(map[firingKey]bool)
+	if len(matches) > 0 {
+		matchRules := make([]int32, len(matches))
+		matchAssets := make([]int32, len(matches))
+		for i, m := range matches {
+			matchRules[i], matchAssets[i] = m.rule.Id, m.asset.Id
+		}
+		rows, err := tx.Query(ctx, `
+            INSERT INTO notification_rule_firings (rule_id, asset_id)
+            SELECT * FROM unnest($1::int[], $2::int[])
+            ON CONFLICT (rule_id, asset_id) DO NOTHING
+            RETURNING rule_id, asset_id`, matchRules, matchAssets)
+		if err != nil {
+			return fmt.Errorf("record firings: %w", err)
+		}
+		for rows.Next() {
+			var key firingKey
+			if err := rows.Scan(&key.ruleId, &key.assetId); err != nil {
+				rows.Close()
+				return err
+			}
+			inserted[key] = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("record firings: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	for _, m := range matches {
+		if inserted[firingKey{m.rule.Id, m.asset.Id}] {
+			log.Info().Msgf("Notification rule %q matched asset ID %d", m.rule.Name, m.asset.Id)
+			e.deliver(ctx, m.rule, m.asset, m.detail)
+		}
+	}
+	return nil
+}
+
+// apply fires a rule when it matches. State rules fire once per asset until
+// the condition clears, tracked in notification_rule_firings.
+func (e *Engine) apply(ctx context.Context, rule Rule, before, after *Asset, now time.Time) {
+	matched, detail := rule.Match(before, after, now)
+
+	if !rule.Transition() {
+		if !matched {
+			_, err := e.DB.Exec(ctx, `DELETE FROM notification_rule_firings WHERE rule_id = $1 AND asset_id = $2`, rule.Id, after.Id)
+			if err != nil {
+				log.Error().Err(err).Msgf("Failed to clear firing of rule ID %d", rule.Id)
+			}
+			return
+		}
+		tag, err := e.DB.Exec(ctx, `
+            INSERT INTO notification_rule_firings (rule_id, asset_id) VALUES ($1, $2)
+            ON CONFLICT (rule_id, asset_id) DO NOTHING`, rule.Id, after.Id)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed to record firing of rule ID %d", rule.Id)
+			return
+		}
+		if tag.RowsAffected() == 0 {
+			return
+		}
+	} else if !matched {
+		return
+	}
+
+	log.Info().Msgf("Notification rule %q matched asset ID %d", rule.Name, after.Id)
+	e.deliver(ctx, rule, after, detail)
+}
+
+func (e *Engine) deliver(ctx context.Context, rule Rule, asset *Asset, detail string) {
+	if e.Dispatcher == nil {
+		return
+	}
+	nips, err := e.audience(ctx, rule, asset)
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to resolve audience of rule ID %d", rule.Id)
+		return
+	}
+
+	data := map[string]interface{}{
+		"RuleName":  rule.Name,
+		"AssetName": asset.Name,
+		"Detail":    detail,
+	}
+	if rule.Channel == "" {
+		e.Dispatcher.Notify(ctx, delivery.TemplateAssetRule, nips, data)
+	} else {
+		e.Dispatcher.NotifyChannel(ctx, rule.Channel, delivery.TemplateAssetRule, nips, data)
+	}
+}
+
+// audience resolves who hears about a rule. Outlet and area audiences with
+// no ID mean the asset's own outlet or area; role audiences for outlet and
+// area roles are limited to the asset's location.
+func (e *Engine) audience(ctx context.Context, rule Rule, asset *Asset) ([]int32, error) {
+	var query string
+	var args []interface{}
+	switch rule.AudienceType {
+	case AudienceUser:
+		return []int32{rule.AudienceId}, nil
+	case AudienceOutlet:
+		outletId := rule.AudienceId
+		if outletId == 0 {
+			outletId = asset.OutletId
+		}
+		query, args = `SELECT nip FROM users WHERE outlet_id = $1`, []interface{}{outletId}
+	case AudienceArea:
+		areaId := rule.AudienceId
+		if areaId == 0 {
+			areaId = asset.AreaId
+		}
+		query, args = `SELECT nip FROM users WHERE area_id = $1`, []interface{}{areaId}
+	case AudienceRole:
+		query, args = `SELECT nip FROM users WHERE role_id = $1`, []interface{}{rule.AudienceId}
+		switch rule.AudienceId {
+		case repository.RoleOutlet:
+			query += ` AND outlet_id = $2`
+			args = append(args, asset.OutletId)
+		case repository.RoleArea:
+			query += ` AND area_id = $2`
+			args = append(args, asset.AreaId)
+		}
+	default:
+		return nil, fmt.Errorf("unknown audience %q", rule.AudienceType)
+	}
+
+	rows, err := e.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var nips []int32
+	for rows.Next() {
+		var nip int32
+		if err := rows.Scan(&nip); err != nil {
+			return nil, err
+		}
+		nips = append(nips, nip)
+	}
+	return nips, rows.Err()
+}
@@ -0,0 +1,124 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// JobFunc is one run of a periodic job.
+type JobFunc func(ctx context.Context) error
+
+// JobStatus reports the outcome of a job's most recent run.
+type JobStatus struct {
+	Name     string
+	Interval time.Duration
+	LastRun  time.Time
+	LastErr  error
+	Running  bool
+}
+
+type job struct {
+	name     string
+	interval time.Duration
+	fn       JobFunc
+}
+
+// Scheduler runs registered jobs at a fixed interval. Each job runs in its own
+// goroutine and never overlaps with itself.
+type Scheduler struct {
+	mu      sync.Mutex
+	jobs    []job
+	status  map[string]*JobStatus
+	started bool
+}
+
+func New() *Scheduler {
+	return &Scheduler{status: make(map[string]*JobStatus)}
+}
+
+// Every registers fn to run once at start and then every interval.
+func (s *Scheduler) Every(name string, interval time.Duration, fn JobFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs = append(s.jobs, job{name: name, interval: interval, fn: fn})
+	s.status[name] = &JobStatus{Name: name, Interval: interval}
+}
+
+// Run starts every job and blocks until ctx is cancelled and all in-flight
+// runs have returned.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mu.Lock()
+	jobs := append([]job(nil), s.jobs...)
+	s.started = true
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, j := range jobs {
+		wg.Add(1)
+		go func(j job) {
+			defer wg.Done()
+			s.loop(ctx, j)
+		}(j)
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	s.started = false
+	s.mu.Unlock()
+}
+
+func (s *Scheduler) loop(ctx context.Context, j job) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx, j)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, j job) {
+	s.setRunning(j.name, true)
+	err := j.fn(ctx)
+	if err != nil && ctx.Err() == nil {
+		log.Error().Err(err).Msgf("Scheduled job %s failed", j.name)
+	}
+
+	s.mu.Lock()
+	st := s.status[j.name]
+	st.Running = false
+	st.LastRun = time.Now()
+	st.LastErr = err
+	s.mu.Unlock()
+}
+
+func (s *Scheduler) setRunning(name string, running bool) {
+	s.mu.Lock()
+	s.status[name].Running = running
+	s.mu.Unlock()
+}
+
+// Started reports whether Run is active.
+func (s *Scheduler) Started() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.started
+}
+
+// Status returns a snapshot of every registered job.
+func (s *Scheduler) Status() []JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		statuses = append(statuses, *s.status[j.name])
+	}
+	return statuses
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"asset-management-api/app/auth"
+	"asset-management-api/assetpb"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyService manages the keys of the REST server. Admins create keys and
+// manage everyone's; other users can list and revoke their own.
+type APIKeyService struct {
+	Keys *auth.APIKeys
+	assetpb.UnimplementedAPIKEYServiceServer
+}
+
+func NewAPIKeyService(keys *auth.APIKeys) *APIKeyService {
+	return &APIKeyService{Keys: keys}
+}
+
+func (s *APIKeyService) Register(server interface{}) {
+	grpcServer := server.(grpc.ServiceRegistrar)
+	assetpb.RegisterAPIKEYServiceServer(grpcServer, s)
+}
+
+func (s *APIKeyService) ListAPIKeys(ctx context.Context, req *assetpb.ListAPIKeysRequest) (*assetpb.ListAPIKeysResponse, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+	nip := claims.Nip
+	if claims.Admin {
+		nip = req.GetNip()
+	} else if req.GetNip() != 0 && req.GetNip() != claims.Nip {
+		return nil, status.Error(codes.PermissionDenied, "Only administrators can do this")
+	}
+
+	keys, err := s.Keys.List(ctx, nip)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list API keys")
+		return nil, status.Error(codes.Internal, "Failed to list API keys")
+	}
+
+	data := make([]*assetpb.APIKey, 0, len(keys))
+	for _, key := range keys {
+		data = append(data, apiKeyToProto(key))
+	}
+
+	return &assetpb.ListAPIKeysResponse{
+		Message: "Successfully retrieved API keys",
+		Code:    "200",
+		Data:    data,
+		Success: true,
+	}, nil
+}
+
+// CreateAPIKey creates a key and returns its secret, which is shown only this
+// once. Admins only.
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, req *assetpb.CreateAPIKeyRequest) (*assetpb.CreateAPIKeyResponse, error) {
+	claims, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	label := strings.TrimSpace(req.GetLabel())
+	if label == "" || len(label) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "label is required and at most 100 characters")
+	}
+	var expiresAt *time.Time
+	if req.GetExpiresAt() != "" {
+		t, err := time.Parse(time.RFC3339, req.GetExpiresAt())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be an RFC 3339 timestamp")
+		}
+		if !t.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		expiresAt = &t
+	}
+	nip := req.GetNip()
+	if nip == 0 {
+		nip = claims.Nip
+	}
+
+	key, secret, err := s.Keys.Create(ctx, nip, label, req.GetScopes(), expiresAt)
+	switch {
+	case errors.Is(err, auth.ErrInvalidScope):
+		return nil, status.Errorf(codes.InvalidArgument, "scopes must be one or more of %s", strings.Join(auth.Scopes, ", "))
+	case errors.Is(err, auth.ErrInvalidOwner):
+		return nil, status.Errorf(codes.NotFound, "User %d not found or disabled", nip)
+	case err != nil:
+		log.Error().Err(err).Msg("Failed to create API key")
+		return nil, status.Error(codes.Internal, "Failed to create API key")
+	}
+
+	log.Info().Msgf("User %d created API key %d (%s) for user %d", claims.Nip, key.Id, key.Prefix, nip)
+	return &assetpb.CreateAPIKeyResponse{
+		Message: "Successfully created API key",
+		Code:    "200",
+		Success: true,
+		Data:    apiKeyToProto(key),
+		Secret:  secret,
+	}, nil
+}
+
+// RevokeAPIKey ends a key of the caller, or any key for admins.
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, req *assetpb.RevokeAPIKeyRequest) (*assetpb.RevokeAPIKeyResponse, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+	owner := claims.Nip
+	if claims.Admin {
+		owner = 0
+	}
+
+	err := s.Keys.Revoke(ctx, req.GetKeyId(), owner)
+	if errors.Is(err, auth.ErrAPIKeyNotFound) {
+		return nil, status.Error(codes.NotFound, "API key not found")
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to revoke API key %d", req.GetKeyId())
+		return nil, status.Error(codes.Internal, "Failed to revoke API key")
+	}
+
+	log.Info().Msgf("User %d revoked API key %d", claims.Nip, req.GetKeyId())
+	return &assetpb.RevokeAPIKeyResponse{
+		Message: "Successfully revoked API key",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+func apiKeyToProto(key auth.APIKey) *assetpb.APIKey {
+	pb := &assetpb.APIKey{
+		KeyId:     key.Id,
+		Label:     key.Label,
+		Nip:       key.Nip,
+		Scopes:    key.Scopes,
+		Prefix:    key.Prefix,
+		CreatedAt: key.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if key.ExpiresAt != nil {
+		pb.ExpiresAt = key.ExpiresAt.Format("2006-01-02 15:04:05")
+	}
+	if key.LastUsedAt != nil {
+		pb.LastUsedAt = key.LastUsedAt.Format("2006-01-02 15:04:05")
+	}
+	return pb
+}
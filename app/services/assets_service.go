@@ -1,6 +1,10 @@
 package services
 
 import (
+	"asset-management-api/app/auth"
+	"asset-management-api/app/lifecycle"
+	"asset-management-api/app/repository"
+	"asset-management-api/app/rules"
 	"asset-management-api/app/utils"
 	"asset-management-api/assetpb"
 	"context"
@@ -11,10 +15,7 @@ import (
 	"strings"
 	"time"
 
-	"database/sql"
-
 	"github.com/gin-gonic/gin"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
@@ -23,25 +24,178 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// classificationPerkap is the classification of office supplies ("perkap"),
+// which are listed separately from the other assets.
+const classificationPerkap int32 = 9
+
 type AssetService struct {
-	DB *pgxpool.Pool
+	Assets repository.AssetRepository
+	Rules  *rules.Engine
+	// Tasks runs the rule evaluations; needed when Rules is set
+	Tasks *lifecycle.Tasks
 	assetpb.UnimplementedASSETServiceServer
 }
 
-type MstAsset struct {
-	IdAssetNaming    int32
-	AssetNaming      string
-	ClassificationId int32
+func NewAssetService(assets repository.AssetRepository, engine *rules.Engine, tasks *lifecycle.Tasks) *AssetService {
+	return &AssetService{Assets: assets, Rules: engine, Tasks: tasks}
 }
 
-func NewAssetService(db *pgxpool.Pool) *AssetService {
-	return &AssetService{DB: db}
+// snapshot captures an asset for the notification rules; nil when rules are
+// disabled or the asset cannot be read.
+func (s *AssetService) snapshot(ctx context.Context, assetId int32) *rules.Asset {
+	if s.Rules == nil {
+		return nil
+	}
+	asset, err := s.Rules.Snapshot(ctx, assetId)
+	if err != nil {
+		log.Error().Err(err).Int32("asset_id", assetId).Msg("Failed to snapshot asset for notification rules")
+		return nil
+	}
+	return asset
+}
+
+// evaluateRules runs the notification rules for a write in the background.
+func (s *AssetService) evaluateRules(before *rules.Asset, assetId int32) {
+	if s.Rules == nil {
+		return
+	}
+	s.Tasks.Go("notification rules", func(ctx context.Context) {
+		if after := s.snapshot(ctx, assetId); after != nil {
+			s.Rules.Evaluate(ctx, before, after)
+		}
+	})
 }
 
 func (s *AssetService) Register(server interface{}) {
 	grpcServer := server.(grpc.ServiceRegistrar)
 	assetpb.RegisterASSETServiceServer(grpcServer, s)
 }
+
+// depreciation spreads the acquisition value evenly over the classification's
+// economic life (in months) and returns the monthly depreciation and the book
+// value after the given number of months. The book value stops at zero once
+// the economic life is used up.
+func depreciation(acquisitionValue, economicValue int32, months int) (deprecationValue, lastBookValue int32, err error) {
+	if economicValue <= 0 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "Classification economic value must be positive, got %d", economicValue)
+	}
+	deprecationValue = acquisitionValue / economicValue
+	lastBookValue = acquisitionValue - deprecationValue*int32(months)
+	if lastBookValue < 0 {
+		lastBookValue = 0
+	}
+	return deprecationValue, lastBookValue, nil
+}
+
+// nextMaintenanceDate is the 20th of the month one maintenance period from now.
+func nextMaintenanceDate(maintenancePeriodId int32, now time.Time) time.Time {
+	period := utils.ExtractMaintenancePeriod(maintenancePeriodId)
+	date := now.AddDate(0, period, 0)
+	return time.Date(date.Year(), date.Month(), 20, 0, 0, 0, 0, time.Local)
+}
+
+// assetScope limits outlet users to their outlet and area users to their area.
+func assetScope(roleId int32, outletId, areaId *wrapperspb.Int32Value) repository.Scope {
+	switch {
+	case roleId == RoleOutlet && outletId != nil:
+		return repository.Scope{OutletId: outletId.GetValue()}
+	case roleId == RoleArea && areaId != nil:
+		return repository.Scope{AreaId: areaId.GetValue()}
+	}
+	return repository.Scope{}
+}
+
+// apiKeyScope is the scope of an asset listing through key: what its owner
+// sees, narrowed to outletId and areaId when given. It reports false when
+// they lie outside the owner's outlet or area.
+func apiKeyScope(key *auth.APIKey, outletId, areaId int32) (repository.Scope, bool) {
+	var ownerOutletId, ownerAreaId *wrapperspb.Int32Value
+	if key.OutletId != 0 {
+		ownerOutletId = wrapperspb.Int32(key.OutletId)
+	}
+	if key.AreaId != 0 {
+		ownerAreaId = wrapperspb.Int32(key.AreaId)
+	}
+	scope := assetScope(key.RoleId, ownerOutletId, ownerAreaId)
+	if (scope.OutletId != 0 && outletId != 0 && outletId != scope.OutletId) ||
+		(scope.AreaId != 0 && areaId != 0 && areaId != scope.AreaId) {
+		return repository.Scope{}, false
+	}
+	if outletId != 0 {
+		scope.OutletId = outletId
+	}
+	if areaId != 0 {
+		scope.AreaId = areaId
+	}
+	return scope, true
+}
+
+// statusChanges are the columns set when an asset moves to a new status; an
+// asset back in good condition ("Baik") is scheduled for its next maintenance.
+func statusChanges(assetStatus string, maintenancePeriodId int32, now time.Time) repository.AssetChanges {
+	changes := repository.AssetChanges{"asset_status": assetStatus}
+	if assetStatus == "Baik" {
+		changes["asset_maintenance_date"] = nextMaintenanceDate(maintenancePeriodId, now).Format("2006-01-02")
+	}
+	return changes
+}
+
+// DepreciationReport counts the outcome of RecomputeDepreciation.
+type DepreciationReport struct {
+	Checked int `json:"checked"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
+}
+
+// RecomputeDepreciation recalculates the depreciation and book value of
+// every asset as of now, e.g. after a classification's economic life changed.
+// Assets whose classification is missing or has no economic life are skipped.
+func (s *AssetService) RecomputeDepreciation(ctx context.Context, now time.Time) (DepreciationReport, error) {
+	var report DepreciationReport
+	assets, err := s.Assets.List(ctx, repository.AssetFilter{})
+	if err != nil {
+		return report, err
+	}
+
+	classifications := make(map[int32]*assetpb.Classification)
+	for _, asset := range assets {
+		report.Checked++
+
+		classification, ok := classifications[asset.AssetClassification]
+		if !ok {
+			classification, err = s.Assets.Classification(ctx, asset.AssetClassification)
+			if err != nil && !errors.Is(err, repository.ErrNotFound) {
+				return report, err
+			}
+			classifications[asset.AssetClassification] = classification
+		}
+		purchaseDate, err := time.Parse("2006-01-02", asset.AssetPurchaseDate)
+		if classification == nil || err != nil {
+			log.Warn().Msgf("Skipping depreciation of asset ID %d", asset.AssetId)
+			report.Skipped++
+			continue
+		}
+
+		months := utils.CountMonths(purchaseDate, now)
+		deprecationValue, lastBookValue, err := depreciation(asset.ClassificationAcquisitionValue, classification.GetClassificationEconomicValue(), months)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping depreciation of asset ID %d", asset.AssetId)
+			report.Skipped++
+			continue
+		}
+		if deprecationValue == asset.DeprecationValue && lastBookValue == asset.ClassificationLastBookValue {
+			continue
+		}
+		if err := s.Assets.SetDepreciation(ctx, asset.AssetId, deprecationValue, lastBookValue); err != nil {
+			return report, err
+		}
+		report.Updated++
+	}
+
+	log.Info().Msgf("Recomputed depreciation: %d checked, %d updated, %d skipped", report.Checked, report.Updated, report.Skipped)
+	return report, nil
+}
+
 func (s *AssetService) CreateAssets(ctx context.Context, req *assetpb.CreateAssetRequest) (*assetpb.CreateAssetResponse, error) {
 	var createdAssets []string
 	var errorsList []string
@@ -54,8 +208,7 @@ func (s *AssetService) CreateAssets(ctx context.Context, req *assetpb.CreateAsse
 	}
 
 	// Ambil AssetID terakhir dari database
-	var lastAssetId int32
-	err := s.DB.QueryRow(ctx, "SELECT COALESCE(MAX(asset_id), 0) FROM assets").Scan(&lastAssetId)
+	lastAssetId, err := s.Assets.LastId(ctx)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to retrieve last asset ID")
 		return nil, status.Errorf(codes.Internal, "Failed to retrieve last asset ID")
@@ -63,10 +216,7 @@ func (s *AssetService) CreateAssets(ctx context.Context, req *assetpb.CreateAsse
 
 	for _, assetReq := range req.Assets {
 		// Validasi Asset Classification
-		var classificationEconomicValue, maintenancePeriodId int32
-		err := s.DB.QueryRow(ctx, "SELECT classification_economic_value, maintenance_period_id FROM classifications WHERE classification_id = $1",
-			assetReq.GetAssetClassification()).Scan(&classificationEconomicValue, &maintenancePeriodId)
-
+		classification, err := s.Assets.Classification(ctx, assetReq.GetAssetClassification())
 		if err != nil {
 			errorMsg := fmt.Sprintf("Classification not found for asset %s", assetReq.GetAssetName())
 			logger.Warn().Err(err).Str("asset", assetReq.GetAssetName()).Msg(errorMsg)
@@ -84,9 +234,8 @@ func (s *AssetService) CreateAssets(ctx context.Context, req *assetpb.CreateAsse
 		}
 
 		// Validasi position_id
-		var positionId int32
-		err = s.DB.QueryRow(ctx, "SELECT id FROM positions WHERE id = $1", assetReq.GetPositionId()).Scan(&positionId)
-		if err != nil {
+		positionExists, err := s.Assets.PositionExists(ctx, assetReq.GetPositionId())
+		if err != nil || !positionExists {
 			errorMsg := fmt.Sprintf("Position ID %d not found for asset %s", assetReq.GetPositionId(), assetReq.GetAssetName())
 			logger.Warn().Err(err).Str("asset", assetReq.GetAssetName()).Msg(errorMsg)
 			errorsList = append(errorsList, errorMsg)
@@ -95,25 +244,17 @@ func (s *AssetService) CreateAssets(ctx context.Context, req *assetpb.CreateAsse
 
 		// Hitung depresiasi dan nilai buku terakhir
 		months := utils.CountMonths(purchaseDate, time.Now())
-		if classificationEconomicValue == 0 {
-			errorMsg := fmt.Sprintf("Economic value cannot be zero for asset %s", assetReq.GetAssetName())
-			logger.Warn().Str("asset", assetReq.GetAssetName()).Msg(errorMsg)
-			errorsList = append(errorsList, errorMsg)
-			continue
+		deprecationValue, lastBookValue, err := depreciation(assetReq.GetClassificationAcquisitionValue(), classification.GetClassificationEconomicValue(), months)
+		if err != nil {
+			logger.Warn().Err(err).Str("asset", assetReq.GetAssetName()).Msg("Invalid classification economic value")
+			return nil, err
 		}
 
-		deprecationValue := assetReq.GetClassificationAcquisitionValue() / classificationEconomicValue
-		lastBookValue := assetReq.GetClassificationAcquisitionValue() - (deprecationValue * int32(months))
-
 		// Hitung tanggal maintenance
-		period := utils.ExtractMaintenancePeriod(maintenancePeriodId)
-		maintenanceDate := time.Now().AddDate(0, period, 0)
-		maintenanceDate = time.Date(maintenanceDate.Year(), maintenanceDate.Month(), 20, 0, 0, 0, 0, time.Local)
-		maintenanceDateStr := maintenanceDate.Format("2006-01-02")
+		maintenanceDate := nextMaintenanceDate(classification.GetMaintenancePeriodId(), time.Now())
 
 		// Ambil Area ID berdasarkan Outlet ID
-		var areaId int32
-		err = s.DB.QueryRow(ctx, "SELECT area_id FROM area_outlets WHERE outlet_id = $1", assetReq.GetOutletId()).Scan(&areaId)
+		areaId, err := s.Assets.OutletArea(ctx, assetReq.GetOutletId())
 		if err != nil {
 			errorMsg := fmt.Sprintf("Failed to retrieve area_id for outlet %d", assetReq.GetOutletId())
 			logger.Warn().Err(err).Int32("outlet_id", assetReq.GetOutletId()).Msg(errorMsg)
@@ -123,8 +264,7 @@ func (s *AssetService) CreateAssets(ctx context.Context, req *assetpb.CreateAsse
 
 		// Validasi id_asset_naming jika diberikan
 		if assetReq.IdAssetNaming != 0 {
-			var idAssetNamingExists bool
-			err = s.DB.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM mst_assets WHERE id_asset_naming = $1)", assetReq.GetIdAssetNaming()).Scan(&idAssetNamingExists)
+			idAssetNamingExists, err := s.Assets.AssetNamingExists(ctx, assetReq.GetIdAssetNaming())
 			if err != nil || !idAssetNamingExists {
 				errorMsg := fmt.Sprintf("Invalid id_asset_naming for asset %s", assetReq.GetAssetName())
 				logger.Warn().Str("asset", assetReq.GetAssetName()).Msg(errorMsg)
@@ -145,30 +285,31 @@ func (s *AssetService) CreateAssets(ctx context.Context, req *assetpb.CreateAsse
 		}
 
 		// Insert asset ke database
-		query := `
-            INSERT INTO assets (
-                asset_id, asset_id_hash, asset_name, asset_brand, asset_specification, 
-                asset_classification, asset_condition, asset_pic, asset_purchase_date, 
-                asset_maintenance_date, asset_status, classification_acquisition_value, 
-                classification_last_book_value, deprecation_value, outlet_id, area_id, 
-                id_asset_naming, asset_image, asset_quantity, asset_quantity_standard, 
-                personal_responsible, position_id
-            ) VALUES (
-                $1, $2, $3, $4, $5, 
-                $6, $7, $8, $9, $10, 
-                $11, $12, $13, $14, $15, 
-                $16, $17, $18, $19, $20, 
-                $21, $22
-            )`
-		_, err = s.DB.Exec(ctx, query,
-			assetId, string(hash), assetReq.GetAssetName(), assetReq.GetAssetBrand(), assetReq.GetAssetSpecification(),
-			assetReq.GetAssetClassification(), assetReq.GetAssetCondition(), assetReq.GetAssetPic(), assetReq.GetAssetPurchaseDate(),
-			maintenanceDateStr, assetReq.GetAssetStatus(), assetReq.GetClassificationAcquisitionValue(),
-			lastBookValue, deprecationValue, assetReq.GetOutletId(), areaId,
-			assetReq.GetIdAssetNaming(), assetReq.GetAssetImage(), assetReq.GetAssetQuantity(), assetReq.GetAssetQuantityStandard(),
-			assetReq.GetPersonalResponsible(), positionId,
-		)
-
+		err = s.Assets.Create(ctx, &assetpb.Asset{
+			AssetId:                        assetId,
+			AssetIdHash:                    string(hash),
+			AssetName:                      assetReq.GetAssetName(),
+			AssetBrand:                     assetReq.GetAssetBrand(),
+			AssetSpecification:             assetReq.GetAssetSpecification(),
+			AssetClassification:            assetReq.GetAssetClassification(),
+			AssetCondition:                 assetReq.GetAssetCondition(),
+			AssetPic:                       assetReq.GetAssetPic(),
+			AssetPurchaseDate:              assetReq.GetAssetPurchaseDate(),
+			AssetMaintenanceDate:           maintenanceDate.Format("2006-01-02"),
+			AssetStatus:                    assetReq.GetAssetStatus(),
+			ClassificationAcquisitionValue: assetReq.GetClassificationAcquisitionValue(),
+			ClassificationLastBookValue:    lastBookValue,
+			DeprecationValue:               deprecationValue,
+			OutletId:                       assetReq.GetOutletId(),
+			AreaId:                         areaId,
+			IdAssetNaming:                  assetReq.GetIdAssetNaming(),
+			AssetImage:                     assetReq.GetAssetImage(),
+			AssetQuantity:                  assetReq.GetAssetQuantity(),
+			AssetQuantityStandard:          assetReq.GetAssetQuantityStandard(),
+			PersonalResponsible:            assetReq.GetPersonalResponsible(),
+			PositionId:                     assetReq.GetPositionId(),
+			AssetWarrantyDate:              assetReq.GetAssetWarrantyDate(),
+		})
 		if err != nil {
 			errorMsg := fmt.Sprintf("Failed to create asset: %s", assetReq.GetAssetName())
 			logger.Error().Err(err).Str("asset", assetReq.GetAssetName()).Msg(errorMsg)
@@ -178,6 +319,7 @@ func (s *AssetService) CreateAssets(ctx context.Context, req *assetpb.CreateAsse
 
 		lastAssetId++
 		logger.Info().Str("asset", assetReq.GetAssetName()).Msg("Asset created successfully")
+		s.evaluateRules(nil, assetId)
 		createdAssets = append(createdAssets, assetReq.GetAssetName())
 	}
 
@@ -202,105 +344,51 @@ func (s *AssetService) UpdateAsset(ctx context.Context, req *assetpb.UpdateAsset
 	logger.Info().Int32("asset_id", req.GetId()).Msg("Updating asset")
 
 	// Menyimpan field yang akan diupdate
-	fields := []string{}
-	values := []interface{}{}
-	index := 1
-
-	// Menambahkan field yang tersedia ke query
-	if req.GetAssetName() != "" {
-		fields = append(fields, fmt.Sprintf("asset_name = $%d", index))
-		values = append(values, req.GetAssetName())
-		index++
-	}
-	if req.GetAssetBrand() != "" {
-		fields = append(fields, fmt.Sprintf("asset_brand = $%d", index))
-		values = append(values, req.GetAssetBrand())
-		index++
-	}
-	if req.GetAssetSpecification() != "" {
-		fields = append(fields, fmt.Sprintf("asset_specification = $%d", index))
-		values = append(values, req.GetAssetSpecification())
-		index++
-	}
-	if req.GetAssetClassification() != 0 {
-		fields = append(fields, fmt.Sprintf("asset_classification = $%d", index))
-		values = append(values, req.GetAssetClassification())
-		index++
-	}
-	if req.GetAssetCondition() != "" {
-		fields = append(fields, fmt.Sprintf("asset_condition = $%d", index))
-		values = append(values, req.GetAssetCondition())
-		index++
-	}
-	if req.GetAssetPic() != 0 {
-		fields = append(fields, fmt.Sprintf("asset_pic = $%d", index))
-		values = append(values, req.GetAssetPic())
-		index++
-	}
-	if req.GetAssetPurchaseDate() != "" {
-		fields = append(fields, fmt.Sprintf("asset_purchase_date = $%d", index))
-		values = append(values, req.GetAssetPurchaseDate())
-		index++
-	}
-	if req.GetAssetStatus() != "" {
-		fields = append(fields, fmt.Sprintf("asset_status = $%d", index))
-		values = append(values, req.GetAssetStatus())
-		index++
-	}
-	if req.GetClassificationAcquisitionValue() != 0 {
-		fields = append(fields, fmt.Sprintf("classification_acquisition_value = $%d", index))
-		values = append(values, req.GetClassificationAcquisitionValue())
-		index++
-	}
-	if req.GetAssetImage() != "" {
-		fields = append(fields, fmt.Sprintf("asset_image = $%d", index))
-		values = append(values, req.GetAssetImage())
-		index++
-	}
-	if req.GetPersonalResponsible() != "" {
-		fields = append(fields, fmt.Sprintf("personal_responsible = $%d", index))
-		values = append(values, req.GetPersonalResponsible())
-		index++
-	}
-	if req.GetOutletId() != 0 {
-		fields = append(fields, fmt.Sprintf("outlet_id = $%d", index))
-		values = append(values, req.GetOutletId())
-		index++
-	}
-	if req.GetAreaId() != 0 {
-		fields = append(fields, fmt.Sprintf("area_id = $%d", index))
-		values = append(values, req.GetAreaId())
-		index++
+	changes := repository.AssetChanges{}
+	setString := func(column, value string) {
+		if value != "" {
+			changes[column] = value
+		}
 	}
-	if req.GetPositionId() != 0 {
-		fields = append(fields, fmt.Sprintf("position_id = $%d", index))
-		values = append(values, req.GetPositionId())
-		index++
+	setInt := func(column string, value int32) {
+		if value != 0 {
+			changes[column] = value
+		}
 	}
+	setString("asset_name", req.GetAssetName())
+	setString("asset_brand", req.GetAssetBrand())
+	setString("asset_specification", req.GetAssetSpecification())
+	setInt("asset_classification", req.GetAssetClassification())
+	setString("asset_condition", req.GetAssetCondition())
+	setInt("asset_pic", req.GetAssetPic())
+	setString("asset_purchase_date", req.GetAssetPurchaseDate())
+	setString("asset_status", req.GetAssetStatus())
+	setInt("classification_acquisition_value", req.GetClassificationAcquisitionValue())
+	setString("asset_image", req.GetAssetImage())
+	setString("personal_responsible", req.GetPersonalResponsible())
+	setInt("outlet_id", req.GetOutletId())
+	setInt("area_id", req.GetAreaId())
+	setInt("position_id", req.GetPositionId())
+	setString("asset_warranty_date", req.GetAssetWarrantyDate())
 
 	// Jika tidak ada field yang diupdate, hentikan
-	if len(fields) == 0 {
+	if len(changes) == 0 {
 		logger.Warn().Int32("asset_id", req.GetId()).Msg("No fields provided for update")
 		return nil, status.Error(codes.InvalidArgument, "No fields provided for update")
 	}
 
-	// Menyusun query UPDATE
-	query := fmt.Sprintf("UPDATE assets SET %s WHERE asset_id = $%d", strings.Join(fields, ", "), index)
-	values = append(values, req.GetId())
+	before := s.snapshot(ctx, req.GetId())
 
-	// Eksekusi query
-	_, err := s.DB.Exec(ctx, query, values...)
+	err := s.Assets.Update(ctx, req.GetId(), changes)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "Asset not found")
+	}
 	if err != nil {
 		logger.Error().Err(err).Int32("asset_id", req.GetId()).Msg("Failed to update asset")
 		return nil, status.Error(codes.Internal, "Failed to update asset: "+err.Error())
 	}
 
-	// Insert data ke tabel `asset_update`
-	_, err = s.DB.Exec(ctx, "INSERT INTO asset_updates (asset_id, asset_status) VALUES ($1, $2)", req.GetId(), req.GetAssetStatus())
-	if err != nil {
-		logger.Error().Err(err).Int32("asset_id", req.GetId()).Msg("Failed to insert asset update record")
-		return nil, status.Error(codes.Internal, "Failed to insert asset update record: "+err.Error())
-	}
+	s.evaluateRules(before, req.GetId())
 
 	logger.Info().Int32("asset_id", req.GetId()).Msg("Asset successfully updated")
 	return &assetpb.UpdateAssetResponse{
@@ -309,14 +397,15 @@ func (s *AssetService) UpdateAsset(ctx context.Context, req *assetpb.UpdateAsset
 		Success: true,
 	}, nil
 }
+
 func (s *AssetService) UpdateAssetStatus(ctx context.Context, req *assetpb.UpdateAssetStatusRequest) (*assetpb.UpdateAssetStatusResponse, error) {
 	logger := log.With().Str("service", "UpdateAssetStatus").Int32("asset_id", req.GetId()).Logger()
 	logger.Info().Msg("Updating asset status")
 
 	// Get asset by id
-	asset, err := s.GetAsset(ctx, &assetpb.GetAssetRequest{Id: req.GetId()})
+	asset, err := s.Assets.Get(ctx, req.GetId())
 	if err != nil {
-		if status.Code(err) == codes.NotFound {
+		if errors.Is(err, repository.ErrNotFound) {
 			logger.Warn().Msg("Asset not found")
 			return nil, status.Error(codes.NotFound, "Asset not found")
 		}
@@ -325,46 +414,21 @@ func (s *AssetService) UpdateAssetStatus(ctx context.Context, req *assetpb.Updat
 	}
 
 	// Getting data classification
-	classification, err := s.getClassificationById(ctx, asset.Data.AssetClassification)
+	classification, err := s.Assets.Classification(ctx, asset.AssetClassification)
 	if err != nil {
 		logger.Warn().Msg("Classification not found")
 		return nil, status.Error(codes.NotFound, "Classification not found")
 	}
 
-	// Set maintenance date
-	period := utils.ExtractMaintenancePeriod(classification.MaintenancePeriodId)
-	maintenanceDate := time.Now().AddDate(0, period, 0)
-	maintenanceDate = time.Date(maintenanceDate.Year(), maintenanceDate.Month(), 20, 0, 0, 0, 0, time.Local)
-	maintenanceDateStr := maintenanceDate.Format("2006-01-02")
-
-	// Menyimpan field yang akan diupdate
-	fields := []string{"asset_status = $1"}
-	values := []interface{}{req.GetAssetStatus()}
-	index := 2
-
-	if req.GetAssetStatus() == "Baik" {
-		fields = append(fields, fmt.Sprintf("asset_maintenance_date = $%d", index))
-		values = append(values, maintenanceDateStr)
-		index++
-	}
-
-	// Menyusun query UPDATE
-	query := fmt.Sprintf("UPDATE assets SET %s WHERE asset_id = $%d", strings.Join(fields, ", "), index)
-	values = append(values, req.GetId())
+	before := s.snapshot(ctx, req.GetId())
 
-	// Eksekusi query
-	_, err = s.DB.Exec(ctx, query, values...)
+	err = s.Assets.Update(ctx, req.GetId(), statusChanges(req.GetAssetStatus(), classification.GetMaintenancePeriodId(), time.Now()))
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to update asset")
 		return nil, status.Error(codes.Internal, "Failed to update asset: "+err.Error())
 	}
 
-	// Insert data ke tabel `asset_update`
-	_, err = s.DB.Exec(ctx, "INSERT INTO asset_updates (asset_id, asset_status) VALUES ($1, $2)", req.GetId(), req.GetAssetStatus())
-	if err != nil {
-		logger.Error().Err(err).Msg("Failed to insert asset update record")
-		return nil, status.Error(codes.Internal, "Failed to insert asset update record: "+err.Error())
-	}
+	s.evaluateRules(before, req.GetId())
 
 	logger.Info().Str("new_status", req.GetAssetStatus()).Msg("Asset status successfully updated")
 	return &assetpb.UpdateAssetStatusResponse{
@@ -373,15 +437,6 @@ func (s *AssetService) UpdateAssetStatus(ctx context.Context, req *assetpb.Updat
 		Success: true,
 	}, nil
 }
-func (s *AssetService) getClassificationById(ctx context.Context, classificationId int32) (*assetpb.Classification, error) {
-	var classification assetpb.Classification
-	err := s.DB.QueryRow(ctx, "SELECT classification_id, classification_name, maintenance_period_id FROM classifications WHERE classification_id = $1", classificationId).Scan(
-		&classification.ClassificationId, &classification.ClassificationName, &classification.MaintenancePeriodId)
-	if err != nil {
-		return nil, err
-	}
-	return &classification, nil
-}
 
 func (s *AssetService) ListAssetsHandler(c *gin.Context) {
 	logger := log.With().Str("handler", "ListAssetsHandler").Logger()
@@ -418,93 +473,77 @@ func (s *AssetService) ListAssetsHandler(c *gin.Context) {
 		return
 	}
 
-	var outletID *int
+	var outletID int
 	if outletIDParam != "" {
-		outletIDInt, err := strconv.Atoi(outletIDParam)
+		outletID, err = strconv.Atoi(outletIDParam)
 		if err != nil {
 			logger.Warn().Str("outlet_id", outletIDParam).Msg("Invalid outlet ID")
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid outlet ID"})
 			return
 		}
-		outletID = &outletIDInt
 	}
 
-	var areaID *int
+	var areaID int
 	if areaIDParam != "" {
-		areaIDInt, err := strconv.Atoi(areaIDParam)
+		areaID, err = strconv.Atoi(areaIDParam)
 		if err != nil {
 			logger.Warn().Str("area_id", areaIDParam).Msg("Invalid area ID")
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid area ID"})
 			return
 		}
-		areaID = &areaIDInt
 	}
 
-	// Build SQL query dynamically
-	query := `SELECT asset_id, asset_name, asset_brand, asset_classification, asset_status, asset_condition 
-			  FROM assets WHERE 1=1`
-	var args []interface{}
-	argIdx := 1
-
-	// Search query (if provided)
-	if q != "" {
-		query += fmt.Sprintf(" AND (asset_name ILIKE $%d OR asset_brand ILIKE $%d)", argIdx, argIdx+1)
-		args = append(args, "%"+q+"%", "%"+q+"%")
-		argIdx += 2
+	var classificationID int
+	if classificationParam != "" {
+		classificationID, err = strconv.Atoi(classificationParam)
+		if err != nil {
+			logger.Warn().Str("classification", classificationParam).Msg("Invalid classification")
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid classification"})
+			return
+		}
 	}
 
-	// Role-based filtering
-	query += fmt.Sprintf(" AND user_role_id = $%d", argIdx)
-	args = append(args, roleID)
-	argIdx++
-
-	// Optional filters
-	if outletID != nil {
-		query += fmt.Sprintf(" AND outlet_id = $%d", argIdx)
-		args = append(args, *outletID)
-		argIdx++
+	key, ok := auth.APIKeyFromContext(c)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Missing API key"})
+		return
 	}
-
-	if areaID != nil {
-		query += fmt.Sprintf(" AND area_id = $%d", argIdx)
-		args = append(args, *areaID)
-		argIdx++
+	scope, ok := apiKeyScope(key, int32(outletID), int32(areaID))
+	if !ok {
+		logger.Warn().Str("api_key", key.Prefix).Int("outlet_id", outletID).Int("area_id", areaID).Msg("Asset listing outside the key owner's scope")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Outlet or area outside the API key owner's scope"})
+		return
 	}
 
-	if classificationParam != "" {
-		query += fmt.Sprintf(" AND asset_classification = $%d", argIdx)
-		args = append(args, classificationParam)
-		argIdx++
+	filter := repository.AssetFilter{
+		Query:            q,
+		MatchBrand:       true,
+		Scope:            scope,
+		PicRoleId:        int32(roleID),
+		ClassificationId: int32(classificationID),
+		SortById:         true,
+		Offset:           int32((pageNumber - 1) * pageSize),
+		Limit:            int32(pageSize),
 	}
 
-	// Pagination
-	query += fmt.Sprintf(" ORDER BY asset_id LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
-	args = append(args, pageSize, (pageNumber-1)*pageSize)
-
-	logger.Info().
-		Str("query", query).
-		Interface("args", args).
-		Msg("Executing asset list query")
-
-	// Execute query
-	rows, err := s.DB.Query(context.Background(), query, args...)
+	found, err := s.Assets.List(c.Request.Context(), filter)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to fetch assets")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch assets: " + err.Error()})
 		return
 	}
-	defer rows.Close()
 
-	// Parse results
+	// Integrations only get the summary of each asset
 	var assets []*assetpb.Asset
-	for rows.Next() {
-		var asset assetpb.Asset
-		if err := rows.Scan(&asset.AssetId, &asset.AssetName, &asset.AssetBrand, &asset.AssetClassification, &asset.AssetStatus, &asset.AssetCondition); err != nil {
-			logger.Error().Err(err).Msg("Error scanning results")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Error scanning results: " + err.Error()})
-			return
-		}
-		assets = append(assets, &asset)
+	for _, asset := range found {
+		assets = append(assets, &assetpb.Asset{
+			AssetId:             asset.AssetId,
+			AssetName:           asset.AssetName,
+			AssetBrand:          asset.AssetBrand,
+			AssetClassification: asset.AssetClassification,
+			AssetStatus:         asset.AssetStatus,
+			AssetCondition:      asset.AssetCondition,
+		})
 	}
 
 	logger.Info().Int("assets_found", len(assets)).Msg("Successfully fetched asset list")
@@ -514,45 +553,50 @@ func (s *AssetService) ListAssetsHandler(c *gin.Context) {
 		Data: assets,
 	})
 }
+
 func (s *AssetService) ListAssets(ctx context.Context, req *assetpb.ListAssetsRequest) (*assetpb.ListAssetsResponse, error) {
 	logger := log.With().Str("method", "ListAssets").Logger()
 	logger.Info().Msg("Listing assets")
 
 	pageNumber := req.GetPageNumber()
 	pageSize := req.GetPageSize()
-	q := req.GetQ()
-	userRoleID := req.GetUserRoleId()
-	userOutletID := req.GetUserOutletId()
-	userAreaID := req.GetUserAreaId()
-	classification := req.GetClassification()
-
 	offset := (pageNumber - 1) * pageSize
 	limit := pageSize
 
 	logger.Info().
 		Int32("page_number", pageNumber).
 		Int32("page_size", pageSize).
-		Str("query", q).
-		Int32("user_role_id", userRoleID).
-		Int32("user_outlet_id", userOutletID.GetValue()).
-		Int32("user_area_id", userAreaID.GetValue()).
-		Str("classification", classification).
+		Str("query", req.GetQ()).
+		Int32("user_role_id", req.GetUserRoleId()).
+		Int32("user_outlet_id", req.GetUserOutletId().GetValue()).
+		Int32("user_area_id", req.GetUserAreaId().GetValue()).
+		Str("classification", req.GetClassification()).
 		Msg("Fetching assets with filters")
 
-	// Fetch assets
-	assets, err := getAssets(s.DB, int(offset), int(limit), q, userRoleID, userOutletID, userAreaID, classification)
-	if err != nil {
-		logger.Error().Err(err).Msg("Error fetching assets")
-		return nil, err
+	filter := repository.AssetFilter{
+		Query: req.GetQ(),
+		Scope: assetScope(req.GetUserRoleId(), req.GetUserOutletId(), req.GetUserAreaId()),
+	}
+	if req.GetClassification() == "perkap" {
+		filter.ClassificationId = classificationPerkap
+	} else {
+		filter.ExcludeClassificationId = classificationPerkap
 	}
 
 	// Fetch total asset count
-	assetTotal, err := getAssets(s.DB, 0, 0, q, userRoleID, userOutletID, userAreaID, classification)
+	totalCount, err := s.Assets.Count(ctx, filter)
 	if err != nil {
 		logger.Error().Err(err).Msg("Error fetching total count of assets")
 		return nil, err
 	}
-	totalCount := int32(len(assetTotal))
+
+	// Fetch assets
+	filter.Offset, filter.Limit = offset, limit
+	assets, err := s.Assets.List(ctx, filter)
+	if err != nil {
+		logger.Error().Err(err).Msg("Error fetching assets")
+		return nil, err
+	}
 
 	logger.Info().
 		Int("assets_fetched", len(assets)).
@@ -583,73 +627,9 @@ func (s *AssetService) GetAsset(ctx context.Context, req *assetpb.GetAssetReques
 	logger := log.With().Str("method", "GetAsset").Int32("asset_id", req.GetId()).Logger()
 	logger.Info().Msg("Fetching asset by ID")
 
-	var asset assetpb.Asset
-
-	// Raw SQL Query
-	query := `
-        SELECT 
-            assets.asset_id, 
-            assets.asset_id_hash, 
-            assets.asset_name, 
-            assets.asset_brand, 
-            assets.asset_specification, 
-            assets.asset_classification, 
-            assets.asset_status, 
-            assets.asset_condition, 
-            assets.asset_purchase_date, 
-            assets.asset_pic, 
-            assets.asset_image, 
-            assets.personal_responsible, 
-            assets.outlet_id, 
-            assets.area_id, 
-            assets.asset_maintenance_date, 
-            assets.classification_acquisition_value, 
-            assets.classification_last_book_value, 
-            assets.created_at, 
-            assets.updated_at, 
-            assets.deprecation_value, 
-            assets.asset_quantity, 
-            assets.asset_quantity_standard, 
-            assets.id_asset_naming, 
-			assets.position_id,
-			positions.position_name,
-            maintenance_periods.period_name AS maintenance_period_name, 
-            areas.area_name AS area_name, 
-            outlets.outlet_name AS outlet_name, 
-            roles.role_name AS asset_pic_name, 
-            classifications.classification_name AS asset_classification_name, 
-            EXTRACT(MONTH FROM AGE(CURRENT_DATE, assets.asset_purchase_date)) AS asset_age
-        FROM assets
-        LEFT JOIN areas ON assets.area_id = areas.area_id
-        LEFT JOIN outlets ON assets.outlet_id = outlets.outlet_id
-        LEFT JOIN roles ON assets.asset_pic = roles.role_id
-        LEFT JOIN classifications ON assets.asset_classification = classifications.classification_id
-        LEFT JOIN maintenance_periods ON classifications.maintenance_period_id = maintenance_periods.period_id
-		LEFT JOIN positions ON assets.position_id = positions.id
-        WHERE assets.asset_id = $1
-        LIMIT 1;
-    `
-
-	logger.Debug().Str("query", query).Msg("Executing SQL query")
-
-	// Execute Query
-	row := s.DB.QueryRow(ctx, query, req.GetId())
-
-	// Scan result
-	var assetIdHash, maintenancePeriodName, areaName, outletName, assetPicName, assetClassificationName, positionName sql.NullString
-	var assetAge sql.NullInt64
-	var idAssetNaming, positionId sql.NullInt32
-	var assetPurchaseDate, assetMaintenanceDate, createdAt, updatedAt time.Time
-
-	err := row.Scan(
-		&asset.AssetId, &assetIdHash, &asset.AssetName, &asset.AssetBrand, &asset.AssetSpecification, &asset.AssetClassification,
-		&asset.AssetStatus, &asset.AssetCondition, &assetPurchaseDate, &asset.AssetPic, &asset.AssetImage, &asset.PersonalResponsible,
-		&asset.OutletId, &asset.AreaId, &assetMaintenanceDate, &asset.ClassificationAcquisitionValue, &asset.ClassificationLastBookValue,
-		&createdAt, &updatedAt, &asset.DeprecationValue, &asset.AssetQuantity, &asset.AssetQuantityStandard, &idAssetNaming,
-		&positionId, &positionName, &maintenancePeriodName, &areaName, &outletName, &assetPicName, &assetClassificationName, &assetAge,
-	)
+	asset, err := s.Assets.Get(ctx, req.GetId())
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, repository.ErrNotFound) {
 			logger.Warn().Msg("Asset not found")
 			return nil, status.Error(codes.NotFound, "Asset not found")
 		}
@@ -657,256 +637,22 @@ func (s *AssetService) GetAsset(ctx context.Context, req *assetpb.GetAssetReques
 		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to get asset: %v", err))
 	}
 
-	asset.AssetIdHash = assetIdHash.String
-	asset.MaintenancePeriodName = maintenancePeriodName.String
-	asset.AreaName = areaName.String
-	asset.OutletName = outletName.String
-	asset.AssetPicName = assetPicName.String
-	asset.AssetClassificationName = assetClassificationName.String
-	asset.AssetPurchaseDate = assetPurchaseDate.Format("2006-01-02")
-	asset.AssetMaintenanceDate = assetMaintenanceDate.Format("2006-01-02")
-	asset.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
-	asset.UpdatedAt = updatedAt.Format("2006-01-02 15:04:05")
-	if positionId.Valid {
-		asset.PositionId = positionId.Int32
-	}
-	if positionName.Valid {
-		asset.PositionName = positionName.String
-	}
-	if assetAge.Valid {
-		asset.AssetAge = int32(assetAge.Int64)
-	}
-	if idAssetNaming.Valid {
-		asset.IdAssetNaming = idAssetNaming.Int32
-	}
-
 	logger.Info().Msg("Successfully retrieved asset")
 
 	return &assetpb.GetAssetResponse{
-		Data:    &asset,
+		Data:    asset,
 		Code:    "200",
 		Message: "Successfully retrieved asset by ID",
 	}, nil
 }
 
-func getAssets(db *pgxpool.Pool, offset, limit int, q string, userRoleID int32, userOutletID, areaID *wrapperspb.Int32Value, classification string) ([]*assetpb.Asset, error) {
-	logger := log.With().Str("method", "getAssets").Logger()
-	logger.Info().
-		Int("offset", offset).
-		Int("limit", limit).
-		Str("query", q).
-		Int32("user_role_id", userRoleID).
-		Str("classification_filter", classification).
-		Msg("Fetching assets with filters")
-
-	var assets []*assetpb.Asset
-
-	// Base SQL Query
-	query := `
-        SELECT 
-            assets.asset_id, 
-            assets.asset_id_hash, 
-            assets.asset_name, 
-            assets.asset_brand, 
-            assets.asset_specification, 
-            assets.asset_classification, 
-            assets.asset_status, 
-            assets.asset_condition, 
-            assets.asset_purchase_date, 
-            assets.asset_pic, 
-            assets.asset_image, 
-            assets.personal_responsible, 
-            assets.outlet_id, 
-            assets.area_id, 
-            assets.asset_maintenance_date, 
-            assets.classification_acquisition_value, 
-            assets.classification_last_book_value, 
-            assets.created_at, 
-            assets.updated_at, 
-            assets.deprecation_value, 
-            assets.asset_quantity, 
-            assets.asset_quantity_standard, 
-            assets.id_asset_naming, 
-			assets.position_id,
-			positions.position_name,
-            maintenance_periods.period_name AS maintenance_period_name, 
-            areas.area_name AS area_name, 
-            outlets.outlet_name AS outlet_name, 
-            roles.role_name AS asset_pic_name, 
-            classifications.classification_name AS asset_classification_name, 
-            EXTRACT(MONTH FROM AGE(CURRENT_DATE, assets.asset_purchase_date)) AS asset_age
-        FROM assets
-        LEFT JOIN areas ON assets.area_id = areas.area_id
-        LEFT JOIN outlets ON assets.outlet_id = outlets.outlet_id
-        LEFT JOIN roles ON assets.asset_pic = roles.role_id
-        LEFT JOIN classifications ON assets.asset_classification = classifications.classification_id
-        LEFT JOIN maintenance_periods ON classifications.maintenance_period_id = maintenance_periods.period_id
-		LEFT JOIN positions ON assets.position_id = positions.id
-        WHERE 1=1 `
-
-	// Query parameters for prepared statement
-	var args []interface{}
-	argIdx := 1
-
-	// Search by asset name
-	if q != "" {
-		query += fmt.Sprintf(" AND assets.asset_name ILIKE $%d", argIdx)
-		args = append(args, "%"+q+"%")
-		argIdx++
-	}
-
-	// Filtering by role
-	if userRoleID == 6 && userOutletID != nil {
-		query += fmt.Sprintf(" AND assets.outlet_id = $%d", argIdx)
-		args = append(args, userOutletID.GetValue())
-		argIdx++
-	}
-
-	if userRoleID == 5 && areaID != nil {
-		query += fmt.Sprintf(" AND assets.area_id = $%d", argIdx)
-		args = append(args, areaID.GetValue())
-		argIdx++
-	}
-
-	// Filtering by classification
-	if classification == "perkap" {
-		query += " AND assets.asset_classification = 9"
-	} else {
-		query += " AND assets.asset_classification <> 9"
-	}
-
-	// Apply pagination if needed
-	if offset != 0 || limit != 0 {
-		query += fmt.Sprintf(" ORDER BY assets.asset_name ASC LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
-		args = append(args, limit, offset)
-	}
-
-	logger.Info().
-		Str("query", query).
-		Interface("query_params", args).
-		Msg("Executing SQL query")
-
-	// Execute Query
-	rows, err := db.Query(context.Background(), query, args...)
-	if err != nil {
-		logger.Error().Err(err).Msg("Error executing SQL query")
-		return nil, err
-	}
-	defer rows.Close()
-
-	// Parse results
-	for rows.Next() {
-		var asset assetpb.Asset
-		var assetIdHash, maintenancePeriodName, areaName, outletName, assetPicName, assetClassificationName, positionName sql.NullString
-		var assetAge sql.NullInt64
-		var idAssetNaming, positionId sql.NullInt32
-		var assetPurchaseDate, assetMaintenanceDate, createdAt, updatedAt time.Time
-
-		if err := rows.Scan(
-			&asset.AssetId, &assetIdHash, &asset.AssetName, &asset.AssetBrand, &asset.AssetSpecification, &asset.AssetClassification,
-			&asset.AssetStatus, &asset.AssetCondition, &assetPurchaseDate, &asset.AssetPic, &asset.AssetImage, &asset.PersonalResponsible,
-			&asset.OutletId, &asset.AreaId, &assetMaintenanceDate, &asset.ClassificationAcquisitionValue, &asset.ClassificationLastBookValue,
-			&createdAt, &updatedAt, &asset.DeprecationValue, &asset.AssetQuantity, &asset.AssetQuantityStandard, &idAssetNaming, &positionId, &positionName,
-			&maintenancePeriodName, &areaName, &outletName, &assetPicName, &assetClassificationName, &assetAge,
-		); err != nil {
-			logger.Error().Err(err).Msg("Error scanning row")
-			return nil, err
-		}
-
-		asset.AssetIdHash = assetIdHash.String
-		asset.MaintenancePeriodName = maintenancePeriodName.String
-		asset.AreaName = areaName.String
-		asset.OutletName = outletName.String
-		asset.AssetPicName = assetPicName.String
-		asset.AssetClassificationName = assetClassificationName.String
-		asset.AssetPurchaseDate = assetPurchaseDate.Format("2006-01-02")
-		asset.AssetMaintenanceDate = assetMaintenanceDate.Format("2006-01-02")
-		asset.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
-		asset.UpdatedAt = updatedAt.Format("2006-01-02 15:04:05")
-		if assetAge.Valid {
-			asset.AssetAge = int32(assetAge.Int64)
-		}
-		if idAssetNaming.Valid {
-			asset.IdAssetNaming = idAssetNaming.Int32
-		}
-
-		assets = append(assets, &asset)
-	}
-
-	logger.Info().Int("assets_fetched", len(assets)).Msg("Successfully retrieved assets")
-	return assets, nil
-}
 func (s *AssetService) GetAssetByHash(ctx context.Context, req *assetpb.GetAssetByHashRequest) (*assetpb.GetAssetByHashResponse, error) {
 	logger := log.With().Str("method", "GetAssetByHash").Str("hash_id", req.GetHashId()).Logger()
 	logger.Info().Msg("Fetching asset by hash ID")
 
-	var asset assetpb.Asset
-
-	// Raw SQL Query
-	query := `
-        SELECT 
-            assets.asset_id, 
-            assets.asset_id_hash, 
-            assets.asset_name, 
-            assets.asset_brand, 
-            assets.asset_specification, 
-            assets.asset_classification, 
-            assets.asset_status, 
-            assets.asset_condition, 
-            assets.asset_purchase_date, 
-            assets.asset_pic, 
-            assets.asset_image, 
-            assets.personal_responsible, 
-            assets.outlet_id, 
-            assets.area_id, 
-            assets.asset_maintenance_date, 
-            assets.classification_acquisition_value, 
-            assets.classification_last_book_value, 
-            assets.created_at, 
-            assets.updated_at, 
-            assets.deprecation_value, 
-            assets.asset_quantity, 
-            assets.asset_quantity_standard, 
-            assets.id_asset_naming, 
-			assets.position_id,
-			positions.position_name,
-            maintenance_periods.period_name AS maintenance_period_name, 
-            areas.area_name AS area_name, 
-            outlets.outlet_name AS outlet_name, 
-            roles.role_name AS asset_pic_name, 
-            classifications.classification_name AS asset_classification_name, 
-            EXTRACT(MONTH FROM AGE(CURRENT_DATE, assets.asset_purchase_date)) AS asset_age
-        FROM assets
-        LEFT JOIN areas ON assets.area_id = areas.area_id
-        LEFT JOIN outlets ON assets.outlet_id = outlets.outlet_id
-        LEFT JOIN roles ON assets.asset_pic = roles.role_id
-        LEFT JOIN classifications ON assets.asset_classification = classifications.classification_id
-        LEFT JOIN maintenance_periods ON classifications.maintenance_period_id = maintenance_periods.period_id
-		LEFT JOIN positions ON assets.position_id = positions.id
-        WHERE assets.asset_id_hash = $1
-        LIMIT 1;
-    `
-
-	logger.Debug().Str("query", query).Msg("Executing SQL query")
-
-	// Execute Query
-	row := s.DB.QueryRow(ctx, query, req.GetHashId())
-
-	// Scan result
-	var assetIdHash, maintenancePeriodName, areaName, outletName, assetPicName, assetClassificationName, positionName sql.NullString
-	var assetAge sql.NullInt64
-	var idAssetNaming, positionId sql.NullInt32
-	var assetPurchaseDate, assetMaintenanceDate, createdAt, updatedAt time.Time
-
-	err := row.Scan(
-		&asset.AssetId, &assetIdHash, &asset.AssetName, &asset.AssetBrand, &asset.AssetSpecification, &asset.AssetClassification,
-		&asset.AssetStatus, &asset.AssetCondition, &assetPurchaseDate, &asset.AssetPic, &asset.AssetImage, &asset.PersonalResponsible,
-		&asset.OutletId, &asset.AreaId, &assetMaintenanceDate, &asset.ClassificationAcquisitionValue, &asset.ClassificationLastBookValue,
-		&createdAt, &updatedAt, &asset.DeprecationValue, &asset.AssetQuantity, &asset.AssetQuantityStandard, &idAssetNaming, &positionId, &positionName,
-		&maintenancePeriodName, &areaName, &outletName, &assetPicName, &assetClassificationName, &assetAge,
-	)
+	asset, err := s.Assets.GetByHash(ctx, req.GetHashId())
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, repository.ErrNotFound) {
 			logger.Warn().Msg("Asset not found")
 			return nil, status.Error(codes.NotFound, "Asset not found")
 		}
@@ -914,160 +660,15 @@ func (s *AssetService) GetAssetByHash(ctx context.Context, req *assetpb.GetAsset
 		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to get asset: %v", err))
 	}
 
-	asset.AssetIdHash = assetIdHash.String
-	asset.MaintenancePeriodName = maintenancePeriodName.String
-	asset.AreaName = areaName.String
-	asset.OutletName = outletName.String
-	asset.AssetPicName = assetPicName.String
-	asset.AssetClassificationName = assetClassificationName.String
-	asset.AssetPurchaseDate = assetPurchaseDate.Format("2006-01-02")
-	asset.AssetMaintenanceDate = assetMaintenanceDate.Format("2006-01-02")
-	asset.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
-	asset.UpdatedAt = updatedAt.Format("2006-01-02 15:04:05")
-	if positionId.Valid {
-		asset.PositionId = positionId.Int32
-	}
-	if positionName.Valid {
-		asset.PositionName = positionName.String
-	}
-	if assetAge.Valid {
-		asset.AssetAge = int32(assetAge.Int64)
-	}
-	if idAssetNaming.Valid {
-		asset.IdAssetNaming = idAssetNaming.Int32
-	}
-
 	logger.Info().Msg("Successfully retrieved asset by hash ID")
 
 	return &assetpb.GetAssetByHashResponse{
-		Data:    &asset,
+		Data:    asset,
 		Code:    "200",
 		Message: "Successfully retrieved asset by hash ID",
 	}, nil
 }
 
-func GetAssetById(db *sql.DB, id int32) (*assetpb.Asset, error) {
-	logger := log.With().Str("method", "GetAssetById").Int32("asset_id", id).Logger()
-	logger.Info().Msg("Fetching asset by ID")
-
-	var asset assetpb.Asset
-
-	// Raw SQL Query
-	query := `
-		SELECT 
-			assets.*, 
-			maintenance_periods.period_name AS maintenance_period_name, 
-			areas.area_name AS area_name, 
-			outlets.outlet_name AS outlet_name, 
-			roles.role_name AS asset_pic_name, 
-			classifications.classification_name AS asset_classification_name, 
-			EXTRACT(MONTH FROM AGE(CURRENT_DATE, assets.asset_purchase_date)) AS asset_age
-		FROM assets
-		LEFT JOIN areas ON assets.area_id = areas.area_id
-		LEFT JOIN outlets ON assets.outlet_id = outlets.outlet_id
-		LEFT JOIN roles ON assets.asset_pic = roles.role_id
-		LEFT JOIN classifications ON assets.asset_classification = classifications.classification_id
-		LEFT JOIN maintenance_periods ON classifications.maintenance_period_id = maintenance_periods.period_id
-		LEFT JOIN positions ON assets.position_id = positions.id
-		WHERE assets.asset_id = $1
-		LIMIT 1;
-	`
-
-	logger.Debug().Str("query", query).Msg("Executing SQL query")
-
-	// Execute Query
-	row := db.QueryRow(query, id)
-
-	// Scan result
-	var assetConditionStr sql.NullString
-	var maintenancePeriodName, areaName, outletName, assetPicName, assetClassificationName, positionName sql.NullString
-	var assetAge sql.NullInt64
-	var positionId sql.NullInt32
-
-	err := row.Scan(
-		&asset.AssetId, &asset.AssetName, &asset.AssetBrand, &asset.AssetClassification,
-		&asset.AssetStatus, &assetConditionStr, &asset.AssetPurchaseDate, &asset.AssetPic,
-		&asset.AssetImage, &asset.PersonalResponsible, &asset.OutletId, &asset.AreaId,
-		&asset.AssetMaintenanceDate, &asset.ClassificationAcquisitionValue, &positionId, &positionName,
-		&maintenancePeriodName, &areaName, &outletName, &assetPicName, &assetClassificationName, &assetAge,
-	)
-
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			logger.Warn().Msg("Asset not found")
-			return nil, status.Error(codes.NotFound, "Asset not found")
-		}
-		logger.Error().Err(err).Msg("Failed to get asset")
-		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to get asset: %v", err))
-	}
-
-	// Set nilai tambahan ke struct
-	asset.MaintenancePeriodName = maintenancePeriodName.String
-	asset.AreaName = areaName.String
-	asset.OutletName = outletName.String
-	asset.AssetPicName = assetPicName.String
-	asset.AssetClassificationName = assetClassificationName.String
-	asset.AssetAge = int32(assetAge.Int64)
-
-	// Konversi asset_condition jika diperlukan
-	if assetConditionStr.Valid {
-		asset.AssetCondition = assetConditionStr.String
-	}
-
-	logger.Info().Msg("Successfully retrieved asset")
-	return &asset, nil
-}
-
-func GetMstAssets(ctx context.Context, db *sql.DB, offset, limit int32) ([]*MstAsset, error) {
-	logger := log.With().
-		Str("method", "GetMstAssets").
-		Int32("offset", offset).
-		Int32("limit", limit).
-		Logger()
-
-	logger.Info().Msg("Fetching master assets")
-
-	var mstAssets []*MstAsset
-
-	// Raw SQL Query
-	query := `
-		SELECT id_asset_naming, asset_naming, classification_id
-		FROM mst_assets
-		ORDER BY asset_naming ASC
-		OFFSET $1
-		LIMIT $2;
-	`
-
-	logger.Debug().Str("query", query).Msg("Executing SQL query")
-
-	// Execute Query
-	rows, err := db.QueryContext(ctx, query, offset, limit)
-	if err != nil {
-		logger.Error().Err(err).Msg("Error executing query")
-		return nil, err
-	}
-	defer rows.Close()
-
-	// Iterate through rows
-	for rows.Next() {
-		var asset MstAsset
-		if err := rows.Scan(&asset.IdAssetNaming, &asset.AssetNaming, &asset.ClassificationId); err != nil {
-			logger.Error().Err(err).Msg("Error scanning row")
-			return nil, err
-		}
-		mstAssets = append(mstAssets, &asset)
-	}
-
-	if err := rows.Err(); err != nil {
-		logger.Error().Err(err).Msg("Error iterating rows")
-		return nil, err
-	}
-
-	logger.Info().Int("total_assets", len(mstAssets)).Msg("Successfully fetched master assets")
-
-	return mstAssets, nil
-}
-
 func (s *AssetService) ListMstAssets(ctx context.Context, req *assetpb.ListMstAssetsRequest) (*assetpb.ListMstAssetsResponse, error) {
 	logger := log.With().
 		Str("method", "ListMstAssets").
@@ -1077,57 +678,21 @@ func (s *AssetService) ListMstAssets(ctx context.Context, req *assetpb.ListMstAs
 
 	logger.Info().Msg("Fetching master assets")
 
-	// Raw SQL Query
-	query := `
-        SELECT id_asset_naming, asset_naming, classification_id
-        FROM mst_assets
-        ORDER BY asset_naming ASC
-        OFFSET $1
-    `
-	var params []interface{}
-	params = append(params, req.Offset)
-
-	// Add LIMIT clause if limit is provided and not -1
-	if req.Limit > 0 {
-		query += " LIMIT $2"
-		params = append(params, req.Limit)
-	}
-
-	logger.Debug().Str("query", query).Msg("Executing SQL query")
-
-	// Execute Query
-	rows, err := s.DB.Query(ctx, query, params...)
+	mstAssets, err := s.Assets.ListAssetNamings(ctx, req.GetOffset(), req.GetLimit())
 	if err != nil {
-		logger.Error().Err(err).Msg("Error executing query")
-		return nil, err
-	}
-	defer rows.Close()
-
-	var mstAssetProtos []*assetpb.MstAsset
-
-	// Iterate through rows
-	for rows.Next() {
-		var asset assetpb.MstAsset
-		if err := rows.Scan(&asset.IdAssetNaming, &asset.AssetNaming, &asset.ClassificationId); err != nil {
-			logger.Error().Err(err).Msg("Error scanning row")
-			return nil, err
-		}
-		mstAssetProtos = append(mstAssetProtos, &asset)
-	}
-
-	if err := rows.Err(); err != nil {
-		logger.Error().Err(err).Msg("Error iterating rows")
+		logger.Error().Err(err).Msg("Error fetching master assets")
 		return nil, err
 	}
 
 	resp := &assetpb.ListMstAssetsResponse{
-		Data:       mstAssetProtos,
-		TotalCount: int32(len(mstAssetProtos)),
+		Data:       mstAssets,
+		TotalCount: int32(len(mstAssets)),
 	}
 
-	logger.Info().Int("total_assets", len(mstAssetProtos)).Msg("Successfully fetched master assets")
+	logger.Info().Int("total_assets", len(mstAssets)).Msg("Successfully fetched master assets")
 	return resp, nil
 }
+
 func (s *AssetService) ListMstAssetsHandler(c *gin.Context) {
 	logger := log.With().Str("handler", "ListMstAssetsHandler").Logger()
 
@@ -1150,62 +715,11 @@ func (s *AssetService) ListMstAssetsHandler(c *gin.Context) {
 		return
 	}
 
-	logger.Info().Int("offset", offset).Int("limit", limit).Msg("Fetching master assets")
-
-	// Raw SQL Query
-	query := `
-        SELECT id_asset_naming, asset_naming, classification_id
-        FROM mst_assets
-        ORDER BY asset_naming ASC
-        OFFSET $1
-    `
-	var params []interface{}
-	params = append(params, offset)
-
-	// Add LIMIT clause if limit is provided and not -1
-	if limit > 0 {
-		query += " LIMIT $2"
-		params = append(params, limit)
-	}
-
-	logger.Debug().Str("query", query).Msg("Executing SQL query")
-
-	// Eksekusi Query
-	rows, err := s.DB.Query(context.Background(), query, params...)
+	resp, err := s.ListMstAssets(c.Request.Context(), &assetpb.ListMstAssetsRequest{Offset: int32(offset), Limit: int32(limit)})
 	if err != nil {
-		logger.Error().Err(err).Msg("Failed to fetch assets from database")
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch assets"})
 		return
 	}
-	defer rows.Close()
-
-	var mstAssets []*assetpb.MstAsset
-
-	// Iterasi hasil query
-	for rows.Next() {
-		var asset assetpb.MstAsset
-		if err := rows.Scan(&asset.IdAssetNaming, &asset.AssetNaming, &asset.ClassificationId); err != nil {
-			logger.Error().Err(err).Msg("Failed to scan asset row")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to parse assets"})
-			return
-		}
-		mstAssets = append(mstAssets, &asset)
-	}
-
-	if err := rows.Err(); err != nil {
-		logger.Error().Err(err).Msg("Error iterating asset rows")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error iterating assets"})
-		return
-	}
-
-	// Buat response
-	resp := &assetpb.ListMstAssetsResponse{
-		Data:       mstAssets,
-		TotalCount: int32(len(mstAssets)),
-	}
-
-	logger.Info().Int("total_assets", len(mstAssets)).Msg("Successfully fetched master assets")
 
-	// Return response
 	c.JSON(http.StatusOK, resp)
 }
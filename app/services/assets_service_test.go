@@ -0,0 +1,295 @@
+package services
+
+import (
+	"asset-management-api/app/auth"
+	"asset-management-api/app/repository"
+	"asset-management-api/app/repository/repositorytest"
+	"asset-management-api/assetpb"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestDepreciation(t *testing.T) {
+	tests := []struct {
+		name             string
+		acquisitionValue int32
+		economicValue    int32
+		months           int
+		wantDeprecation  int32
+		wantBookValue    int32
+		wantErr          bool
+	}{
+		{"new asset", 1_200_000, 48, 0, 25_000, 1_200_000, false},
+		{"part way", 1_200_000, 48, 12, 25_000, 900_000, false},
+		{"end of life", 1_200_000, 48, 48, 25_000, 0, false},
+		{"past end of life", 1_200_000, 48, 60, 25_000, 0, false},
+		{"rounds down", 1_000, 3, 1, 333, 667, false},
+		{"no value", 0, 12, 5, 0, 0, false},
+		{"no economic life", 1_200_000, 0, 5, 0, 0, true},
+		{"negative economic life", 1_200_000, -12, 5, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deprecation, bookValue, err := depreciation(tt.acquisitionValue, tt.economicValue, tt.months)
+			if tt.wantErr {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Errorf("err = %v, want InvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if deprecation != tt.wantDeprecation || bookValue != tt.wantBookValue {
+				t.Errorf("depreciation(%d, %d, %d) = %d, %d, want %d, %d", tt.acquisitionValue, tt.economicValue, tt.months,
+					deprecation, bookValue, tt.wantDeprecation, tt.wantBookValue)
+			}
+		})
+	}
+}
+
+func TestNextMaintenanceDate(t *testing.T) {
+	now := time.Date(2024, time.March, 5, 14, 30, 0, 0, time.Local)
+	tests := []struct {
+		name     string
+		periodId int32
+		now      time.Time
+		want     string
+	}{
+		{"monthly", 1, now, "2024-04-20"},
+		{"every two months", 2, now, "2024-05-20"},
+		{"quarterly", 3, now, "2024-06-20"},
+		{"every six months", 4, now, "2024-09-20"},
+		{"unknown period is this month", 0, now, "2024-03-20"},
+		{"after the 20th", 1, time.Date(2024, time.March, 25, 0, 0, 0, 0, time.Local), "2024-04-20"},
+		{"into the next year", 4, time.Date(2024, time.October, 15, 0, 0, 0, 0, time.Local), "2025-04-20"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nextMaintenanceDate(tt.periodId, tt.now)
+			if got.Format("2006-01-02") != tt.want || got.Hour() != 0 || got.Minute() != 0 {
+				t.Errorf("nextMaintenanceDate(%d, %s) = %s, want %s", tt.periodId, tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssetScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		roleId   int32
+		outletId *wrapperspb.Int32Value
+		areaId   *wrapperspb.Int32Value
+		want     repository.Scope
+	}{
+		{"outlet user", RoleOutlet, wrapperspb.Int32(7), wrapperspb.Int32(2), repository.Scope{OutletId: 7}},
+		{"area user", RoleArea, wrapperspb.Int32(7), wrapperspb.Int32(2), repository.Scope{AreaId: 2}},
+		{"outlet user without outlet", RoleOutlet, nil, wrapperspb.Int32(2), repository.Scope{}},
+		{"area user without area", RoleArea, wrapperspb.Int32(7), nil, repository.Scope{}},
+		{"other role", 1, wrapperspb.Int32(7), wrapperspb.Int32(2), repository.Scope{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := assetScope(tt.roleId, tt.outletId, tt.areaId); got != tt.want {
+				t.Errorf("assetScope() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIKeyScope(t *testing.T) {
+	outletKey := &auth.APIKey{RoleId: RoleOutlet, AreaId: 2, OutletId: 7}
+	areaKey := &auth.APIKey{RoleId: RoleArea, AreaId: 2, OutletId: 7}
+	adminKey := &auth.APIKey{RoleId: 1}
+	tests := []struct {
+		name     string
+		key      *auth.APIKey
+		outletId int32
+		areaId   int32
+		want     repository.Scope
+		wantOK   bool
+	}{
+		{"outlet owner", outletKey, 0, 0, repository.Scope{OutletId: 7}, true},
+		{"outlet owner, own outlet", outletKey, 7, 0, repository.Scope{OutletId: 7}, true},
+		{"outlet owner, other outlet", outletKey, 8, 0, repository.Scope{}, false},
+		{"outlet owner, narrowed by area", outletKey, 0, 3, repository.Scope{OutletId: 7, AreaId: 3}, true},
+		{"area owner", areaKey, 0, 0, repository.Scope{AreaId: 2}, true},
+		{"area owner, outlet in the area", areaKey, 9, 0, repository.Scope{OutletId: 9, AreaId: 2}, true},
+		{"area owner, other area", areaKey, 0, 3, repository.Scope{}, false},
+		{"other role", adminKey, 0, 0, repository.Scope{}, true},
+		{"other role, narrowed", adminKey, 9, 3, repository.Scope{OutletId: 9, AreaId: 3}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := apiKeyScope(tt.key, tt.outletId, tt.areaId)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("apiKeyScope() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestStatusChanges(t *testing.T) {
+	now := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.Local)
+	tests := []struct {
+		name     string
+		status   string
+		periodId int32
+		want     repository.AssetChanges
+	}{
+		{"back in good condition", "Baik", 3, repository.AssetChanges{"asset_status": "Baik", "asset_maintenance_date": "2024-06-20"}},
+		{"broken", "Rusak", 3, repository.AssetChanges{"asset_status": "Rusak"}},
+		{"in repair", "Perbaikan", 1, repository.AssetChanges{"asset_status": "Perbaikan"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statusChanges(tt.status, tt.periodId, now); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("statusChanges(%q) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecomputeDepreciation(t *testing.T) {
+	assets := repositorytest.NewAssets(
+		&assetpb.Asset{AssetId: 1, AssetClassification: 1, AssetPurchaseDate: "2023-03-10", ClassificationAcquisitionValue: 1_200_000},
+		// Already up to date
+		&assetpb.Asset{AssetId: 2, AssetClassification: 1, AssetPurchaseDate: "2024-01-10", ClassificationAcquisitionValue: 480_000,
+			DeprecationValue: 10_000, ClassificationLastBookValue: 460_000},
+		// No economic life
+		&assetpb.Asset{AssetId: 3, AssetClassification: 2, AssetPurchaseDate: "2023-03-10", ClassificationAcquisitionValue: 100},
+		// Unknown classification
+		&assetpb.Asset{AssetId: 4, AssetClassification: 3, AssetPurchaseDate: "2023-03-10", ClassificationAcquisitionValue: 100},
+		&assetpb.Asset{AssetId: 5, AssetClassification: 1, AssetPurchaseDate: "not a date", ClassificationAcquisitionValue: 100},
+	)
+	assets.Classifications[1] = &assetpb.Classification{ClassificationId: 1, ClassificationEconomicValue: 48}
+	assets.Classifications[2] = &assetpb.Classification{ClassificationId: 2}
+
+	s := NewAssetService(assets, nil, nil)
+	report, err := s.RecomputeDepreciation(context.Background(), time.Date(2024, time.March, 15, 0, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (DepreciationReport{Checked: 5, Updated: 1, Skipped: 3}); report != want {
+		t.Errorf("report = %+v, want %+v", report, want)
+	}
+
+	want := map[int32][2]int32{
+		1: {25_000, 900_000},
+		2: {10_000, 460_000},
+		3: {0, 0},
+		4: {0, 0},
+		5: {0, 0},
+	}
+	for id, values := range want {
+		asset := assets.Items[id]
+		if asset.DeprecationValue != values[0] || asset.ClassificationLastBookValue != values[1] {
+			t.Errorf("asset %d: depreciation %d, book value %d, want %d, %d",
+				id, asset.DeprecationValue, asset.ClassificationLastBookValue, values[0], values[1])
+		}
+	}
+}
+
+func TestCreateAssetsWithoutEconomicLife(t *testing.T) {
+	assets := repositorytest.NewAssets()
+	assets.Classifications[2] = &assetpb.Classification{ClassificationId: 2}
+	assets.Positions[1] = true
+
+	_, err := NewAssetService(assets, nil, nil).CreateAssets(context.Background(), &assetpb.CreateAssetRequest{
+		Assets: []*assetpb.Asset{{AssetName: "Freezer", AssetClassification: 2, AssetPurchaseDate: "10-03-2023", PositionId: 1}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("err = %v, want InvalidArgument", err)
+	}
+	if len(assets.Items) != 0 {
+		t.Errorf("created %d assets", len(assets.Items))
+	}
+}
+
+func TestUpdateAssetStatus(t *testing.T) {
+	tests := []struct {
+		status          string
+		wantMaintenance bool
+	}{
+		{"Baik", true},
+		{"Rusak", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			assets := repositorytest.NewAssets(&assetpb.Asset{
+				AssetId: 1, AssetClassification: 1, AssetStatus: "Perbaikan", AssetMaintenanceDate: "2020-01-20",
+			})
+			assets.Classifications[1] = &assetpb.Classification{ClassificationId: 1, MaintenancePeriodId: 3}
+
+			before := nextMaintenanceDate(3, time.Now()).Format("2006-01-02")
+			_, err := NewAssetService(assets, nil, nil).UpdateAssetStatus(context.Background(),
+				&assetpb.UpdateAssetStatusRequest{Id: 1, AssetStatus: tt.status})
+			if err != nil {
+				t.Fatal(err)
+			}
+			after := nextMaintenanceDate(3, time.Now()).Format("2006-01-02")
+
+			asset := assets.Items[1]
+			if asset.AssetStatus != tt.status {
+				t.Errorf("status = %q, want %q", asset.AssetStatus, tt.status)
+			}
+			rescheduled := asset.AssetMaintenanceDate == before || asset.AssetMaintenanceDate == after
+			if tt.wantMaintenance != rescheduled || !tt.wantMaintenance && asset.AssetMaintenanceDate != "2020-01-20" {
+				t.Errorf("maintenance date = %s", asset.AssetMaintenanceDate)
+			}
+			if want := []repositorytest.AssetUpdate{{AssetId: 1, Status: tt.status}}; !reflect.DeepEqual(assets.Updates, want) {
+				t.Errorf("history = %+v, want %+v", assets.Updates, want)
+			}
+		})
+	}
+}
+
+func TestUpdateAssetStatusNotFound(t *testing.T) {
+	_, err := NewAssetService(repositorytest.NewAssets(), nil, nil).UpdateAssetStatus(context.Background(),
+		&assetpb.UpdateAssetStatusRequest{Id: 1, AssetStatus: "Baik"})
+	if err == nil {
+		t.Fatal("expected an error for a missing asset")
+	}
+}
+
+func TestListAssetsScope(t *testing.T) {
+	assets := repositorytest.NewAssets(
+		&assetpb.Asset{AssetId: 1, AssetName: "Kulkas", OutletId: 7, AreaId: 2, AssetClassification: 1},
+		&assetpb.Asset{AssetId: 2, AssetName: "Freezer", OutletId: 8, AreaId: 2, AssetClassification: 1},
+		&assetpb.Asset{AssetId: 3, AssetName: "AC", OutletId: 9, AreaId: 3, AssetClassification: 1},
+		&assetpb.Asset{AssetId: 4, AssetName: "Kertas", OutletId: 7, AreaId: 2, AssetClassification: classificationPerkap},
+	)
+	tests := []struct {
+		name string
+		req  *assetpb.ListAssetsRequest
+		want []int32
+	}{
+		{"outlet user", &assetpb.ListAssetsRequest{UserRoleId: RoleOutlet, UserOutletId: wrapperspb.Int32(7)}, []int32{1}},
+		{"area user", &assetpb.ListAssetsRequest{UserRoleId: RoleArea, UserAreaId: wrapperspb.Int32(2)}, []int32{2, 1}},
+		{"other role", &assetpb.ListAssetsRequest{UserRoleId: 1, UserOutletId: wrapperspb.Int32(7)}, []int32{3, 2, 1}},
+		{"office supplies", &assetpb.ListAssetsRequest{UserRoleId: RoleOutlet, UserOutletId: wrapperspb.Int32(7), Classification: "perkap"}, []int32{4}},
+		{"search", &assetpb.ListAssetsRequest{UserRoleId: 1, Q: "ul"}, []int32{1}},
+		{"page", &assetpb.ListAssetsRequest{UserRoleId: 1, PageNumber: 2, PageSize: 2}, []int32{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewAssetService(assets, nil, nil).ListAssets(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int32
+			for _, asset := range resp.Data {
+				got = append(got, asset.AssetId)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("assets = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
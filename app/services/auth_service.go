@@ -2,28 +2,31 @@ package services
 
 import (
 	"asset-management-api/app/auth"
-	"asset-management-api/app/utils"
 	"asset-management-api/assetpb"
 	"context"
-	"database/sql"
 	"errors"
-	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type AuthService struct {
-	db *pgxpool.Pool
+	db        *pgxpool.Pool
+	tokens    *auth.TokenIssuer
+	guard     *auth.LoginGuard
+	twoFactor *auth.TwoFactor
+	providers *auth.Providers
+	passwords *auth.Passwords
 	assetpb.UnimplementedAUTHServiceServer
 }
 
-func NewAuthService(db *pgxpool.Pool) *AuthService {
-	return &AuthService{db: db}
+func NewAuthService(db *pgxpool.Pool, tokens *auth.TokenIssuer, guard *auth.LoginGuard, twoFactor *auth.TwoFactor, providers *auth.Providers, passwords *auth.Passwords) *AuthService {
+	return &AuthService{db: db, tokens: tokens, guard: guard, twoFactor: twoFactor, providers: providers, passwords: passwords}
 }
 
 func (s *AuthService) Register(server interface{}) {
@@ -31,138 +34,737 @@ func (s *AuthService) Register(server interface{}) {
 	assetpb.RegisterAUTHServiceServer(grpcServer, s)
 }
 
-func (s *AuthService) tokenStore(tokenString string) error {
-	if tokenString == "" {
-		return errors.New("invalid token: empty string")
+// errLoginFailed is returned for unknown users and wrong passwords alike, so
+// that logins cannot be used to find out which NIPs exist.
+var errLoginFailed = status.Error(codes.Unauthenticated, "Invalid NIP or password")
+
+func (s *AuthService) Login(ctx context.Context, req *assetpb.LoginRequest) (*assetpb.LoginResponse, error) {
+	log.Info().Msgf("Logging in user with NIP: %d", req.GetNip())
+	client := auth.ClientFromContext(ctx)
+
+	if err := s.throttled(ctx, req.GetNip(), client); err != nil {
+		return nil, err
+	}
+
+	identity, err := s.providers.Authenticate(ctx, auth.Credentials{Nip: req.GetNip(), Password: req.GetUserPassword()})
+	switch {
+	case errors.Is(err, auth.ErrUnknownUser):
+		log.Warn().Msg("Login attempt for unknown user")
+		s.failed(ctx, req.GetNip(), client, auth.LoginUnknownUser)
+		return nil, errLoginFailed
+	case errors.Is(err, auth.ErrBadCredentials):
+		log.Warn().Msg("Invalid password attempt")
+		s.failed(ctx, req.GetNip(), client, auth.LoginBadPassword)
+		return nil, errLoginFailed
+	case err != nil:
+		log.Error().Err(err).Msg("Error authenticating user")
+		return nil, status.Error(codes.Internal, "Error retrieving user")
 	}
 
-	expirationTime := time.Now().Add(72 * time.Hour) // Token expires in 3 days
-	query := `INSERT INTO token_stores (token, created_at, exp_token) VALUES ($1, NOW(), $2)`
+	return s.loginAs(ctx, identity, client)
+}
 
-	_, err := s.db.Exec(context.Background(), query, tokenString, expirationTime)
+// BeginOIDCLogin starts a single sign-on login and returns the provider page
+// to send the browser to.
+func (s *AuthService) BeginOIDCLogin(ctx context.Context, req *assetpb.BeginOIDCLoginRequest) (*assetpb.BeginOIDCLoginResponse, error) {
+	if s.providers.OIDC == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Single sign-on is not configured")
+	}
+
+	authorizationURL, err := s.providers.OIDC.AuthorizationURL(ctx)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to store token")
-		return err
+		log.Error().Err(err).Msg("Failed to start single sign-on")
+		return nil, status.Error(codes.Unavailable, "Single sign-on is unavailable")
 	}
 
-	log.Info().Msgf("Token stored successfully with ExpToken: %s", expirationTime)
-	return nil
+	return &assetpb.BeginOIDCLoginResponse{
+		Message:          "Continue at the identity provider",
+		Code:             "200",
+		Success:          true,
+		AuthorizationUrl: authorizationURL,
+	}, nil
 }
 
-func (s *AuthService) deleteToken(tokenString string) error {
-	query := `DELETE FROM token_stores WHERE token = $1`
-	result, err := s.db.Exec(context.Background(), query, tokenString)
+// CompleteOIDCLogin finishes a single sign-on login with the code and state
+// the provider redirected back with.
+func (s *AuthService) CompleteOIDCLogin(ctx context.Context, req *assetpb.CompleteOIDCLoginRequest) (*assetpb.LoginResponse, error) {
+	if s.providers.OIDC == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Single sign-on is not configured")
+	}
+	client := auth.ClientFromContext(ctx)
+
+	identity, err := s.providers.OIDC.Authenticate(ctx, auth.Credentials{Code: req.GetCode(), State: req.GetState()})
+	if errors.Is(err, auth.ErrUnknownUser) || errors.Is(err, auth.ErrBadCredentials) {
+		return nil, status.Error(codes.Unauthenticated, "Single sign-on failed or expired, please try again")
+	}
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to delete token")
-		return err
+		log.Error().Err(err).Msg("Failed to complete single sign-on")
+		return nil, status.Error(codes.Unavailable, "Single sign-on is unavailable")
 	}
 
-	rowsAffected := result.RowsAffected()
-	log.Info().Msgf("Token deleted, affected rows: %d", rowsAffected)
-	return nil
+	return s.loginAs(ctx, identity, client)
 }
 
-func (s *AuthService) GetToken(tokenString string) *string {
-	query := `SELECT token FROM token_stores WHERE token = $1 LIMIT 1`
-	var token string
+// loginAs continues a login once a provider vouched for identity: it finds
+// or creates the local user, turns away disabled users and asks for a
+// second factor where needed.
+func (s *AuthService) loginAs(ctx context.Context, identity *auth.Identity, client auth.Client) (*assetpb.LoginResponse, error) {
+	nip, err := s.providers.Provisioner.Resolve(ctx, identity)
+	if errors.Is(err, auth.ErrUnknownUser) {
+		log.Warn().Msgf("No local user for %s identity %d <%s>", identity.Provider, identity.Nip, identity.Email)
+		s.failed(ctx, identity.Nip, client, auth.LoginUnknownUser)
+		return nil, errLoginFailed
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to provision %s identity %d", identity.Provider, identity.Nip)
+		return nil, status.Error(codes.Internal, "Error retrieving user")
+	}
+
+	var disabled bool
+	err = s.db.QueryRow(ctx, "SELECT disabled_at IS NOT NULL FROM users WHERE nip = $1", nip).Scan(&disabled)
+	if err != nil {
+		log.Error().Err(err).Msg("Error retrieving user")
+		return nil, status.Error(codes.Internal, "Error retrieving user")
+	}
+	// Only told to whoever passed authentication
+	if disabled {
+		log.Warn().Msgf("Login attempt by disabled user %d", nip)
+		s.record(ctx, nip, client, auth.LoginDisabled)
+		return nil, status.Error(codes.PermissionDenied, "User is disabled")
+	}
 
-	err := s.db.QueryRow(context.Background(), query, tokenString).Scan(&token)
+	// Users with two-factor authentication, or whose role requires it, get a
+	// challenge to complete instead of tokens
+	mfa, err := s.twoFactor.Status(ctx, nip)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			log.Warn().Msg("Token not found")
-			return nil
+		log.Error().Err(err).Msg("Error retrieving two-factor status")
+		return nil, status.Error(codes.Internal, "Error retrieving user")
+	}
+	if mfa.Enabled || mfa.Required {
+		challenge, err := s.tokens.IssueChallenge(nip)
+		if err != nil {
+			log.Error().Err(err).Msg("Challenge generation failed")
+			return nil, status.Error(codes.Internal, "Failed to generate token")
 		}
-		log.Error().Err(err).Msg("Error retrieving token")
-		return nil
+		s.record(ctx, nip, client, auth.LoginMFARequired)
+		return &assetpb.LoginResponse{
+			Message:               "Two-factor authentication required",
+			Code:                  "200",
+			Success:               true,
+			MfaRequired:           true,
+			MfaToken:              challenge,
+			MfaEnrollmentRequired: !mfa.Enabled,
+		}, nil
 	}
 
-	return &token
+	return s.completeLogin(ctx, nip, client)
 }
 
-func (s *AuthService) Login(ctx context.Context, req *assetpb.LoginRequest) (*assetpb.LoginResponse, error) {
-	log.Info().Msgf("Logging in user with NIP: %s", req.GetNip())
+// completeLogin starts a session for a user who passed every login step.
+func (s *AuthService) completeLogin(ctx context.Context, nip int32, client auth.Client) (*assetpb.LoginResponse, error) {
+	pair, err := s.tokens.Issue(ctx, nip, client)
+	if errors.Is(err, auth.ErrInvalidToken) {
+		return nil, status.Error(codes.PermissionDenied, "User is disabled")
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Token generation failed")
+		return nil, status.Error(codes.Internal, "Failed to generate token")
+	}
 
-	// Get user by NIP
-	query := `SELECT nip, user_password FROM users WHERE nip = $1 LIMIT 1`
-	var storedNIP, storedPassword string
+	if err := s.guard.Succeeded(ctx, nip); err != nil {
+		log.Error().Err(err).Msg("Failed to clear login failures")
+	}
+	s.record(ctx, nip, client, auth.LoginSucceeded)
+
+	var mustChangePassword bool
+	if err := s.db.QueryRow(ctx, "SELECT must_change_password FROM users WHERE nip = $1", nip).Scan(&mustChangePassword); err != nil {
+		log.Error().Err(err).Msgf("Failed to read password state of user %d", nip)
+	}
+
+	return &assetpb.LoginResponse{
+		Message:            "Successfully logged in",
+		Code:               "200",
+		Token:              pair.AccessToken,
+		RefreshToken:       pair.RefreshToken,
+		ExpiresIn:          int32(pair.ExpiresIn.Seconds()),
+		Success:            true,
+		MustChangePassword: mustChangePassword,
+	}, nil
+}
 
-	err := s.db.QueryRow(context.Background(), query, req.GetNip()).Scan(&storedNIP, &storedPassword)
+// throttled checks whether logins for nip from client are on hold, and
+// returns the error to give if so.
+func (s *AuthService) throttled(ctx context.Context, nip int32, client auth.Client) error {
+	wait, err := s.guard.Check(ctx, nip, client)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			log.Warn().Msg("User not found")
-			return nil, status.Errorf(http.StatusNotFound, "User not found")
-		}
-		log.Error().Err(err).Msg("Error retrieving user")
-		return nil, status.Errorf(http.StatusInternalServerError, "Error retrieving user")
+		log.Error().Err(err).Msg("Error checking login attempts")
+		return status.Error(codes.Internal, "Error retrieving user")
+	}
+	if wait > 0 {
+		log.Warn().Msgf("Login for user %d from %s throttled for %s", nip, client.IP, wait)
+		s.record(ctx, nip, client, auth.LoginThrottled)
+		return status.Errorf(codes.ResourceExhausted, "Too many login attempts, try again in %s", wait.Round(time.Second))
+	}
+	return nil
+}
+
+// failed counts a failed login and records it.
+func (s *AuthService) failed(ctx context.Context, nip int32, client auth.Client, reason string) {
+	if err := s.guard.Failed(ctx, nip, client); err != nil {
+		log.Error().Err(err).Msg("Failed to count login failure")
 	}
+	s.record(ctx, nip, client, reason)
+}
+
+func (s *AuthService) record(ctx context.Context, nip int32, client auth.Client, reason string) {
+	attempt := auth.LoginAttempt{Nip: nip, Success: reason == auth.LoginSucceeded, Reason: reason, Client: client}
+	if err := s.guard.Record(ctx, attempt); err != nil {
+		log.Error().Err(err).Msg("Failed to record login attempt")
+	}
+}
 
-	// Verify password
-	err = utils.VerifyPassword(storedPassword, req.GetUserPassword())
+// RefreshToken exchanges a refresh token for a new access and refresh token.
+// It is called without an access token, since the old one has usually expired.
+func (s *AuthService) RefreshToken(ctx context.Context, req *assetpb.RefreshTokenRequest) (*assetpb.RefreshTokenResponse, error) {
+	pair, err := s.tokens.Refresh(ctx, req.GetRefreshToken(), auth.ClientFromContext(ctx))
+	if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, auth.ErrTokenReused) {
+		log.Warn().Err(err).Msg("Refresh rejected")
+		return nil, status.Error(codes.Unauthenticated, "Invalid refresh token")
+	}
 	if err != nil {
-		log.Warn().Msg("Invalid password attempt")
-		return nil, status.Errorf(http.StatusBadRequest, "Invalid password")
+		log.Error().Err(err).Msg("Failed to refresh token")
+		return nil, status.Error(codes.Internal, "Failed to refresh token")
 	}
 
-	// Generate token
-	nipInt, err := strconv.Atoi(storedNIP)
+	return &assetpb.RefreshTokenResponse{
+		Message:      "Successfully refreshed token",
+		Code:         "200",
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresIn:    int32(pair.ExpiresIn.Seconds()),
+		Success:      true,
+	}, nil
+}
+
+// Logout ends a session by revoking its whole refresh token family. The
+// session is taken from the refresh token, then the access token in the
+// request, then the caller's own token.
+func (s *AuthService) Logout(ctx context.Context, req *assetpb.LogoutRequest) (*assetpb.LogoutResponse, error) {
+	var sessionId string
+	var err error
+	switch {
+	case req.GetRefreshToken() != "":
+		sessionId, err = s.tokens.SessionOf(ctx, req.GetRefreshToken())
+	case req.GetToken() != "":
+		var claims *auth.Claims
+		if claims, err = s.tokens.Verify(req.GetToken()); err == nil {
+			sessionId = claims.SessionId
+		}
+	default:
+		if claims, ok := auth.ClaimsFromContext(ctx); ok {
+			sessionId = claims.SessionId
+		}
+	}
+	if err == nil && sessionId != "" {
+		err = s.tokens.RevokeSession(ctx, sessionId)
+	}
+	if err != nil || sessionId == "" {
+		log.Warn().Err(err).Msg("Failed to logout")
+		return &assetpb.LogoutResponse{
+			Message: "Failed to logout",
+			Code:    "400",
+			Success: false,
+		}, nil
+	}
+
+	log.Info().Msgf("Session %s logged out", sessionId)
+	return &assetpb.LogoutResponse{
+		Message: "Successfully logged out",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+// ListSessions returns the caller's active sessions.
+func (s *AuthService) ListSessions(ctx context.Context, req *assetpb.ListSessionsRequest) (*assetpb.ListSessionsResponse, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+
+	sessions, err := s.tokens.Sessions(ctx, claims.Nip)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to convert NIP to int")
-		return nil, status.Errorf(http.StatusInternalServerError, "Failed to convert NIP to int")
+		log.Error().Err(err).Msgf("Failed to list sessions of user %d", claims.Nip)
+		return nil, status.Error(codes.Internal, "Failed to list sessions")
+	}
+
+	data := make([]*assetpb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		data = append(data, &assetpb.Session{
+			SessionId:  session.Id,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IP,
+			CreatedAt:  session.CreatedAt.Format("2006-01-02 15:04:05"),
+			LastSeenAt: session.LastSeenAt.Format("2006-01-02 15:04:05"),
+			ExpiresAt:  session.ExpiresAt.Format("2006-01-02 15:04:05"),
+			Current:    session.Id == claims.SessionId,
+		})
 	}
-	token := auth.GenerateJWTToken(int32(nipInt))
 
-	if token == nil {
-		log.Error().Msg("Token generation failed")
-		return nil, status.Errorf(http.StatusInternalServerError, "Failed to generate token")
+	return &assetpb.ListSessionsResponse{
+		Message: "Successfully retrieved sessions",
+		Code:    "200",
+		Data:    data,
+		Success: true,
+	}, nil
+}
+
+// RevokeSession ends one of the caller's own sessions, e.g. a device they
+// no longer use.
+func (s *AuthService) RevokeSession(ctx context.Context, req *assetpb.RevokeSessionRequest) (*assetpb.RevokeSessionResponse, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
 	}
 
-	// Save token to database
-	err = s.tokenStore(*token)
+	err := s.tokens.RevokeUserSession(ctx, claims.Nip, req.GetSessionId())
+	if errors.Is(err, auth.ErrSessionNotFound) {
+		return nil, status.Error(codes.NotFound, "Session not found")
+	}
 	if err != nil {
-		return nil, status.Errorf(http.StatusInternalServerError, "Failed to save token")
+		log.Error().Err(err).Msgf("Failed to revoke session %s", req.GetSessionId())
+		return nil, status.Error(codes.Internal, "Failed to revoke session")
 	}
 
-	return &assetpb.LoginResponse{
-		Message: "Successfully logged in",
+	log.Info().Msgf("User %d revoked session %s", claims.Nip, req.GetSessionId())
+	return &assetpb.RevokeSessionResponse{
+		Message: "Successfully revoked session",
 		Code:    "200",
-		Token:   *token,
 		Success: true,
 	}, nil
 }
 
-func (s *AuthService) Logout(ctx context.Context, req *assetpb.LogoutRequest) (*assetpb.LogoutResponse, error) {
-	log.Info().Msgf("Logging out user with token: %s", req.Token)
+// RevokeAllSessions ends the caller's sessions, optionally except the one
+// the request was made from.
+func (s *AuthService) RevokeAllSessions(ctx context.Context, req *assetpb.RevokeAllSessionsRequest) (*assetpb.RevokeAllSessionsResponse, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
 
-	// Delete token
-	err := s.deleteToken(req.Token)
+	var keep string
+	if req.GetKeepCurrent() {
+		keep = claims.SessionId
+	}
+	revoked, err := s.tokens.RevokeUser(ctx, claims.Nip, keep)
 	if err != nil {
-		return &assetpb.LogoutResponse{
-			Message: "Failed to logout",
+		log.Error().Err(err).Msgf("Failed to revoke sessions of user %d", claims.Nip)
+		return nil, status.Error(codes.Internal, "Failed to revoke sessions")
+	}
+
+	log.Info().Msgf("User %d revoked %d sessions", claims.Nip, revoked)
+	return &assetpb.RevokeAllSessionsResponse{
+		Message: "Successfully revoked sessions",
+		Code:    "200",
+		Success: true,
+		Revoked: int32(revoked),
+	}, nil
+}
+
+// ChangePassword replaces the caller's password after checking the current
+// one. The caller's other sessions end, and the response carries a new
+// access token that is no longer limited to changing the password. Wrong
+// current passwords count as failed logins.
+func (s *AuthService) ChangePassword(ctx context.Context, req *assetpb.ChangePasswordRequest) (*assetpb.ChangePasswordResponse, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+	client := auth.ClientFromContext(ctx)
+	if err := s.throttled(ctx, claims.Nip, client); err != nil {
+		return nil, err
+	}
+
+	err := s.passwords.Change(ctx, claims.Nip, claims.SessionId, req.GetCurrentPassword(), req.GetNewPassword())
+	switch {
+	case errors.Is(err, auth.ErrBadCredentials):
+		s.failed(ctx, claims.Nip, client, auth.LoginBadPassword)
+		return nil, status.Error(codes.InvalidArgument, "Current password is incorrect")
+	case errors.Is(err, auth.ErrPasswordPolicy):
+		return &assetpb.ChangePasswordResponse{
+			Message: err.Error(),
 			Code:    "400",
 			Success: false,
 		}, nil
+	case errors.Is(err, auth.ErrUnknownUser):
+		return nil, status.Error(codes.PermissionDenied, "User is disabled")
+	case err != nil:
+		log.Error().Err(err).Msgf("Failed to change password of user %d", claims.Nip)
+		return nil, status.Error(codes.Internal, "Failed to change password")
 	}
+	log.Info().Msgf("User %d changed their password", claims.Nip)
 
-	return &assetpb.LogoutResponse{
-		Message: "Successfully logged out",
+	token, err := s.tokens.Reissue(ctx, claims.Nip, claims.SessionId)
+	if err != nil {
+		// The password is changed; a refresh gets the new token as well
+		log.Error().Err(err).Msgf("Failed to reissue token of user %d", claims.Nip)
+		return &assetpb.ChangePasswordResponse{
+			Message: "Successfully changed password",
+			Code:    "200",
+			Success: true,
+		}, nil
+	}
+
+	return &assetpb.ChangePasswordResponse{
+		Message:   "Successfully changed password",
+		Code:      "200",
+		Success:   true,
+		Token:     token,
+		ExpiresIn: int32(s.tokens.AccessTTL.Seconds()),
+	}, nil
+}
+
+// ForceLogout ends every session of another user. Admins only.
+func (s *AuthService) ForceLogout(ctx context.Context, req *assetpb.ForceLogoutRequest) (*assetpb.ForceLogoutResponse, error) {
+	claims, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.tokens.RevokeUser(ctx, req.GetNip(), "")
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to revoke sessions of user %d", req.GetNip())
+		return nil, status.Error(codes.Internal, "Failed to logout user")
+	}
+
+	log.Info().Msgf("User %d forced logout of user %d, %d sessions revoked", claims.Nip, req.GetNip(), revoked)
+	return &assetpb.ForceLogoutResponse{
+		Message: "Successfully logged out user",
 		Code:    "200",
 		Success: true,
+		Revoked: int32(revoked),
 	}, nil
 }
 
-func (s *AuthService) deleteExpiredTokens() {
-	currentTime := time.Now()
-	log.Info().Msgf("Running deleteExpiredTokens at: %s", currentTime)
+// errInvalidCode is returned for wrong, reused and unknown second factor codes.
+var errInvalidCode = status.Error(codes.Unauthenticated, "Invalid verification code")
 
-	// Delete expired tokens
-	query := `DELETE FROM token_stores WHERE exp_token < $1`
-	result, err := s.db.Exec(context.Background(), query, currentTime)
+// VerifyMFA completes a login that needs a second factor, with a code from
+// the user's authenticator app or one of their recovery codes.
+func (s *AuthService) VerifyMFA(ctx context.Context, req *assetpb.VerifyMFARequest) (*assetpb.LoginResponse, error) {
+	client := auth.ClientFromContext(ctx)
+	nip, err := s.tokens.VerifyChallenge(req.GetMfaToken())
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to delete expired tokens")
-		return
+		return nil, status.Error(codes.Unauthenticated, "Invalid or expired MFA token")
+	}
+	if err := s.throttled(ctx, nip, client); err != nil {
+		return nil, err
 	}
 
-	rowsAffected := result.RowsAffected()
+	err = s.twoFactor.Verify(ctx, nip, req.GetCode())
+	if errors.Is(err, auth.ErrInvalidCode) {
+		log.Warn().Msgf("Invalid second factor for user %d", nip)
+		s.failed(ctx, nip, client, auth.LoginBadMFACode)
+		return nil, errInvalidCode
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to verify second factor")
+		return nil, status.Error(codes.Internal, "Failed to verify code")
+	}
+
+	return s.completeLogin(ctx, nip, client)
+}
+
+// mfaSubject returns whose two-factor setup an enrollment call is for: the
+// holder of the challenge token of a login in progress, or else the caller.
+func (s *AuthService) mfaSubject(ctx context.Context, mfaToken string) (nip int32, loggingIn bool, err error) {
+	if mfaToken != "" {
+		nip, err := s.tokens.VerifyChallenge(mfaToken)
+		if err != nil {
+			return 0, false, status.Error(codes.Unauthenticated, "Invalid or expired MFA token")
+		}
+		return nip, true, nil
+	}
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return 0, false, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+	return claims.Nip, false, nil
+}
+
+// GetTwoFactorStatus tells the caller whether they use two-factor
+// authentication and whether their role requires it.
+func (s *AuthService) GetTwoFactorStatus(ctx context.Context, req *assetpb.GetTwoFactorStatusRequest) (*assetpb.GetTwoFactorStatusResponse, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+
+	mfa, err := s.twoFactor.Status(ctx, claims.Nip)
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to get two-factor status of user %d", claims.Nip)
+		return nil, status.Error(codes.Internal, "Failed to get two-factor status")
+	}
+
+	return &assetpb.GetTwoFactorStatusResponse{
+		Message:           "Successfully retrieved two-factor status",
+		Code:              "200",
+		Success:           true,
+		Enabled:           mfa.Enabled,
+		Required:          mfa.Required,
+		RecoveryCodesLeft: mfa.RecoveryCodesLeft,
+	}, nil
+}
+
+// EnrollTOTP starts setting up an authenticator app. The returned secret only
+// takes effect once ConfirmTOTP receives a code generated from it.
+func (s *AuthService) EnrollTOTP(ctx context.Context, req *assetpb.EnrollTOTPRequest) (*assetpb.EnrollTOTPResponse, error) {
+	nip, _, err := s.mfaSubject(ctx, req.GetMfaToken())
+	if err != nil {
+		return nil, err
+	}
+
+	secret, uri, err := s.twoFactor.Begin(ctx, nip, strconv.Itoa(int(nip)))
+	if errors.Is(err, auth.ErrTOTPEnabled) {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is already enabled")
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to start two-factor enrollment of user %d", nip)
+		return nil, status.Error(codes.Internal, "Failed to start enrollment")
+	}
+
+	return &assetpb.EnrollTOTPResponse{
+		Message:    "Scan the code with an authenticator app and confirm with a generated code",
+		Code:       "200",
+		Success:    true,
+		Secret:     secret,
+		OtpauthUri: uri,
+	}, nil
+}
+
+// ConfirmTOTP enables two-factor authentication and returns the recovery
+// codes. During a login that required enrollment, it also completes the
+// login.
+func (s *AuthService) ConfirmTOTP(ctx context.Context, req *assetpb.ConfirmTOTPRequest) (*assetpb.ConfirmTOTPResponse, error) {
+	nip, loggingIn, err := s.mfaSubject(ctx, req.GetMfaToken())
+	if err != nil {
+		return nil, err
+	}
+	client := auth.ClientFromContext(ctx)
+	if loggingIn {
+		if err := s.throttled(ctx, nip, client); err != nil {
+			return nil, err
+		}
+	}
+
+	recoveryCodes, err := s.twoFactor.Confirm(ctx, nip, req.GetCode())
+	switch {
+	case errors.Is(err, auth.ErrTOTPNotPending):
+		return nil, status.Error(codes.FailedPrecondition, "No two-factor enrollment in progress")
+	case errors.Is(err, auth.ErrInvalidCode):
+		if loggingIn {
+			s.failed(ctx, nip, client, auth.LoginBadMFACode)
+		}
+		return nil, errInvalidCode
+	case err != nil:
+		log.Error().Err(err).Msgf("Failed to confirm two-factor enrollment of user %d", nip)
+		return nil, status.Error(codes.Internal, "Failed to confirm enrollment")
+	}
+	log.Info().Msgf("Two-factor authentication enabled for user %d", nip)
+
+	resp := &assetpb.ConfirmTOTPResponse{
+		Message:       "Two-factor authentication enabled",
+		Code:          "200",
+		Success:       true,
+		RecoveryCodes: recoveryCodes,
+	}
+	if loggingIn {
+		login, err := s.completeLogin(ctx, nip, client)
+		if err != nil {
+			return nil, err
+		}
+		resp.Token = login.GetToken()
+		resp.RefreshToken = login.GetRefreshToken()
+		resp.ExpiresIn = login.GetExpiresIn()
+	}
+	return resp, nil
+}
+
+// verifyCaller checks a second factor code of the caller before a change to
+// their two-factor setup.
+func (s *AuthService) verifyCaller(ctx context.Context, code string) (*auth.Claims, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+	client := auth.ClientFromContext(ctx)
+	if err := s.throttled(ctx, claims.Nip, client); err != nil {
+		return nil, err
+	}
+
+	err := s.twoFactor.Verify(ctx, claims.Nip, code)
+	if errors.Is(err, auth.ErrInvalidCode) {
+		s.failed(ctx, claims.Nip, client, auth.LoginBadMFACode)
+		return nil, errInvalidCode
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to verify second factor")
+		return nil, status.Error(codes.Internal, "Failed to verify code")
+	}
+	return claims, nil
+}
+
+// DisableTOTP turns two-factor authentication off, unless the caller's role
+// requires it.
+func (s *AuthService) DisableTOTP(ctx context.Context, req *assetpb.DisableTOTPRequest) (*assetpb.DisableTOTPResponse, error) {
+	claims, err := s.verifyCaller(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	mfa, err := s.twoFactor.Status(ctx, claims.Nip)
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to get two-factor status of user %d", claims.Nip)
+		return nil, status.Error(codes.Internal, "Failed to disable two-factor authentication")
+	}
+	if mfa.Required {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is required for your role")
+	}
+
+	if err := s.twoFactor.Disable(ctx, claims.Nip); err != nil {
+		log.Error().Err(err).Msgf("Failed to disable two-factor authentication of user %d", claims.Nip)
+		return nil, status.Error(codes.Internal, "Failed to disable two-factor authentication")
+	}
+
+	log.Info().Msgf("Two-factor authentication disabled for user %d", claims.Nip)
+	return &assetpb.DisableTOTPResponse{
+		Message: "Two-factor authentication disabled",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+// RegenerateRecoveryCodes replaces the caller's recovery codes, e.g. once
+// most are used up.
+func (s *AuthService) RegenerateRecoveryCodes(ctx context.Context, req *assetpb.RegenerateRecoveryCodesRequest) (*assetpb.RegenerateRecoveryCodesResponse, error) {
+	claims, err := s.verifyCaller(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := s.twoFactor.RegenerateRecoveryCodes(ctx, claims.Nip)
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to regenerate recovery codes of user %d", claims.Nip)
+		return nil, status.Error(codes.Internal, "Failed to regenerate recovery codes")
+	}
+
+	return &assetpb.RegenerateRecoveryCodesResponse{
+		Message:       "Successfully regenerated recovery codes",
+		Code:          "200",
+		Success:       true,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// ResetTwoFactor removes another user's two-factor setup, e.g. after they
+// lost their phone and recovery codes. If their role requires two-factor
+// authentication they enroll again at their next login. Admins only.
+func (s *AuthService) ResetTwoFactor(ctx context.Context, req *assetpb.ResetTwoFactorRequest) (*assetpb.ResetTwoFactorResponse, error) {
+	claims, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.twoFactor.Disable(ctx, req.GetNip()); err != nil {
+		log.Error().Err(err).Msgf("Failed to reset two-factor authentication of user %d", req.GetNip())
+		return nil, status.Error(codes.Internal, "Failed to reset two-factor authentication")
+	}
+
+	log.Info().Msgf("User %d reset two-factor authentication of user %d", claims.Nip, req.GetNip())
+	return &assetpb.ResetTwoFactorResponse{
+		Message: "Successfully reset two-factor authentication",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+// UnlockAccount clears the failed logins of an account, and optionally of a
+// client address, lifting any lockout. Admins only.
+func (s *AuthService) UnlockAccount(ctx context.Context, req *assetpb.UnlockAccountRequest) (*assetpb.UnlockAccountResponse, error) {
+	claims, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cleared, err := s.guard.Unlock(ctx, req.GetNip(), req.GetIpAddress())
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to unlock user %d", req.GetNip())
+		return nil, status.Error(codes.Internal, "Failed to unlock account")
+	}
+
+	message := "Account was not locked"
+	if cleared {
+		message = "Successfully unlocked account"
+		log.Info().Msgf("User %d unlocked user %d", claims.Nip, req.GetNip())
+	}
+	return &assetpb.UnlockAccountResponse{
+		Message: message,
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+// ListLoginAttempts returns the login audit log, newest first. Admins only.
+func (s *AuthService) ListLoginAttempts(ctx context.Context, req *assetpb.ListLoginAttemptsRequest) (*assetpb.ListLoginAttemptsResponse, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := req.GetLimit()
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	attempts, err := s.guard.Attempts(ctx, req.GetNip(), limit)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list login attempts")
+		return nil, status.Error(codes.Internal, "Failed to list login attempts")
+	}
+
+	data := make([]*assetpb.LoginAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		data = append(data, &assetpb.LoginAttempt{
+			Nip:       attempt.Nip,
+			Success:   attempt.Success,
+			Reason:    attempt.Reason,
+			IpAddress: attempt.Client.IP,
+			UserAgent: attempt.Client.UserAgent,
+			CreatedAt: attempt.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &assetpb.ListLoginAttemptsResponse{
+		Message: "Successfully retrieved login attempts",
+		Code:    "200",
+		Data:    data,
+		Success: true,
+	}, nil
+}
+
+// PurgeExpiredSessions deletes the sessions that have expired or been
+// revoked and returns how many were removed.
+func (s *AuthService) PurgeExpiredSessions(ctx context.Context) (int64, error) {
+	purged, err := s.tokens.PurgeExpired(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to delete expired sessions")
+		return 0, err
+	}
+
+	log.Info().Msgf("Deleted expired sessions, affected rows: %d", purged)
+	return purged, nil
+}
 
-	log.Info().Msgf("Deleted expired tokens, affected rows: %d", rowsAffected)
+// CleanupSessions is the scheduler job form of PurgeExpiredSessions.
+func (s *AuthService) CleanupSessions(ctx context.Context) error {
+	_, err := s.PurgeExpiredSessions(ctx)
+	return err
 }
@@ -0,0 +1,195 @@
+package services
+
+import (
+	"asset-management-api/app/auth"
+	"asset-management-api/app/calendar"
+	"asset-management-api/assetpb"
+	"context"
+	"database/sql"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CalendarService manages the tokenized iCalendar feeds served by
+// calendar.Handler. BaseURL is the public gateway address used in feed URLs.
+type CalendarService struct {
+	DB      *pgxpool.Pool
+	BaseURL string
+	assetpb.UnimplementedCALENDARServiceServer
+}
+
+func NewCalendarService(db *pgxpool.Pool, baseURL string) *CalendarService {
+	return &CalendarService{DB: db, BaseURL: baseURL}
+}
+
+func (s *CalendarService) Register(server interface{}) {
+	grpcServer := server.(grpc.ServiceRegistrar)
+	assetpb.RegisterCALENDARServiceServer(grpcServer, s)
+}
+
+// CreateCalendarFeed creates a feed for the caller. Without an outlet the feed
+// follows the caller's own scope; outlet and area users may only subscribe to
+// outlets they are responsible for.
+func (s *CalendarService) CreateCalendarFeed(ctx context.Context, req *assetpb.CreateCalendarFeedRequest) (*assetpb.CreateCalendarFeedResponse, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+	log.Info().Msgf("Creating calendar feed for NIP %d, outlet ID %d", claims.Nip, req.GetOutletId())
+
+	var outletId *int32
+	if req.GetOutletId() != 0 {
+		if err := s.checkOutletAccess(ctx, claims, req.GetOutletId()); err != nil {
+			return nil, err
+		}
+		id := req.GetOutletId()
+		outletId = &id
+	}
+
+	token, hash, err := calendar.NewToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to create calendar feed")
+	}
+
+	var feed assetpb.CalendarFeed
+	var createdAt sql.NullTime
+	err = s.DB.QueryRow(ctx, `
+        INSERT INTO calendar_feeds (nip, outlet_id, token_hash) VALUES ($1, $2, $3)
+        RETURNING id, created_at`, claims.Nip, outletId, hash).Scan(&feed.Id, &createdAt)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create calendar feed")
+		return nil, status.Error(codes.Internal, "Failed to create calendar feed")
+	}
+	feed.OutletId = req.GetOutletId()
+	feed.CreatedAt = createdAt.Time.Format("2006-01-02 15:04:05")
+
+	return &assetpb.CreateCalendarFeedResponse{
+		Data:    &feed,
+		Url:     calendar.FeedURL(s.BaseURL, token),
+		Message: "Successfully created calendar feed",
+		Code:    "200",
+	}, nil
+}
+
+// ListCalendarFeeds lists the caller's active feeds. Tokens are stored hashed,
+// so URLs are only shown when a feed is created or rotated.
+func (s *CalendarService) ListCalendarFeeds(ctx context.Context, req *assetpb.ListCalendarFeedsRequest) (*assetpb.ListCalendarFeedsResponse, error) {
+	nip := callerNip(ctx)
+	log.Info().Msgf("Listing calendar feeds for NIP %d", nip)
+
+	rows, err := s.DB.Query(ctx, `
+        SELECT f.id, COALESCE(f.outlet_id, 0), COALESCE(o.outlet_name, ''), f.created_at, f.last_fetched_at
+        FROM calendar_feeds f
+        LEFT JOIN outlets o ON o.outlet_id = f.outlet_id
+        WHERE f.nip = $1 AND f.revoked_at IS NULL
+        ORDER BY f.id`, nip)
+	if err != nil {
+		log.Error().Err(err).Msg("Error fetching calendar feeds")
+		return nil, status.Error(codes.Internal, "Failed to list calendar feeds")
+	}
+	defer rows.Close()
+
+	var feeds []*assetpb.CalendarFeed
+	for rows.Next() {
+		var feed assetpb.CalendarFeed
+		var createdAt, lastFetchedAt sql.NullTime
+		if err := rows.Scan(&feed.Id, &feed.OutletId, &feed.OutletName, &createdAt, &lastFetchedAt); err != nil {
+			log.Error().Err(err).Msg("Error scanning calendar feed")
+			return nil, status.Error(codes.Internal, "Failed to list calendar feeds")
+		}
+		feed.CreatedAt = createdAt.Time.Format("2006-01-02 15:04:05")
+		if lastFetchedAt.Valid {
+			feed.LastFetchedAt = lastFetchedAt.Time.Format("2006-01-02 15:04:05")
+		}
+		feeds = append(feeds, &feed)
+	}
+
+	return &assetpb.ListCalendarFeedsResponse{
+		Data:    feeds,
+		Message: "Successfully fetched calendar feeds",
+		Code:    "200",
+	}, nil
+}
+
+// RotateCalendarFeed replaces the feed's token; the old URL stops working at once.
+func (s *CalendarService) RotateCalendarFeed(ctx context.Context, req *assetpb.RotateCalendarFeedRequest) (*assetpb.RotateCalendarFeedResponse, error) {
+	nip := callerNip(ctx)
+	log.Info().Msgf("Rotating calendar feed ID %d for NIP %d", req.GetId(), nip)
+
+	token, hash, err := calendar.NewToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to rotate calendar feed")
+	}
+
+	tag, err := s.DB.Exec(ctx, `
+        UPDATE calendar_feeds SET token_hash = $1, last_fetched_at = NULL
+        WHERE id = $2 AND nip = $3 AND revoked_at IS NULL`, hash, req.GetId(), nip)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to rotate calendar feed")
+		return nil, status.Error(codes.Internal, "Failed to rotate calendar feed")
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "Calendar feed not found")
+	}
+
+	return &assetpb.RotateCalendarFeedResponse{
+		Url:     calendar.FeedURL(s.BaseURL, token),
+		Message: "Successfully rotated calendar feed",
+		Code:    "200",
+	}, nil
+}
+
+func (s *CalendarService) RevokeCalendarFeed(ctx context.Context, req *assetpb.RevokeCalendarFeedRequest) (*assetpb.RevokeCalendarFeedResponse, error) {
+	nip := callerNip(ctx)
+	log.Info().Msgf("Revoking calendar feed ID %d for NIP %d", req.GetId(), nip)
+
+	tag, err := s.DB.Exec(ctx, `
+        UPDATE calendar_feeds SET revoked_at = NOW()
+        WHERE id = $1 AND nip = $2 AND revoked_at IS NULL`, req.GetId(), nip)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to revoke calendar feed")
+		return nil, status.Error(codes.Internal, "Failed to revoke calendar feed")
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "Calendar feed not found")
+	}
+
+	return &assetpb.RevokeCalendarFeedResponse{
+		Message: "Successfully revoked calendar feed",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+func (s *CalendarService) checkOutletAccess(ctx context.Context, claims *auth.Claims, outletId int32) error {
+	var exists bool
+	err := s.DB.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM outlets WHERE outlet_id = $1)`, outletId).Scan(&exists)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check outlet")
+		return status.Error(codes.Internal, "Failed to create calendar feed")
+	}
+	if !exists {
+		return status.Error(codes.NotFound, "Outlet not found")
+	}
+
+	switch claims.RoleId {
+	case RoleOutlet:
+		if outletId != claims.OutletId {
+			return status.Error(codes.PermissionDenied, "You can only subscribe to your own outlet")
+		}
+	case RoleArea:
+		var inArea bool
+		err := s.DB.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM area_outlets WHERE outlet_id = $1 AND area_id = $2)`, outletId, claims.AreaId).Scan(&inArea)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to create calendar feed")
+		}
+		if !inArea {
+			return status.Error(codes.PermissionDenied, "You can only subscribe to outlets in your area")
+		}
+	}
+	return nil
+}
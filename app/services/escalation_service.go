@@ -0,0 +1,340 @@
+package services
+
+import (
+	"asset-management-api/app/delivery"
+	"asset-management-api/app/repository"
+	"asset-management-api/assetpb"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EscalationService manages escalation rules for late maintenance and runs
+// the periodic job that applies them to late notifications.
+type EscalationService struct {
+	DB         *pgxpool.Pool
+	Users      repository.UserRepository
+	Dispatcher *delivery.Dispatcher
+	assetpb.UnimplementedESCALATIONServiceServer
+}
+
+func NewEscalationService(db *pgxpool.Pool, users repository.UserRepository, dispatcher *delivery.Dispatcher) *EscalationService {
+	return &EscalationService{DB: db, Users: users, Dispatcher: dispatcher}
+}
+
+func (s *EscalationService) Register(server interface{}) {
+	grpcServer := server.(grpc.ServiceRegistrar)
+	assetpb.RegisterESCALATIONServiceServer(grpcServer, s)
+}
+
+func (s *EscalationService) ListEscalationRules(ctx context.Context, req *assetpb.ListEscalationRulesRequest) (*assetpb.ListEscalationRulesResponse, error) {
+	log.Info().Msg("Fetching escalation rules")
+
+	rules, err := s.rules(ctx, false)
+	if err != nil {
+		log.Error().Err(err).Msg("Error fetching escalation rules")
+		return nil, status.Error(codes.Internal, "Failed to get escalation rules")
+	}
+
+	return &assetpb.ListEscalationRulesResponse{
+		Data:    rules,
+		Message: "Successfully fetched escalation rules",
+		Code:    "200",
+	}, nil
+}
+
+func (s *EscalationService) CreateEscalationRule(ctx context.Context, req *assetpb.CreateEscalationRuleRequest) (*assetpb.CreateEscalationRuleResponse, error) {
+	rule := req.GetRule()
+	if err := validateEscalationRule(rule); err != nil {
+		return nil, err
+	}
+	log.Info().Msgf("Creating escalation rule %s", rule.GetName())
+
+	err := s.DB.QueryRow(ctx, `
+        INSERT INTO escalation_rules (name, level, min_days_late, target_role_id, classification_id,
+                                      min_acquisition_value, max_acquisition_value, enabled)
+        VALUES ($1, $2, $3, $4, NULLIF($5, 0), NULLIF($6, 0), NULLIF($7, 0), $8)
+        RETURNING id`,
+		rule.GetName(), rule.GetLevel(), rule.GetMinDaysLate(), rule.GetTargetRoleId(), rule.GetClassificationId(),
+		rule.GetMinAcquisitionValue(), rule.GetMaxAcquisitionValue(), rule.GetEnabled()).Scan(&rule.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create escalation rule")
+		return nil, status.Error(codes.Internal, "Failed to create escalation rule")
+	}
+
+	return &assetpb.CreateEscalationRuleResponse{
+		Data:    rule,
+		Message: "Successfully created escalation rule",
+		Code:    "200",
+	}, nil
+}
+
+func (s *EscalationService) UpdateEscalationRule(ctx context.Context, req *assetpb.UpdateEscalationRuleRequest) (*assetpb.UpdateEscalationRuleResponse, error) {
+	rule := req.GetRule()
+	if err := validateEscalationRule(rule); err != nil {
+		return nil, err
+	}
+	log.Info().Msgf("Updating escalation rule ID %d", req.GetId())
+
+	tag, err := s.DB.Exec(ctx, `
+        UPDATE escalation_rules SET name = $1, level = $2, min_days_late = $3, target_role_id = $4,
+               classification_id = NULLIF($5, 0), min_acquisition_value = NULLIF($6, 0),
+               max_acquisition_value = NULLIF($7, 0), enabled = $8
+        WHERE id = $9`,
+		rule.GetName(), rule.GetLevel(), rule.GetMinDaysLate(), rule.GetTargetRoleId(), rule.GetClassificationId(),
+		rule.GetMinAcquisitionValue(), rule.GetMaxAcquisitionValue(), rule.GetEnabled(), req.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update escalation rule")
+		return nil, status.Error(codes.Internal, "Failed to update escalation rule")
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "Escalation rule not found")
+	}
+
+	return &assetpb.UpdateEscalationRuleResponse{
+		Message: "Successfully updated escalation rule",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+func (s *EscalationService) DeleteEscalationRule(ctx context.Context, req *assetpb.DeleteEscalationRuleRequest) (*assetpb.DeleteEscalationRuleResponse, error) {
+	log.Info().Msgf("Deleting escalation rule ID %d", req.GetId())
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to delete escalation rule")
+	}
+	defer tx.Rollback(ctx)
+
+	// Past escalations stay on the notifications; only the audit rows go
+	if _, err := tx.Exec(ctx, `DELETE FROM notification_escalations WHERE rule_id = $1`, req.GetId()); err != nil {
+		log.Error().Err(err).Msg("Failed to delete escalation history")
+		return nil, status.Error(codes.Internal, "Failed to delete escalation rule")
+	}
+	tag, err := tx.Exec(ctx, `DELETE FROM escalation_rules WHERE id = $1`, req.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to delete escalation rule")
+		return nil, status.Error(codes.Internal, "Failed to delete escalation rule")
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "Escalation rule not found")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to delete escalation rule")
+	}
+
+	return &assetpb.DeleteEscalationRuleResponse{
+		Message: "Successfully deleted escalation rule",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+func validateEscalationRule(rule *assetpb.EscalationRule) error {
+	switch {
+	case rule == nil:
+		return status.Error(codes.InvalidArgument, "Rule is required")
+	case rule.GetName() == "":
+		return status.Error(codes.InvalidArgument, "Name is required")
+	case rule.GetLevel() <= 0:
+		return status.Error(codes.InvalidArgument, "Level must be at least 1")
+	case rule.GetMinDaysLate() < 0:
+		return status.Error(codes.InvalidArgument, "Minimum days late cannot be negative")
+	case rule.GetTargetRoleId() == 0:
+		return status.Error(codes.InvalidArgument, "Target role is required")
+	case rule.GetMaxAcquisitionValue() != 0 && rule.GetMaxAcquisitionValue() < rule.GetMinAcquisitionValue():
+		return status.Error(codes.InvalidArgument, "Maximum acquisition value must not be below the minimum")
+	}
+	return nil
+}
+
+func (s *EscalationService) rules(ctx context.Context, enabledOnly bool) ([]*assetpb.EscalationRule, error) {
+	query := `
+        SELECT id, name, level, min_days_late, target_role_id, COALESCE(classification_id, 0),
+               COALESCE(min_acquisition_value, 0), COALESCE(max_acquisition_value, 0), enabled
+        FROM escalation_rules`
+	if enabledOnly {
+		query += " WHERE enabled"
+	}
+	query += " ORDER BY level, min_days_late, id"
+
+	rows, err := s.DB.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*assetpb.EscalationRule
+	for rows.Next() {
+		var rule assetpb.EscalationRule
+		if err := rows.Scan(&rule.Id, &rule.Name, &rule.Level, &rule.MinDaysLate, &rule.TargetRoleId, &rule.ClassificationId,
+			&rule.MinAcquisitionValue, &rule.MaxAcquisitionValue, &rule.Enabled); err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rule)
+	}
+	return rules, rows.Err()
+}
+
+type lateNotification struct {
+	id               int32
+	assetName        string
+	outletId         int32
+	areaId           int32
+	maintenanceDate  time.Time
+	level            int32
+	daysLate         int32
+	classificationId int32
+	acquisitionValue int32
+	fired            map[int32]bool
+}
+
+// matches reports whether a rule applies to the notification, ignoring
+// whether it already fired.
+func (n *lateNotification) matches(rule *assetpb.EscalationRule) bool {
+	if n.daysLate < rule.GetMinDaysLate() {
+		return false
+	}
+	if rule.GetClassificationId() != 0 && rule.GetClassificationId() != n.classificationId {
+		return false
+	}
+	if rule.GetMinAcquisitionValue() != 0 && n.acquisitionValue < rule.GetMinAcquisitionValue() {
+		return false
+	}
+	if rule.GetMaxAcquisitionValue() != 0 && n.acquisitionValue > rule.GetMaxAcquisitionValue() {
+		return false
+	}
+	return true
+}
+
+// EscalateLateNotifications applies every enabled rule to the late
+// notifications. Each rule fires at most once per notification; the
+// notification keeps the highest level reached.
+func (s *EscalationService) EscalateLateNotifications(ctx context.Context) error {
+	rules, err := s.rules(ctx, true)
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+
+	notifications, err := s.lateNotifications(ctx)
+	if err != nil {
+		return err
+	}
+
+	escalated := 0
+	for _, n := range notifications {
+		level := n.level
+		var fired []*assetpb.EscalationRule
+		for _, rule := range rules {
+			if n.fired[rule.GetId()] || !n.matches(rule) {
+				continue
+			}
+			fired = append(fired, rule)
+			if rule.GetLevel() > level {
+				level = rule.GetLevel()
+			}
+		}
+		if len(fired) == 0 {
+			continue
+		}
+
+		if err := s.recordEscalation(ctx, n, level, fired); err != nil {
+			log.Error().Err(err).Msgf("Failed to escalate notification ID %d", n.id)
+			continue
+		}
+		for _, rule := range fired {
+			s.deliverEscalation(ctx, n, rule)
+		}
+		escalated++
+	}
+
+	if escalated > 0 {
+		log.Info().Msgf("Escalated %d late notifications", escalated)
+	}
+	return nil
+}
+
+func (s *EscalationService) lateNotifications(ctx context.Context) ([]*lateNotification, error) {
+	rows, err := s.DB.Query(ctx, `
+        SELECT n.id_notification, n.asset_name, n.outlet_id, n.area_id, n.maintenance_or_submitted,
+               n.escalation_level, CURRENT_DATE - n.maintenance_or_submitted,
+               COALESCE(a.asset_classification, 0), COALESCE(a.classification_acquisition_value, 0),
+               COALESCE(array_agg(e.rule_id) FILTER (WHERE e.rule_id IS NOT NULL), '{}')
+        FROM notifications n
+        LEFT JOIN assets a ON a.asset_id = n.asset_id
+        LEFT JOIN notification_escalations e ON e.id_notification = n.id_notification
+        WHERE n.status = 'late'
+        GROUP BY n.id_notification, a.asset_classification, a.classification_acquisition_value`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*lateNotification
+	for rows.Next() {
+		n := &lateNotification{fired: make(map[int32]bool)}
+		var firedRules []int32
+		if err := rows.Scan(&n.id, &n.assetName, &n.outletId, &n.areaId, &n.maintenanceDate, &n.level, &n.daysLate,
+			&n.classificationId, &n.acquisitionValue, &firedRules); err != nil {
+			return nil, err
+		}
+		for _, id := range firedRules {
+			n.fired[id] = true
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, rows.Err()
+}
+
+func (s *EscalationService) recordEscalation(ctx context.Context, n *lateNotification, level int32, fired []*assetpb.EscalationRule) error {
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, rule := range fired {
+		_, err := tx.Exec(ctx, `
+            INSERT INTO notification_escalations (id_notification, rule_id, level, days_late, target_role_id)
+            VALUES ($1, $2, $3, $4, $5)`, n.id, rule.GetId(), rule.GetLevel(), n.daysLate, rule.GetTargetRoleId())
+		if err != nil {
+			return err
+		}
+	}
+
+	if level > n.level {
+		_, err := tx.Exec(ctx, `UPDATE notifications SET escalation_level = $1, escalated_at = NOW() WHERE id_notification = $2`, level, n.id)
+		if err != nil {
+			return err
+		}
+		// A higher level is news again for everyone who already read it
+		_, err = tx.Exec(ctx, `UPDATE notification_deliveries SET read_at = NULL WHERE id_notification = $1`, n.id)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func (s *EscalationService) deliverEscalation(ctx context.Context, n *lateNotification, rule *assetpb.EscalationRule) {
+	if s.Dispatcher == nil {
+		return
+	}
+	nips, err := s.Users.WithRole(ctx, rule.GetTargetRoleId(), n.outletId, n.areaId)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve escalation recipients")
+		return
+	}
+	s.Dispatcher.Notify(ctx, delivery.TemplateMaintenanceEscalated, nips, map[string]interface{}{
+		"AssetName":       n.assetName,
+		"MaintenanceDate": n.maintenanceDate.Format("2006-01-02"),
+		"DaysLate":        n.daysLate,
+		"Level":           rule.GetLevel(),
+	})
+}
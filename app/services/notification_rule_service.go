@@ -0,0 +1,176 @@
+package services
+
+import (
+	"asset-management-api/app/rules"
+	"asset-management-api/assetpb"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NotificationRuleService lets admins manage the rules evaluated by rules.Engine.
+type NotificationRuleService struct {
+	DB     *pgxpool.Pool
+	Engine *rules.Engine
+	assetpb.UnimplementedNOTIFICATIONRULEServiceServer
+}
+
+func NewNotificationRuleService(db *pgxpool.Pool, engine *rules.Engine) *NotificationRuleService {
+	return &NotificationRuleService{DB: db, Engine: engine}
+}
+
+func (s *NotificationRuleService) Register(server interface{}) {
+	grpcServer := server.(grpc.ServiceRegistrar)
+	assetpb.RegisterNOTIFICATIONRULEServiceServer(grpcServer, s)
+}
+
+func (s *NotificationRuleService) ListNotificationRules(ctx context.Context, req *assetpb.ListNotificationRulesRequest) (*assetpb.ListNotificationRulesResponse, error) {
+	log.Info().Msg("Fetching notification rules")
+
+	stored, err := s.Engine.Rules(ctx, false)
+	if err != nil {
+		log.Error().Err(err).Msg("Error fetching notification rules")
+		return nil, status.Error(codes.Internal, "Failed to get notification rules")
+	}
+
+	var data []*assetpb.NotificationRule
+	for _, r := range stored {
+		data = append(data, &assetpb.NotificationRule{
+			Id:           r.Id,
+			Name:         r.Name,
+			Condition:    r.Condition,
+			Value:        r.Value,
+			AudienceType: r.AudienceType,
+			AudienceId:   r.AudienceId,
+			Channel:      r.Channel,
+			Enabled:      r.Enabled,
+		})
+	}
+
+	return &assetpb.ListNotificationRulesResponse{
+		Data:    data,
+		Message: "Successfully fetched notification rules",
+		Code:    "200",
+	}, nil
+}
+
+func (s *NotificationRuleService) CreateNotificationRule(ctx context.Context, req *assetpb.CreateNotificationRuleRequest) (*assetpb.CreateNotificationRuleResponse, error) {
+	rule, err := ruleFromProto(req.GetRule())
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Msgf("Creating notification rule %s", rule.Name)
+
+	err = s.DB.QueryRow(ctx, `
+        INSERT INTO notification_rules (name, condition, value, audience_type, audience_id, channel, enabled)
+        VALUES ($1, $2, NULLIF($3, ''), $4, NULLIF($5, 0), NULLIF($6, ''), $7)
+        RETURNING id`,
+		rule.Name, rule.Condition, rule.Value, rule.AudienceType, rule.AudienceId, rule.Channel, rule.Enabled).Scan(&req.Rule.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create notification rule")
+		return nil, status.Error(codes.Internal, "Failed to create notification rule")
+	}
+
+	return &assetpb.CreateNotificationRuleResponse{
+		Data:    req.GetRule(),
+		Message: "Successfully created notification rule",
+		Code:    "200",
+	}, nil
+}
+
+func (s *NotificationRuleService) UpdateNotificationRule(ctx context.Context, req *assetpb.UpdateNotificationRuleRequest) (*assetpb.UpdateNotificationRuleResponse, error) {
+	rule, err := ruleFromProto(req.GetRule())
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Msgf("Updating notification rule ID %d", req.GetId())
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update notification rule")
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+        UPDATE notification_rules SET name = $1, condition = $2, value = NULLIF($3, ''), audience_type = $4,
+               audience_id = NULLIF($5, 0), channel = NULLIF($6, ''), enabled = $7, updated_at = NOW()
+        WHERE id = $8`,
+		rule.Name, rule.Condition, rule.Value, rule.AudienceType, rule.AudienceId, rule.Channel, rule.Enabled, req.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update notification rule")
+		return nil, status.Error(codes.Internal, "Failed to update notification rule")
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "Notification rule not found")
+	}
+
+	// A changed condition may match different assets, so let it fire afresh
+	if _, err := tx.Exec(ctx, `DELETE FROM notification_rule_firings WHERE rule_id = $1`, req.GetId()); err != nil {
+		log.Error().Err(err).Msg("Failed to reset notification rule firings")
+		return nil, status.Error(codes.Internal, "Failed to update notification rule")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update notification rule")
+	}
+
+	return &assetpb.UpdateNotificationRuleResponse{
+		Message: "Successfully updated notification rule",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+func (s *NotificationRuleService) DeleteNotificationRule(ctx context.Context, req *assetpb.DeleteNotificationRuleRequest) (*assetpb.DeleteNotificationRuleResponse, error) {
+	log.Info().Msgf("Deleting notification rule ID %d", req.GetId())
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to delete notification rule")
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM notification_rule_firings WHERE rule_id = $1`, req.GetId()); err != nil {
+		log.Error().Err(err).Msg("Failed to delete notification rule firings")
+		return nil, status.Error(codes.Internal, "Failed to delete notification rule")
+	}
+	tag, err := tx.Exec(ctx, `DELETE FROM notification_rules WHERE id = $1`, req.GetId())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to delete notification rule")
+		return nil, status.Error(codes.Internal, "Failed to delete notification rule")
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "Notification rule not found")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to delete notification rule")
+	}
+
+	return &assetpb.DeleteNotificationRuleResponse{
+		Message: "Successfully deleted notification rule",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+func ruleFromProto(r *assetpb.NotificationRule) (rules.Rule, error) {
+	if r == nil || r.GetName() == "" {
+		return rules.Rule{}, status.Error(codes.InvalidArgument, "Rule name is required")
+	}
+	rule := rules.Rule{
+		Name:         r.GetName(),
+		Condition:    r.GetCondition(),
+		Value:        r.GetValue(),
+		AudienceType: r.GetAudienceType(),
+		AudienceId:   r.GetAudienceId(),
+		Channel:      r.GetChannel(),
+		Enabled:      r.GetEnabled(),
+	}
+	if err := rule.Validate(); err != nil {
+		return rules.Rule{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return rule, nil
+}
@@ -1,6 +1,12 @@
 package services
 
 import (
+	"asset-management-api/app/auth"
+	"asset-management-api/app/delivery"
+	"asset-management-api/app/digest"
+	"asset-management-api/app/lifecycle"
+	"asset-management-api/app/realtime"
+	"asset-management-api/app/repository"
 	"asset-management-api/assetpb"
 	"context"
 	"errors"
@@ -9,6 +15,7 @@ import (
 
 	"database/sql"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
@@ -17,12 +24,36 @@ import (
 )
 
 type NotificationService struct {
-	DB *pgxpool.Pool
+	DB            *pgxpool.Pool
+	Notifications repository.NotificationRepository
+	Assets        repository.AssetRepository
+	Submissions   repository.SubmissionRepository
+	Users         repository.UserRepository
+	Hub           *realtime.Hub
+	Dispatcher    *delivery.Dispatcher
+	// Tasks sends the reminders; needed when Dispatcher is set
+	Tasks *lifecycle.Tasks
 	assetpb.UnimplementedNOTIFICATIONServiceServer
 }
 
-func NewNotificationService(db *pgxpool.Pool) *NotificationService {
-	return &NotificationService{DB: db}
+const (
+	defaultHeartbeat = 25 * time.Second
+	minHeartbeat     = 5 * time.Second
+	maxHeartbeat     = 5 * time.Minute
+)
+
+func NewNotificationService(db *pgxpool.Pool, notifications repository.NotificationRepository, assets repository.AssetRepository,
+	submissions repository.SubmissionRepository, users repository.UserRepository, hub *realtime.Hub, dispatcher *delivery.Dispatcher, tasks *lifecycle.Tasks) *NotificationService {
+	return &NotificationService{
+		DB:            db,
+		Notifications: notifications,
+		Assets:        assets,
+		Submissions:   submissions,
+		Users:         users,
+		Hub:           hub,
+		Dispatcher:    dispatcher,
+		Tasks:         tasks,
+	}
 }
 
 func (s *NotificationService) Register(server interface{}) {
@@ -30,66 +61,60 @@ func (s *NotificationService) Register(server interface{}) {
 	assetpb.RegisterNOTIFICATIONServiceServer(grpcServer, s)
 }
 
+// maintenanceStatus is the notification status of an asset due for
+// maintenance on the given date: "late" once it has passed, "waiting" within
+// a week and "normal" (no notification) otherwise.
+func maintenanceStatus(maintenanceDate, now time.Time) string {
+	daysUntilMaintenance := int(maintenanceDate.Sub(now).Hours() / 24)
+	if daysUntilMaintenance < 0 {
+		return "late"
+	} else if daysUntilMaintenance <= 7 {
+		return "waiting"
+	}
+	return "normal"
+}
+
 func (s *NotificationService) InsertNotificationsForAllAssets(ctx context.Context, req *assetpb.InsertAllRequest) (*assetpb.InsertAllResponse, error) {
 	log.Info().Msg("Processing notifications based on asset maintenance dates and submissions")
 
 	// Step 1: Fetch assets from database
-	query := `SELECT asset_id, asset_name, asset_maintenance_date, outlet_id, area_id FROM assets`
-	rows, err := s.DB.Query(ctx, query)
+	assets, err := s.Assets.List(ctx, repository.AssetFilter{})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to retrieve assets")
 		return nil, err
 	}
-	defer rows.Close()
-
-	var assets []struct {
-		AssetId              int32
-		AssetName            string
-		AssetMaintenanceDate time.Time
-		OutletId             int32
-		AreaId               int32
-	}
-
-	for rows.Next() {
-		var asset struct {
-			AssetId              int32
-			AssetName            string
-			AssetMaintenanceDate time.Time
-			OutletId             int32
-			AreaId               int32
-		}
-		if err := rows.Scan(&asset.AssetId, &asset.AssetName, &asset.AssetMaintenanceDate, &asset.OutletId, &asset.AreaId); err != nil {
-			log.Error().Err(err).Msg("Failed to scan asset row")
-			continue
-		}
-		assets = append(assets, asset)
-	}
 
 	log.Info().Msgf("Found %d assets", len(assets))
 
 	// Step 2: Process assets
 	for _, asset := range assets {
-		log.Info().Msgf("Processing asset ID: %d, Name: %s, Maintenance Date: %s", asset.AssetId, asset.AssetName, asset.AssetMaintenanceDate.Format("2006-01-02"))
-
-		daysUntilMaintenance := int(time.Until(asset.AssetMaintenanceDate).Hours() / 24)
-		notificationStatus := "normal"
-		if daysUntilMaintenance < 0 {
-			notificationStatus = "late"
-		} else if daysUntilMaintenance <= 7 {
-			notificationStatus = "waiting"
+		log.Info().Msgf("Processing asset ID: %d, Name: %s, Maintenance Date: %s", asset.AssetId, asset.AssetName, asset.AssetMaintenanceDate)
+
+		maintenanceDate, err := time.Parse("2006-01-02", asset.AssetMaintenanceDate)
+		if err != nil {
+			log.Error().Err(err).Msgf("Invalid maintenance date for asset ID %d", asset.AssetId)
+			continue
 		}
+		notificationStatus := maintenanceStatus(maintenanceDate, time.Now())
 
 		// Check if notification exists
-		var existingNotificationId int
-		err = s.DB.QueryRow(ctx, `SELECT id_notification FROM notifications WHERE asset_id = $1`, asset.AssetId).Scan(&existingNotificationId)
+		existing, err := s.Notifications.ForAsset(ctx, asset.AssetId, false)
 
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, repository.ErrNotFound) {
 			if notificationStatus != "normal" {
 				log.Info().Msgf("Creating notification for asset ID %d", asset.AssetId)
-				_, err := s.DB.Exec(ctx, `INSERT INTO notifications (asset_id, asset_name, outlet_id, area_id, maintenance_or_submitted, status) VALUES ($1, $2, $3, $4, $5, $6)`,
-					asset.AssetId, asset.AssetName, asset.OutletId, asset.AreaId, asset.AssetMaintenanceDate.Format("2006-01-02"), notificationStatus)
+				err := s.Notifications.Create(ctx, &assetpb.Notification{
+					AssetId:                asset.AssetId,
+					AssetName:              asset.AssetName,
+					OutletId:               asset.OutletId,
+					AreaId:                 asset.AreaId,
+					MaintenanceOrSubmitted: asset.AssetMaintenanceDate,
+					Status:                 notificationStatus,
+				})
 				if err != nil {
 					log.Error().Err(err).Msgf("Failed to insert notification for asset ID %d", asset.AssetId)
+				} else {
+					s.deliverMaintenance(asset.AssetName, asset.OutletId, asset.AreaId, maintenanceDate, notificationStatus)
 				}
 			}
 		} else if err != nil {
@@ -97,52 +122,40 @@ func (s *NotificationService) InsertNotificationsForAllAssets(ctx context.Contex
 		} else {
 			if notificationStatus == "normal" {
 				log.Info().Msgf("Deleting notification for asset ID %d", asset.AssetId)
-				_, err := s.DB.Exec(ctx, `DELETE FROM notifications WHERE asset_id = $1`, asset.AssetId)
-				if err != nil {
+				if err := s.Notifications.Delete(ctx, existing.IdNotification); err != nil {
 					log.Error().Err(err).Msgf("Failed to delete notification for asset ID %d", asset.AssetId)
 				}
 			} else {
 				log.Info().Msgf("Updating notification for asset ID %d", asset.AssetId)
-				_, err := s.DB.Exec(ctx, `UPDATE notifications SET maintenance_or_submitted = $1, status = $2 WHERE asset_id = $3`,
-					asset.AssetMaintenanceDate.Format("2006-01-02"), notificationStatus, asset.AssetId)
+				err := s.Notifications.UpdateStatus(ctx, existing.IdNotification, asset.AssetMaintenanceDate, notificationStatus)
 				if err != nil {
 					log.Error().Err(err).Msgf("Failed to update notification for asset ID %d", asset.AssetId)
 				}
+
+				// A status change (e.g. waiting -> late) is news again for everyone
+				if existing.Status != notificationStatus {
+					if err := s.Notifications.ResetDeliveries(ctx, existing.IdNotification); err != nil {
+						log.Error().Err(err).Msgf("Failed to reset deliveries for notification ID %d", existing.IdNotification)
+					}
+					s.deliverMaintenance(asset.AssetName, asset.OutletId, asset.AreaId, maintenanceDate, notificationStatus)
+				}
+
+				// No longer late (the date was pushed forward): escalation starts over
+				if existing.Status == "late" && notificationStatus != "late" {
+					if err := s.Notifications.ResetEscalation(ctx, existing.IdNotification); err != nil {
+						log.Error().Err(err).Msgf("Failed to reset escalation for notification ID %d", existing.IdNotification)
+					}
+				}
 			}
 		}
 	}
 
 	// Step 3: Fetch submissions
-	query = `SELECT submission_id, asset_id, submission_asset_name, outlet_id, area_id FROM submissions`
-	rows, err = s.DB.Query(ctx, query)
+	submissions, err := s.Submissions.List(ctx, repository.SubmissionFilter{Grouping: repository.AnyGrouping})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to retrieve submissions")
 		return nil, err
 	}
-	defer rows.Close()
-
-	var submissions []struct {
-		SubmissionId        *int32
-		AssetId             int32
-		SubmissionAssetName string
-		OutletId            int32
-		AreaId              int32
-	}
-
-	for rows.Next() {
-		var submission struct {
-			SubmissionId        *int32
-			AssetId             int32
-			SubmissionAssetName string
-			OutletId            int32
-			AreaId              int32
-		}
-		if err := rows.Scan(&submission.SubmissionId, &submission.AssetId, &submission.SubmissionAssetName, &submission.OutletId, &submission.AreaId); err != nil {
-			log.Error().Err(err).Msg("Failed to scan submission row")
-			continue
-		}
-		submissions = append(submissions, submission)
-	}
 
 	log.Info().Msgf("Found %d submissions", len(submissions))
 
@@ -150,13 +163,19 @@ func (s *NotificationService) InsertNotificationsForAllAssets(ctx context.Contex
 	for _, submission := range submissions {
 		log.Info().Msgf("Processing submission ID: %d, Asset ID: %d", submission.SubmissionId, submission.AssetId)
 
-		var existingNotificationId int
-		err = s.DB.QueryRow(ctx, `SELECT id_notification FROM notifications WHERE asset_id = $1 AND status = 'submitted'`, submission.AssetId).Scan(&existingNotificationId)
+		_, err := s.Notifications.ForAsset(ctx, submission.AssetId, true)
 
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, repository.ErrNotFound) {
 			log.Info().Msgf("Creating notification for submission ID %d", submission.SubmissionId)
-			_, err := s.DB.Exec(ctx, `INSERT INTO notifications (asset_id, submission_id, asset_name, outlet_id, area_id, maintenance_or_submitted, status) VALUES ($1, $2, $3, $4, $5, $6, 'submitted')`,
-				submission.AssetId, submission.SubmissionId, submission.SubmissionAssetName, submission.OutletId, submission.AreaId, time.Now().Format("2006-01-02"))
+			err := s.Notifications.Create(ctx, &assetpb.Notification{
+				AssetId:                submission.AssetId,
+				SubmissionId:           submission.SubmissionId,
+				AssetName:              submission.SubmissionAssetName,
+				OutletId:               submission.OutletId,
+				AreaId:                 submission.AreaId,
+				MaintenanceOrSubmitted: time.Now().Format("2006-01-02"),
+				Status:                 "submitted",
+			})
 			if err != nil {
 				log.Error().Err(err).Msgf("Failed to insert notification for submission ID %d", submission.SubmissionId)
 			}
@@ -191,29 +210,9 @@ func (s *NotificationService) PostNotifications(ctx context.Context, req *assetp
 func (s *NotificationService) GetNotification(ctx context.Context, req *assetpb.GetNotificationsRequest) (*assetpb.GetNotificationsResponse, error) {
 	log.Info().Msgf("Fetching notification with ID: %d", req.GetId())
 
-	query := `
-        SELECT n.id_notification, n.asset_id, n.submission_id, n.status, 
-               a.asset_name, a.outlet_id, a.area_id, a.asset_maintenance_date
-        FROM notifications n
-        LEFT JOIN assets a ON a.asset_id = n.asset_id
-        WHERE n.id_notification = $1
-    `
-
-	var notification assetpb.Notification
-
-	err := s.DB.QueryRow(ctx, query, req.GetId()).Scan(
-		&notification.IdNotification,
-		&notification.AssetId,
-		&notification.SubmissionId,
-		&notification.Status,
-		&notification.AssetName,
-		&notification.OutletId,
-		&notification.AreaId,
-		&notification.MaintenanceOrSubmitted,
-	)
-
+	notification, err := s.Notifications.Get(ctx, req.GetId(), callerNip(ctx))
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, repository.ErrNotFound) {
 			log.Warn().Msgf("Notification with ID %d not found", req.GetId())
 			return nil, status.Error(codes.NotFound, "Notification not found")
 		}
@@ -222,7 +221,7 @@ func (s *NotificationService) GetNotification(ctx context.Context, req *assetpb.
 	}
 
 	return &assetpb.GetNotificationsResponse{
-		Data:    &notification,
+		Data:    notification,
 		Code:    "200",
 		Message: "Successfully fetched notification by ID",
 	}, nil
@@ -234,10 +233,6 @@ func (s *NotificationService) GetListNotification(ctx context.Context, req *asse
 	// Getting parameters from request
 	pageNumber := req.GetPageNumber()
 	pageSize := req.GetPageSize()
-	q := req.GetQ()
-	outletId := req.OutletId
-	areaId := req.AreaId
-	roleId := req.RoleId
 
 	if pageNumber <= 0 {
 		pageNumber = 1
@@ -248,231 +243,481 @@ func (s *NotificationService) GetListNotification(ctx context.Context, req *asse
 
 	offset := (pageNumber - 1) * pageSize
 
-	// Query untuk mendapatkan daftar notifikasi dengan filter
-	query := `
-        SELECT id_notification, asset_id, submission_id, status, 
-               asset_name, outlet_id, area_id, maintenance_or_submitted
-        FROM notifications
-        WHERE 1=1
-    `
-	var params []interface{}
-	paramIndex := 1
-
-	if q != "" {
-		query += fmt.Sprintf(" AND asset_name LIKE $%d", paramIndex)
-		params = append(params, "%"+q+"%")
-		paramIndex++
-	}
+	filter := repository.NotificationFilter{
+		Query:      req.GetQ(),
+		Scope:      roleScope(req.GetRoleId(), req.GetOutletId(), req.GetAreaId()),
+		Nip:        callerNip(ctx),
+		UnreadOnly: req.GetUnreadOnly(),
 
-	if roleId == 5 {
-		query += fmt.Sprintf(" AND area_id = $%d", paramIndex)
-		params = append(params, areaId)
-		paramIndex++
-	} else if roleId == 6 {
-		query += fmt.Sprintf(" AND outlet_id = $%d", paramIndex)
-		params = append(params, outletId)
-		paramIndex++
+		EscalationLevel: req.GetEscalationLevel(),
 	}
 
-	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", paramIndex, paramIndex+1)
-	params = append(params, pageSize, offset)
-
-	rows, err := s.DB.Query(ctx, query, params...)
+	notifications, err := s.Notifications.List(ctx, filter, offset, pageSize)
 	if err != nil {
 		log.Error().Err(err).Msg("Error fetching notifications")
 		return nil, err
 	}
-	defer rows.Close()
-
-	var notifications []*assetpb.Notification
-	for rows.Next() {
-		var notif assetpb.Notification
-		var submissionId sql.NullInt32
-		var maintenanceOrSubmitted sql.NullTime
-
-		err := rows.Scan(
-			&notif.IdNotification,
-			&notif.AssetId,
-			&submissionId,
-			&notif.Status,
-			&notif.AssetName,
-			&notif.OutletId,
-			&notif.AreaId,
-			&maintenanceOrSubmitted,
-		)
-		if err != nil {
-			log.Error().Err(err).Msg("Error scanning row")
-			return nil, err
-		}
-
-		if maintenanceOrSubmitted.Valid {
-			notif.MaintenanceOrSubmitted = maintenanceOrSubmitted.Time.Format("2006-01-02")
-		} else {
-			notif.MaintenanceOrSubmitted = ""
-		}
-
-		notifications = append(notifications, &notif)
-	}
 
 	// Query untuk mendapatkan total count berdasarkan status
-	totalWaiting, _ := s.GetTotalCountWithFilters("notifications", q, outletId, areaId, "waiting", roleId)
-	totalLate, _ := s.GetTotalCountWithFilters("notifications", q, outletId, areaId, "late", roleId)
-	totalSubmitted, _ := s.GetTotalCountWithFilters("notifications", q, outletId, areaId, "submitted", roleId)
+	totalWaiting, _ := s.countWithStatus(ctx, filter, "waiting")
+	totalLate, _ := s.countWithStatus(ctx, filter, "late")
+	totalSubmitted, _ := s.countWithStatus(ctx, filter, "submitted")
 
 	totalCount := totalWaiting + totalLate + totalSubmitted
 
+	unreadFilter := filter
+	unreadFilter.UnreadOnly = true
+	totalUnread, _ := s.countWithStatus(ctx, unreadFilter, "")
+
+	escalationCounts, err := s.Notifications.EscalationCounts(ctx, filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Error fetching escalation counts")
+	}
+
 	resp := &assetpb.GetListNotificationResponse{
-		Data:           notifications,
-		TotalCount:     int32(totalCount),
-		TotalWaiting:   int32(totalWaiting),
-		TotalLate:      int32(totalLate),
-		TotalSubmitted: int32(totalSubmitted),
-		PageNumber:     pageNumber,
-		PageSize:       pageSize,
+		Data:             notifications,
+		TotalCount:       totalCount,
+		TotalWaiting:     totalWaiting,
+		TotalLate:        totalLate,
+		TotalSubmitted:   totalSubmitted,
+		TotalUnread:      totalUnread,
+		EscalationCounts: escalationCounts,
+		PageNumber:       pageNumber,
+		PageSize:         pageSize,
 	}
 
 	// Menambahkan token halaman berikutnya jika masih ada data
-	if int32(totalCount) > offset+pageSize {
+	if totalCount > offset+pageSize {
 		resp.NextPageToken = fmt.Sprintf("page_token_%d", pageNumber+1)
 	}
 
 	return resp, nil
 }
 
-func (s *NotificationService) GetTotalCountWithFilters(tableName string, q string, outletId, areaId int32, status string, roleId int32) (int, error) {
-	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE status = $1", tableName)
-	var params []interface{}
-	params = append(params, status)
-	paramIndex := 2
-
-	if q != "" {
-		query += fmt.Sprintf(" AND asset_name LIKE $%d", paramIndex)
-		params = append(params, "%"+q+"%")
-		paramIndex++
-	}
-	if roleId == RoleArea && areaId != 0 {
-		query += fmt.Sprintf(" AND area_id = $%d", paramIndex)
-		params = append(params, areaId)
-		paramIndex++
-	} else if roleId == RoleOutlet && outletId != 0 {
-		query += fmt.Sprintf(" AND outlet_id = $%d", paramIndex)
-		params = append(params, outletId)
-		paramIndex++
-	}
-
-	var count int
-	err := s.DB.QueryRow(context.Background(), query, params...).Scan(&count)
+// countWithStatus counts the notifications visible to the filter's user,
+// optionally restricted to one status.
+func (s *NotificationService) countWithStatus(ctx context.Context, filter repository.NotificationFilter, status string) (int32, error) {
+	filter.Status = status
+	count, err := s.Notifications.Count(ctx, filter)
 	if err != nil {
 		log.Error().Err(err).Msg("Error fetching total count")
 		return 0, err
 	}
-
 	return count, nil
 }
-func (s *NotificationService) GetNotificationsFromDBWithFilters(offset, limit int, q string, outletId, areaId int32) ([]*assetpb.Notification, error) {
-	log.Info().Msg("Fetching notifications from database with filters")
 
-	query := `
-        SELECT n.id_notification, n.asset_id, n.submission_id, n.status, 
-               n.asset_name, n.outlet_id, n.area_id, n.maintenance_or_submitted,
-               a.asset_maintenance_date
-        FROM notifications n
-        LEFT JOIN assets a ON a.asset_id = n.asset_id
-        WHERE 1=1
-    `
-	var params []interface{}
-	paramIndex := 1
+// roleScope limits area users to their area and outlet users to their outlet.
+func roleScope(roleId, outletId, areaId int32) repository.Scope {
+	switch roleId {
+	case RoleArea:
+		return repository.Scope{AreaId: areaId}
+	case RoleOutlet:
+		return repository.Scope{OutletId: outletId}
+	}
+	return repository.Scope{}
+}
+
+// callerFilter scopes the inbox to the authenticated user's own area or outlet.
+func callerFilter(ctx context.Context) repository.NotificationFilter {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return repository.NotificationFilter{}
+	}
+	return repository.NotificationFilter{
+		Scope: roleScope(claims.RoleId, claims.OutletId, claims.AreaId),
+		Nip:   claims.Nip,
+	}
+}
+
+func callerNip(ctx context.Context) int32 {
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		return claims.Nip
+	}
+	return 0
+}
+
+// setDeliveryState records a per-user state change (read, dismiss, snooze) on
+// a notification. Notifications outside the caller's outlet or area are not
+// found, so their ids are not given away.
+func (s *NotificationService) setDeliveryState(ctx context.Context, id int32, nip int32, state repository.DeliveryState, at time.Time) error {
+	err := s.Notifications.SetDeliveryState(ctx, id, nip, callerFilter(ctx).Scope, state, at)
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, "Notification not found")
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to update %s for notification ID %d", state, id)
+		return status.Error(codes.Internal, "Failed to update notification")
+	}
+	return nil
+}
+
+func (s *NotificationService) MarkNotificationRead(ctx context.Context, req *assetpb.MarkNotificationReadRequest) (*assetpb.MarkNotificationReadResponse, error) {
+	nip := callerNip(ctx)
+	log.Info().Msgf("Marking notification %d as read for NIP %d", req.GetId(), nip)
 
-	if q != "" {
-		query += fmt.Sprintf(" AND n.asset_name LIKE $%d", paramIndex)
-		params = append(params, "%"+q+"%")
-		paramIndex++
+	if err := s.setDeliveryState(ctx, req.GetId(), nip, repository.DeliveryRead, time.Now()); err != nil {
+		return nil, err
 	}
 
-	if outletId != 0 {
-		query += fmt.Sprintf(" AND n.outlet_id = $%d", paramIndex)
-		params = append(params, outletId)
-		paramIndex++
+	return &assetpb.MarkNotificationReadResponse{
+		Message: "Notification marked as read",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+func (s *NotificationService) MarkAllNotificationsRead(ctx context.Context, req *assetpb.MarkAllNotificationsReadRequest) (*assetpb.MarkAllNotificationsReadResponse, error) {
+	filter := callerFilter(ctx)
+	log.Info().Msgf("Marking all notifications as read for NIP %d", filter.Nip)
+
+	updated, err := s.Notifications.MarkAllRead(ctx, filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to mark all notifications as read")
+		return nil, status.Error(codes.Internal, "Failed to mark notifications as read")
 	}
 
-	if areaId != 0 {
-		query += fmt.Sprintf(" AND n.area_id = $%d", paramIndex)
-		params = append(params, areaId)
-		paramIndex++
+	return &assetpb.MarkAllNotificationsReadResponse{
+		Message: "All notifications marked as read",
+		Code:    "200",
+		Success: true,
+		Updated: int32(updated),
+	}, nil
+}
+
+func (s *NotificationService) DismissNotification(ctx context.Context, req *assetpb.DismissNotificationRequest) (*assetpb.DismissNotificationResponse, error) {
+	nip := callerNip(ctx)
+	log.Info().Msgf("Dismissing notification %d for NIP %d", req.GetId(), nip)
+
+	if err := s.setDeliveryState(ctx, req.GetId(), nip, repository.DeliveryDismissed, time.Now()); err != nil {
+		return nil, err
 	}
 
-	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", paramIndex, paramIndex+1)
-	params = append(params, limit, offset)
+	return &assetpb.DismissNotificationResponse{
+		Message: "Notification dismissed",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
 
-	log.Info().Msgf("Executing query: %s with params: %v", query, params)
+func (s *NotificationService) SnoozeNotification(ctx context.Context, req *assetpb.SnoozeNotificationRequest) (*assetpb.SnoozeNotificationResponse, error) {
+	nip := callerNip(ctx)
+	log.Info().Msgf("Snoozing notification %d for NIP %d until %s", req.GetId(), nip, req.GetSnoozedUntil())
 
-	rows, err := s.DB.Query(context.Background(), query, params...)
+	snoozedUntil, err := time.Parse(time.RFC3339, req.GetSnoozedUntil())
 	if err != nil {
-		log.Error().Err(err).Msg("Error executing query")
+		return nil, status.Error(codes.InvalidArgument, "snoozed_until must be an RFC 3339 timestamp")
+	}
+	if !snoozedUntil.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "snoozed_until must be in the future")
+	}
+
+	if err := s.setDeliveryState(ctx, req.GetId(), nip, repository.DeliverySnoozed, snoozedUntil); err != nil {
 		return nil, err
 	}
+
+	return &assetpb.SnoozeNotificationResponse{
+		Message: "Notification snoozed",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+func (s *NotificationService) GetUnreadNotificationCount(ctx context.Context, req *assetpb.GetUnreadNotificationCountRequest) (*assetpb.GetUnreadNotificationCountResponse, error) {
+	filter := callerFilter(ctx)
+	filter.UnreadOnly = true
+
+	count, err := s.countWithStatus(ctx, filter, "")
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to count unread notifications")
+	}
+
+	return &assetpb.GetUnreadNotificationCountResponse{
+		UnreadCount: count,
+		Message:     "Successfully fetched unread notification count",
+		Code:        "200",
+	}, nil
+}
+
+// StreamNotifications pushes notification changes in the caller's outlet or
+// area. Clients resume after a disconnect by sending the last event ID they saw.
+func (s *NotificationService) StreamNotifications(req *assetpb.StreamNotificationsRequest, stream assetpb.NOTIFICATIONService_StreamNotificationsServer) error {
+	ctx := stream.Context()
+	filter := callerFilter(ctx)
+	scope := realtime.Scope{OutletId: filter.Scope.OutletId, AreaId: filter.Scope.AreaId}
+
+	heartbeat := defaultHeartbeat
+	if req.GetHeartbeatSeconds() > 0 {
+		heartbeat = time.Duration(req.GetHeartbeatSeconds()) * time.Second
+		heartbeat = max(minHeartbeat, min(heartbeat, maxHeartbeat))
+	}
+
+	log.Info().Msgf("Opening notification stream for NIP %d from event %d", filter.Nip, req.GetLastEventId())
+
+	// Subscribe before replaying so nothing committed in between is missed
+	sub := s.Hub.Subscribe(scope)
+	defer sub.Close()
+
+	lastEventID := req.GetLastEventId()
+	send := func(evt realtime.Event) error {
+		return stream.Send(&assetpb.NotificationEvent{
+			EventId:    evt.EventID,
+			Type:       evt.Type,
+			Data:       evt.Notification,
+			OccurredAt: evt.OccurredAt.Format(time.RFC3339),
+		})
+	}
+	sendHeartbeat := func() error {
+		return stream.Send(&assetpb.NotificationEvent{
+			EventId:    lastEventID,
+			Type:       "heartbeat",
+			OccurredAt: time.Now().Format(time.RFC3339),
+		})
+	}
+
+	if err := sendHeartbeat(); err != nil {
+		return err
+	}
+
+	if lastEventID > 0 {
+		events, err := s.Hub.Replay(ctx, lastEventID, scope)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to replay notification events")
+			return status.Error(codes.Internal, "Failed to replay notification events")
+		}
+		for _, evt := range events {
+			if err := send(evt); err != nil {
+				return err
+			}
+			lastEventID = evt.EventID
+		}
+	}
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case evt, ok := <-sub.C:
+			if !ok {
+				return status.Error(codes.Unavailable, "Notification stream fell behind, resume from the last event ID")
+			}
+			if evt.EventID <= lastEventID {
+				continue
+			}
+			if err := send(evt); err != nil {
+				return err
+			}
+			lastEventID = evt.EventID
+		case <-ticker.C:
+			if err := sendHeartbeat(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// deliverMaintenance sends a maintenance reminder to the users responsible for
+// the asset's outlet and area over their preferred channels.
+func (s *NotificationService) deliverMaintenance(assetName string, outletId, areaId int32, maintenanceDate time.Time, notificationStatus string) {
+	if s.Dispatcher == nil {
+		return
+	}
+	template := delivery.TemplateMaintenanceDue
+	if notificationStatus == "late" {
+		template = delivery.TemplateMaintenanceLate
+	}
+
+	s.Tasks.Go("maintenance reminder", func(ctx context.Context) {
+		nips, err := s.Users.InScope(ctx, outletId, areaId)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to resolve maintenance notification recipients")
+			return
+		}
+		s.Dispatcher.Notify(ctx, template, nips, map[string]interface{}{
+			"AssetName":       assetName,
+			"MaintenanceDate": maintenanceDate.Format("2006-01-02"),
+		})
+	})
+}
+
+func (s *NotificationService) GetChannelPreferences(ctx context.Context, req *assetpb.GetChannelPreferencesRequest) (*assetpb.GetChannelPreferencesResponse, error) {
+	nip := callerNip(ctx)
+	log.Info().Msgf("Fetching channel preferences for NIP %d", nip)
+
+	rows, err := s.DB.Query(ctx, `SELECT channel, enabled, COALESCE(address, '') FROM user_channel_preferences WHERE nip = $1 ORDER BY channel`, nip)
+	if err != nil {
+		log.Error().Err(err).Msg("Error fetching channel preferences")
+		return nil, status.Error(codes.Internal, "Failed to get channel preferences")
+	}
 	defer rows.Close()
 
-	var notifications []*assetpb.Notification
+	var preferences []*assetpb.ChannelPreference
 	for rows.Next() {
-		var notif assetpb.Notification
-		err := rows.Scan(
-			&notif.IdNotification,
-			&notif.AssetId,
-			&notif.SubmissionId,
-			&notif.Status,
-			&notif.AssetName,
-			&notif.OutletId,
-			&notif.AreaId,
-			&notif.MaintenanceOrSubmitted,
-		)
-		if err != nil {
-			log.Error().Err(err).Msg("Error scanning row")
-			return nil, err
+		var pref assetpb.ChannelPreference
+		if err := rows.Scan(&pref.Channel, &pref.Enabled, &pref.Address); err != nil {
+			log.Error().Err(err).Msg("Error scanning channel preference")
+			return nil, status.Error(codes.Internal, "Failed to get channel preferences")
 		}
-		notifications = append(notifications, &notif)
+		preferences = append(preferences, &pref)
+	}
+
+	// Without stored preferences everything goes to the account email
+	if len(preferences) == 0 {
+		preferences = append(preferences, &assetpb.ChannelPreference{Channel: delivery.ChannelEmail, Enabled: true})
+	}
+
+	locale := delivery.DefaultLocale
+	err = s.DB.QueryRow(ctx, `SELECT locale FROM user_notification_settings WHERE nip = $1`, nip).Scan(&locale)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		log.Error().Err(err).Msg("Error fetching notification settings")
+		return nil, status.Error(codes.Internal, "Failed to get channel preferences")
 	}
 
-	log.Info().Msgf("Fetched %d notifications", len(notifications))
-	return notifications, nil
+	return &assetpb.GetChannelPreferencesResponse{
+		Data:    preferences,
+		Locale:  locale,
+		Message: "Successfully fetched channel preferences",
+		Code:    "200",
+	}, nil
 }
 
-func (s *NotificationService) GetNotificationById(id int32) (*assetpb.Notification, error) {
-	log.Info().Msgf("Fetching notification with ID: %d", id)
-
-	query := `
-        SELECT n.id_notification, n.asset_id, n.submission_id, n.status, 
-               n.asset_name, n.outlet_id, n.area_id, n.maintenance_or_submitted,
-               a.asset_maintenance_date
-        FROM notifications n
-        LEFT JOIN assets a ON a.asset_id = n.asset_id
-        WHERE n.id_notification = $1
-    `
-
-	log.Info().Msgf("Executing query: %s with param: %d", query, id)
-
-	row := s.DB.QueryRow(context.Background(), query, id)
-
-	var notification assetpb.Notification
-	err := row.Scan(
-		&notification.IdNotification,
-		&notification.AssetId,
-		&notification.SubmissionId,
-		&notification.Status,
-		&notification.AssetName,
-		&notification.OutletId,
-		&notification.AreaId,
-		&notification.MaintenanceOrSubmitted,
-	)
+func (s *NotificationService) UpdateChannelPreferences(ctx context.Context, req *assetpb.UpdateChannelPreferencesRequest) (*assetpb.UpdateChannelPreferencesResponse, error) {
+	nip := callerNip(ctx)
+	log.Info().Msgf("Updating channel preferences for NIP %d", nip)
+
+	for _, pref := range req.GetPreferences() {
+		switch pref.GetChannel() {
+		case delivery.ChannelEmail, delivery.ChannelWebhook, delivery.ChannelChat:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown channel %q", pref.GetChannel())
+		}
+	}
+	if locale := req.GetLocale(); locale != "" && locale != "id" && locale != "en" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unsupported locale %q", locale)
+	}
+
+	tx, err := s.DB.Begin(ctx)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			log.Error().Msgf("Notification with ID %d not found", id)
-			return nil, status.Error(codes.NotFound, "Notification not found")
+		return nil, status.Error(codes.Internal, "Failed to update channel preferences")
+	}
+	defer tx.Rollback(ctx)
+
+	for _, pref := range req.GetPreferences() {
+		_, err := tx.Exec(ctx, `
+            INSERT INTO user_channel_preferences (nip, channel, enabled, address)
+            VALUES ($1, $2, $3, NULLIF($4, ''))
+            ON CONFLICT (nip, channel) DO UPDATE SET enabled = EXCLUDED.enabled, address = EXCLUDED.address`,
+			nip, pref.GetChannel(), pref.GetEnabled(), pref.GetAddress())
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to save channel preference")
+			return nil, status.Error(codes.Internal, "Failed to update channel preferences")
 		}
-		log.Error().Err(err).Msg("Error executing query")
-		return nil, status.Error(codes.Internal, "Failed to get notification")
 	}
 
-	log.Info().Msgf("Fetched notification: %+v", notification)
-	return &notification, nil
+	if req.GetLocale() != "" {
+		_, err := tx.Exec(ctx, `
+            INSERT INTO user_notification_settings (nip, locale) VALUES ($1, $2)
+            ON CONFLICT (nip) DO UPDATE SET locale = EXCLUDED.locale`, nip, req.GetLocale())
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to save notification settings")
+			return nil, status.Error(codes.Internal, "Failed to update channel preferences")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update channel preferences")
+	}
+
+	return &assetpb.UpdateChannelPreferencesResponse{
+		Message: "Successfully updated channel preferences",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+func (s *NotificationService) GetDigestSettings(ctx context.Context, req *assetpb.GetDigestSettingsRequest) (*assetpb.GetDigestSettingsResponse, error) {
+	nip := callerNip(ctx)
+	log.Info().Msgf("Fetching digest settings for NIP %d", nip)
+
+	settings := &assetpb.DigestSettings{
+		Frequency: digest.DefaultFrequency,
+		Hour:      digest.DefaultHour,
+		Weekday:   int32(digest.DefaultWeekday),
+	}
+	var lastSentAt sql.NullTime
+	err := s.DB.QueryRow(ctx, `
+        SELECT COALESCE(digest_frequency, $2), COALESCE(digest_hour, $3), COALESCE(digest_weekday, $4), digest_last_sent_at
+        FROM user_notification_settings WHERE nip = $1`,
+		nip, digest.DefaultFrequency, digest.DefaultHour, digest.DefaultWeekday).Scan(&settings.Frequency, &settings.Hour, &settings.Weekday, &lastSentAt)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		log.Error().Err(err).Msg("Error fetching digest settings")
+		return nil, status.Error(codes.Internal, "Failed to get digest settings")
+	}
+	if lastSentAt.Valid {
+		settings.LastSentAt = lastSentAt.Time.Format("2006-01-02 15:04:05")
+	}
+
+	return &assetpb.GetDigestSettingsResponse{
+		Data:    settings,
+		Message: "Successfully fetched digest settings",
+		Code:    "200",
+	}, nil
+}
+
+func (s *NotificationService) UpdateDigestSettings(ctx context.Context, req *assetpb.UpdateDigestSettingsRequest) (*assetpb.UpdateDigestSettingsResponse, error) {
+	nip := callerNip(ctx)
+	log.Info().Msgf("Updating digest settings for NIP %d", nip)
+
+	switch req.GetFrequency() {
+	case digest.FrequencyDaily, digest.FrequencyWeekly, digest.FrequencyOff:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Frequency must be daily, weekly or off")
+	}
+	if req.GetHour() < 0 || req.GetHour() > 23 {
+		return nil, status.Error(codes.InvalidArgument, "Hour must be between 0 and 23")
+	}
+	if req.GetWeekday() < 0 || req.GetWeekday() > 6 {
+		return nil, status.Error(codes.InvalidArgument, "Weekday must be between 0 (Sunday) and 6 (Saturday)")
+	}
+
+	_, err := s.DB.Exec(ctx, `
+        INSERT INTO user_notification_settings (nip, digest_frequency, digest_hour, digest_weekday) VALUES ($1, $2, $3, $4)
+        ON CONFLICT (nip) DO UPDATE SET digest_frequency = EXCLUDED.digest_frequency,
+            digest_hour = EXCLUDED.digest_hour, digest_weekday = EXCLUDED.digest_weekday`,
+		nip, req.GetFrequency(), req.GetHour(), req.GetWeekday())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to save digest settings")
+		return nil, status.Error(codes.Internal, "Failed to update digest settings")
+	}
+
+	return &assetpb.UpdateDigestSettingsResponse{
+		Message: "Successfully updated digest settings",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
+
+// UnsubscribeDigest is reached from the link in a digest email, so it is
+// authenticated by the token rather than a login.
+func (s *NotificationService) UnsubscribeDigest(ctx context.Context, req *assetpb.UnsubscribeDigestRequest) (*assetpb.UnsubscribeDigestResponse, error) {
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Token is required")
+	}
+
+	tag, err := s.DB.Exec(ctx, `UPDATE user_notification_settings SET digest_frequency = $1 WHERE digest_token = $2`, digest.FrequencyOff, req.GetToken())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to unsubscribe from digest")
+		return nil, status.Error(codes.Internal, "Failed to unsubscribe")
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "Invalid unsubscribe link")
+	}
+
+	return &assetpb.UnsubscribeDigestResponse{
+		Message: "You have been unsubscribed from the digest",
+		Code:    "200",
+		Success: true,
+	}, nil
 }
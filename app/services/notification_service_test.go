@@ -0,0 +1,122 @@
+package services
+
+import (
+	"asset-management-api/app/auth"
+	"asset-management-api/app/repository"
+	"asset-management-api/app/repository/repositorytest"
+	"asset-management-api/assetpb"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRoleScope(t *testing.T) {
+	tests := []struct {
+		name   string
+		roleId int32
+		want   repository.Scope
+	}{
+		{"outlet user", RoleOutlet, repository.Scope{OutletId: 7}},
+		{"area user", RoleArea, repository.Scope{AreaId: 2}},
+		{"other role", 1, repository.Scope{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roleScope(tt.roleId, 7, 2); got != tt.want {
+				t.Errorf("roleScope(%d) = %+v, want %+v", tt.roleId, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetListNotificationScope(t *testing.T) {
+	notifications := repositorytest.NewNotifications(
+		&assetpb.Notification{IdNotification: 1, OutletId: 7, AreaId: 2, Status: "waiting"},
+		&assetpb.Notification{IdNotification: 2, OutletId: 8, AreaId: 2, Status: "late", EscalationLevel: 1},
+		&assetpb.Notification{IdNotification: 3, OutletId: 9, AreaId: 3, Status: "submitted"},
+	)
+	tests := []struct {
+		name      string
+		req       *assetpb.GetListNotificationRequest
+		want      []int32
+		wantTotal int32
+	}{
+		{"outlet user", &assetpb.GetListNotificationRequest{RoleId: RoleOutlet, OutletId: 7, AreaId: 2}, []int32{1}, 1},
+		{"area user", &assetpb.GetListNotificationRequest{RoleId: RoleArea, OutletId: 7, AreaId: 2}, []int32{2, 1}, 2},
+		{"other role", &assetpb.GetListNotificationRequest{RoleId: 1, OutletId: 7, AreaId: 2}, []int32{3, 2, 1}, 3},
+		{"escalated", &assetpb.GetListNotificationRequest{RoleId: RoleArea, AreaId: 2, EscalationLevel: 1}, []int32{2}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &NotificationService{Notifications: notifications}
+			resp, err := s.GetListNotification(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int32
+			for _, n := range resp.Data {
+				got = append(got, n.IdNotification)
+			}
+			if !reflect.DeepEqual(got, tt.want) || resp.TotalCount != tt.wantTotal {
+				t.Errorf("notifications = %v (total %d), want %v (total %d)", got, resp.TotalCount, tt.want, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestDeliveryStateScope(t *testing.T) {
+	notifications := repositorytest.NewNotifications(
+		&assetpb.Notification{IdNotification: 1, OutletId: 7, AreaId: 2, Status: "waiting"},
+		&assetpb.Notification{IdNotification: 2, OutletId: 8, AreaId: 2, Status: "waiting"},
+		&assetpb.Notification{IdNotification: 3, OutletId: 9, AreaId: 3, Status: "waiting"},
+	)
+	s := &NotificationService{Notifications: notifications}
+	outletUser := auth.ContextWithClaims(context.Background(), &auth.Claims{Nip: 1001, RoleId: RoleOutlet, OutletId: 7, AreaId: 2})
+	areaUser := auth.ContextWithClaims(context.Background(), &auth.Claims{Nip: 1002, RoleId: RoleArea, OutletId: 7, AreaId: 2})
+	snoozedUntil := time.Now().Add(time.Hour).Format(time.RFC3339)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		id       int32
+		wantCode codes.Code
+	}{
+		{"own outlet", outletUser, 1, codes.OK},
+		{"other outlet", outletUser, 2, codes.NotFound},
+		{"other area", outletUser, 3, codes.NotFound},
+		{"area user in area", areaUser, 2, codes.OK},
+		{"area user outside area", areaUser, 3, codes.NotFound},
+		{"missing", areaUser, 4, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nip := callerNip(tt.ctx)
+			calls := map[string]func() error{
+				"read": func() error {
+					_, err := s.MarkNotificationRead(tt.ctx, &assetpb.MarkNotificationReadRequest{Id: tt.id})
+					return err
+				},
+				"dismiss": func() error {
+					_, err := s.DismissNotification(tt.ctx, &assetpb.DismissNotificationRequest{Id: tt.id})
+					return err
+				},
+				"snooze": func() error {
+					_, err := s.SnoozeNotification(tt.ctx, &assetpb.SnoozeNotificationRequest{Id: tt.id, SnoozedUntil: snoozedUntil})
+					return err
+				},
+			}
+			for name, call := range calls {
+				if code := status.Code(call()); code != tt.wantCode {
+					t.Errorf("%s: code = %v, want %v", name, code, tt.wantCode)
+				}
+			}
+			if d := notifications.Delivery(tt.id, nip); (d != nil) != (tt.wantCode == codes.OK) {
+				t.Errorf("delivery = %+v", d)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type RoleService struct {
@@ -30,7 +32,7 @@ func (s *RoleService) Register(server interface{}) {
 func (s *RoleService) ListRole(ctx context.Context, req *assetpb.ListRoleRequest) (*assetpb.ListRoleResponse, error) {
 	log.Info().Msg("List role")
 
-	query := "SELECT role_id, role_name, status FROM roles"
+	query := "SELECT role_id, role_name, status, mfa_required FROM roles"
 	rows, err := s.DB.Query(ctx, query)
 	if err != nil {
 		log.Error().Err(err).Msg("Error fetching data")
@@ -45,7 +47,7 @@ func (s *RoleService) ListRole(ctx context.Context, req *assetpb.ListRoleRequest
 	var roles []*assetpb.Role
 	for rows.Next() {
 		var role assetpb.Role
-		if err := rows.Scan(&role.RoleId, &role.RoleName, &role.Status); err != nil {
+		if err := rows.Scan(&role.RoleId, &role.RoleName, &role.Status, &role.MfaRequired); err != nil {
 			log.Error().Err(err).Msg("Error scanning row")
 			continue
 		}
@@ -58,3 +60,28 @@ func (s *RoleService) ListRole(ctx context.Context, req *assetpb.ListRoleRequest
 		Code:    "200",
 	}, nil
 }
+
+// UpdateRoleMFAPolicy makes two-factor authentication mandatory, or optional
+// again, for the users of a role. Admins only.
+func (s *RoleService) UpdateRoleMFAPolicy(ctx context.Context, req *assetpb.UpdateRoleMFAPolicyRequest) (*assetpb.UpdateRoleMFAPolicyResponse, error) {
+	claims, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.DB.Exec(ctx, "UPDATE roles SET mfa_required = $2 WHERE role_id = $1", req.GetRoleId(), req.GetMfaRequired())
+	if err != nil {
+		log.Error().Err(err).Msg("Error updating role MFA policy")
+		return nil, status.Error(codes.Internal, "Failed to update role")
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "Role not found")
+	}
+
+	log.Info().Msgf("User %d set MFA required = %t for role %d", claims.Nip, req.GetMfaRequired(), req.GetRoleId())
+	return &assetpb.UpdateRoleMFAPolicyResponse{
+		Message: "Successfully updated role",
+		Code:    "200",
+		Success: true,
+	}, nil
+}
@@ -1,21 +1,17 @@
 package services
 
 import (
-	"asset-management-api/app/database"
+	"asset-management-api/app/auth"
 	"asset-management-api/assetpb"
 	"context"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-var db *pgxpool.Pool
-
-func init() {
-	db = database.DBConn()
-}
-
 // MasterService contains shared methods and attributes for all services.
 type MasterService struct {
 	DB *pgxpool.Pool
@@ -38,7 +34,7 @@ type InterfaceService interface {
 	Register(server interface{})
 }
 
-func GetTotalCount(table string) (int32, error) {
+func GetTotalCount(db *pgxpool.Pool, table string) (int32, error) {
 	var count int32
 	query := "SELECT COUNT(*) FROM " + table
 	err := db.QueryRow(context.Background(), query).Scan(&count)
@@ -49,3 +45,15 @@ func GetTotalCount(table string) (int32, error) {
 
 	return count, nil
 }
+
+// requireAdmin returns the caller's claims if the caller has the admin role.
+func requireAdmin(ctx context.Context) (*auth.Claims, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+	if !claims.Admin {
+		return nil, status.Error(codes.PermissionDenied, "Only administrators can do this")
+	}
+	return claims, nil
+}
@@ -2,37 +2,51 @@ package services
 
 import (
 	"asset-management-api/app/auth"
+	"asset-management-api/app/delivery"
+	"asset-management-api/app/lifecycle"
+	"asset-management-api/app/repository"
+	"asset-management-api/app/userimport"
 	"asset-management-api/app/utils"
 	"asset-management-api/assetpb"
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 
-	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UserService struct {
 	MasterService
 	assetpb.UnimplementedUSERServiceServer
-	DB *pgxpool.Pool
+	Users     repository.UserRepository
+	Tokens    *auth.TokenIssuer
+	Passwords *auth.Passwords
+	// Mailer delivers password reset links; resets are unavailable when nil
+	Mailer delivery.Mailer
+	// Tasks sends the reset links
+	Tasks *lifecycle.Tasks
+	// AppBaseURL is where reset links point to
+	AppBaseURL string
+	// ClosedSubmissionStatuses are the statuses of submissions that are
+	// finished; offboarding a user hands over the others
+	ClosedSubmissionStatuses []string
+	// Importer applies user spreadsheets; imports are unavailable when nil
+	Importer *userimport.Importer
 }
 
-type User struct {
-	Nip          int32  `json:"nip"`
-	UserFullName string `json:"user_full_name"`
-	UserEmail    string `json:"user_email"`
-	UserPassword string `json:"user_password"`
-	RoleID       int32  `json:"role_id"`
-	AreaID       *int32 `json:"area_id"`
-	OutletID     *int32 `json:"outlet_id"`
-}
-
-func NewUserService(db *pgxpool.Pool) *UserService {
+func NewUserService(users repository.UserRepository, tokens *auth.TokenIssuer, passwords *auth.Passwords, mailer delivery.Mailer, tasks *lifecycle.Tasks, appBaseURL string) *UserService {
 	return &UserService{
-		DB: db,
+		Users:      users,
+		Tokens:     tokens,
+		Passwords:  passwords,
+		Mailer:     mailer,
+		Tasks:      tasks,
+		AppBaseURL: appBaseURL,
 	}
 }
 
@@ -50,28 +64,39 @@ func (s *UserService) CreateUser(ctx context.Context, req *assetpb.CreateUserReq
 	}
 	log.Info().Msg("Creating new user")
 
-	hashedPassword, err := utils.HashPassword(req.GetUserPassword())
-	if err != nil {
+	// New users must replace the password they were given at their first
+	// login; without one, a password is generated
+	password, initialPassword := req.GetUserPassword(), ""
+	if password == "" {
+		generated, err := utils.GeneratePassword()
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to generate password")
+			return nil, status.Error(codes.Internal, "Failed to create user")
+		}
+		password, initialPassword = generated, generated
+	} else if err := s.Passwords.Validate(password); err != nil {
 		return &assetpb.CreateUserResponse{
 			Message: err.Error(),
 			Code:    "400",
 			Success: false}, nil
 	}
 
-	var areaId *int32
-	if req.AreaId != 0 {
-		areaId = &req.AreaId
-	}
-
-	var outletId *int32
-	if req.OutletId != 0 {
-		outletId = &req.OutletId
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		return &assetpb.CreateUserResponse{
+			Message: err.Error(),
+			Code:    "400",
+			Success: false}, nil
 	}
 
-	query := `INSERT INTO users (nip, user_full_name, user_email, user_password, role_id, area_id, outlet_id) 
-              VALUES ($1, $2, $3, $4, $5, $6, $7)`
-
-	_, err = s.DB.Exec(ctx, query, req.GetNip(), req.GetUserFullName(), req.GetUserEmail(), hashedPassword, req.GetRoleId(), areaId, outletId)
+	err = s.Users.Create(ctx, &assetpb.User{
+		Nip:          req.GetNip(),
+		UserFullName: req.GetUserFullName(),
+		UserEmail:    req.GetUserEmail(),
+		RoleId:       req.GetRoleId(),
+		AreaId:       req.GetAreaId(),
+		OutletId:     req.GetOutletId(),
+	}, hashedPassword)
 	if err != nil {
 		return &assetpb.CreateUserResponse{
 			Message: err.Error(),
@@ -82,24 +107,16 @@ func (s *UserService) CreateUser(ctx context.Context, req *assetpb.CreateUserReq
 	log.Info().Msgf("New user created with NIP: %d", req.GetNip())
 
 	return &assetpb.CreateUserResponse{
-		Message: "Successfully created user",
-		Code:    "200",
-		Success: true}, nil
+		Message:         "Successfully created user",
+		Code:            "200",
+		Success:         true,
+		InitialPassword: initialPassword}, nil
 }
 
 func (s *UserService) GetUser(ctx context.Context, req *assetpb.GetUserRequest) (*assetpb.GetUserResponse, error) {
 	log.Info().Msgf("Getting user with nip: %d", req.GetNip())
-	var user assetpb.User
-	var areaID sql.NullInt32
-	var outletID sql.NullInt32
 
-	query := `SELECT users.nip, users.user_full_name, users.user_email, users.role_id, users.area_id, users.outlet_id, roles.role_name 
-              FROM users 
-              LEFT JOIN roles ON users.role_id = roles.role_id 
-              WHERE users.nip = $1 LIMIT 1`
-	row := s.DB.QueryRow(ctx, query, req.GetNip())
-
-	err := row.Scan(&user.Nip, &user.UserFullName, &user.UserEmail, &user.RoleId, &areaID, &outletID, &user.RoleName)
+	user, err := s.Users.Get(ctx, req.GetNip())
 	if err != nil {
 		log.Error().Err(err).Msg("User not found")
 		return &assetpb.GetUserResponse{
@@ -108,44 +125,32 @@ func (s *UserService) GetUser(ctx context.Context, req *assetpb.GetUserRequest)
 			Success: false}, nil
 	}
 
-	// Convert NULL values to default values
-	if areaID.Valid {
-		user.AreaId = areaID.Int32
-	} else {
-		user.AreaId = 0
-	}
-
-	if outletID.Valid {
-		user.OutletId = outletID.Int32
-	} else {
-		user.OutletId = 0
-	}
-
 	return &assetpb.GetUserResponse{
 		Message: "Successfully fetched user",
 		Code:    "200",
-		Data:    &user,
+		Data:    user,
 		Success: true,
 	}, nil
 }
+
 func (s *UserService) UpdateUser(ctx context.Context, req *assetpb.UpdateUserRequest) (*assetpb.UpdateUserResponse, error) {
 	log.Info().Msgf("Updating user with nip: %d", req.GetNip())
 
-	query := `UPDATE users SET user_full_name = $1, user_email = $2, role_id = $3`
-	params := []interface{}{req.GetUserFullName(), req.GetUserEmail(), req.GetRoleId()}
-
-	if req.GetAreaId() > 0 {
-		query += ", area_id = $4"
-		params = append(params, req.GetAreaId())
-	}
-	if req.GetOutletId() > 0 {
-		query += ", outlet_id = $5"
-		params = append(params, req.GetOutletId())
+	err := s.Users.Update(ctx, &assetpb.User{
+		Nip:          req.GetNip(),
+		UserFullName: req.GetUserFullName(),
+		UserEmail:    req.GetUserEmail(),
+		RoleId:       req.GetRoleId(),
+		AreaId:       req.GetAreaId(),
+		OutletId:     req.GetOutletId(),
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return &assetpb.UpdateUserResponse{
+			Message: "User not found",
+			Code:    "404",
+			Success: false,
+		}, nil
 	}
-	query += " WHERE nip = $6"
-	params = append(params, req.GetNip())
-
-	_, err := s.DB.Exec(ctx, query, params...)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to update user")
 		return &assetpb.UpdateUserResponse{
@@ -162,20 +167,28 @@ func (s *UserService) UpdateUser(ctx context.Context, req *assetpb.UpdateUserReq
 	}, nil
 }
 
+// DeleteUser marks the user deleted rather than removing the row, so their
+// submissions and audit entries stay intact. Their sessions end and they can
+// no longer log in. Admins only, and not on their own account.
 func (s *UserService) DeleteUser(ctx context.Context, req *assetpb.DeleteUserRequest) (*assetpb.DeleteUserResponse, error) {
-	log.Info().Msg("Deleting user")
-	query := "DELETE FROM users WHERE nip = $1"
-	result, err := s.DB.Exec(ctx, query, req.GetNip())
+	claims, err := requireAdmin(ctx)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to delete user")
-		return &assetpb.DeleteUserResponse{Success: false}, nil
+		return nil, err
+	}
+	if req.GetNip() == claims.Nip {
+		return nil, status.Error(codes.FailedPrecondition, "You cannot delete yourself")
 	}
 
-	rowsAffected := result.RowsAffected()
-	if rowsAffected == 0 {
+	log.Info().Msg("Deleting user")
+	err = s.Users.Delete(ctx, req.GetNip())
+	if errors.Is(err, repository.ErrNotFound) {
 		log.Warn().Msg("No user found to delete")
 		return &assetpb.DeleteUserResponse{Success: false}, nil
 	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to delete user")
+		return &assetpb.DeleteUserResponse{Success: false}, nil
+	}
 
 	return &assetpb.DeleteUserResponse{Success: true}, nil
 }
@@ -184,76 +197,26 @@ func (s *UserService) ListUsers(ctx context.Context, req *assetpb.ListUsersReque
 	log.Info().Msg("Listing users")
 	pageNumber := req.GetPageNumber()
 	pageSize := req.GetPageSize()
-	q := req.GetQ()
 	offset := (pageNumber - 1) * pageSize
 
-	query := `SELECT users.nip, users.user_full_name, users.user_email, 
-                     users.role_id, users.area_id, users.outlet_id, 
-                     COALESCE(roles.role_name, 'Unknown') 
-              FROM users 
-              LEFT JOIN roles ON users.role_id = roles.role_id`
-	var params []interface{}
-	paramIndex := 1
-
-	if q != "" {
-		query += fmt.Sprintf(" WHERE users.user_full_name LIKE $%d OR users.user_email LIKE $%d", paramIndex, paramIndex+1)
-		params = append(params, "%"+q+"%", "%"+q+"%")
-		paramIndex += 2
+	switch req.GetStatus() {
+	case "", repository.UserActive, repository.UserDeactivated:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be active or deactivated")
 	}
+	filter := repository.UserFilter{Query: req.GetQ(), Status: req.GetStatus()}
 
-	query += fmt.Sprintf(" ORDER BY users.nip ASC LIMIT $%d OFFSET $%d", paramIndex, paramIndex+1)
-	params = append(params, pageSize, offset)
-
-	rows, err := s.DB.Query(ctx, query, params...)
+	// Hitung total data
+	totalCount, err := s.Users.Count(ctx, filter)
 	if err != nil {
-		log.Error().Err(err).Msg("Error fetching users")
+		log.Error().Err(err).Msg("Error fetching total count")
 		return nil, err
 	}
-	defer rows.Close()
-
-	var users []*assetpb.User
-	for rows.Next() {
-		var user assetpb.User
-		var areaID sql.NullInt32
-		var outletID sql.NullInt32
-		var roleName sql.NullString
-
-		err := rows.Scan(&user.Nip, &user.UserFullName, &user.UserEmail,
-			&user.RoleId, &areaID, &outletID, &roleName)
-
-		if err != nil {
-			log.Error().Err(err).Msg("Error scanning user row")
-			return nil, err
-		}
-
-		// Convert NULL values to default values
-		if areaID.Valid {
-			user.AreaId = areaID.Int32
-		} else {
-			user.AreaId = 0
-		}
-
-		if outletID.Valid {
-			user.OutletId = outletID.Int32
-		} else {
-			user.OutletId = 0
-		}
-
-		if roleName.Valid {
-			user.RoleName = roleName.String
-		} else {
-			user.RoleName = ""
-		}
-
-		users = append(users, &user)
-	}
 
-	// Hitung total data
-	var totalCount int32
-	countQuery := "SELECT COUNT(*) FROM users"
-	err = s.DB.QueryRow(ctx, countQuery).Scan(&totalCount)
+	filter.Offset, filter.Limit = offset, pageSize
+	users, err := s.Users.List(ctx, filter)
 	if err != nil {
-		log.Error().Err(err).Msg("Error fetching total count")
+		log.Error().Err(err).Msg("Error fetching users")
 		return nil, err
 	}
 
@@ -271,110 +234,312 @@ func (s *UserService) ListUsers(ctx context.Context, req *assetpb.ListUsersReque
 	return resp, nil
 }
 
+// ResetPassword is the former reset endpoint, kept for existing clients. The
+// nip is ignored: the reset token alone identifies the account.
 func (s *UserService) ResetPassword(ctx context.Context, req *assetpb.ResetPasswordRequest) (*assetpb.ResetPasswordResponse, error) {
-	log.Info().Msg("Resetting password")
+	resp, err := s.ConfirmPasswordReset(ctx, &assetpb.ConfirmPasswordResetRequest{
+		ResetToken:  req.GetResetToken(),
+		NewPassword: req.GetUserPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &assetpb.ResetPasswordResponse{
+		Message: resp.GetMessage(),
+		Code:    resp.GetCode(),
+		Success: resp.GetSuccess()}, nil
+}
 
-	// Validate user
-	var userCount int
-	err := s.DB.QueryRow(ctx, "SELECT COUNT(*) FROM users WHERE nip = $1", req.GetNip()).Scan(&userCount)
-	if err != nil || userCount == 0 {
-		log.Error().Err(err).Msg("User not found")
-		return &assetpb.ResetPasswordResponse{
-			Message: "User not found",
-			Code:    "404",
-			Success: false}, nil
+// RequestPasswordReset emails a single-use reset link to the account named
+// by nip or email. The response is the same whether or not the account
+// exists, so it cannot be used to probe for users.
+func (s *UserService) RequestPasswordReset(ctx context.Context, req *assetpb.RequestPasswordResetRequest) (*assetpb.RequestPasswordResetResponse, error) {
+	if req.GetNip() == 0 && req.GetUserEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "nip or user_email is required")
+	}
+	if s.Mailer == nil {
+		return nil, status.Error(codes.Unavailable, "Password reset is not available")
 	}
 
-	// Validate token
-	token := auth.ValidateToken(req.GetResetToken())
-	if token == nil {
-		log.Error().Msg("Invalid reset token")
-		return &assetpb.ResetPasswordResponse{
-			Message: "Invalid reset token",
-			Code:    "400",
-			Success: false}, nil
+	resp := &assetpb.RequestPasswordResetResponse{
+		Message: "If the account exists, a password reset link has been sent to its email address",
+		Code:    "200",
+		Success: true,
+	}
+
+	var user *assetpb.User
+	var err error
+	if req.GetNip() != 0 {
+		user, err = s.Users.Get(ctx, req.GetNip())
+	} else {
+		user, err = s.Users.GetByEmail(ctx, req.GetUserEmail())
+	}
+	if errors.Is(err, repository.ErrNotFound) {
+		log.Info().Msg("Password reset requested for unknown account")
+		return resp, nil
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to look up user for password reset")
+		return nil, status.Error(codes.Internal, "Failed to request password reset")
+	}
+	if user.GetUserEmail() == "" {
+		log.Warn().Msgf("Password reset requested for user %d, who has no email address", user.GetNip())
+		return resp, nil
 	}
 
-	// Hash password
-	hashedPassword, err := utils.HashPassword(req.GetUserPassword())
+	token, err := s.Tokens.IssueResetToken(ctx, user.GetNip())
+	if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, auth.ErrResetThrottled) {
+		log.Warn().Err(err).Msgf("Password reset for user %d not sent", user.GetNip())
+		return resp, nil
+	}
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to hash password")
-		return &assetpb.ResetPasswordResponse{
-			Message: "Failed to hash password",
+		log.Error().Err(err).Msg("Failed to create password reset token")
+		return nil, status.Error(codes.Internal, "Failed to request password reset")
+	}
+
+	data := map[string]interface{}{
+		"ResetToken":       token,
+		"ExpiresInMinutes": int(s.Tokens.ResetTTL.Minutes()),
+	}
+	if s.AppBaseURL != "" {
+		data["ResetLink"] = strings.TrimRight(s.AppBaseURL, "/") + "/reset-password?token=" + url.QueryEscape(token)
+	}
+	// Sent aside so the response time does not tell whether the account exists
+	nip := user.GetNip()
+	s.Tasks.Go("password reset email", func(ctx context.Context) {
+		if err := s.Mailer.Mail(ctx, nip, delivery.TemplatePasswordReset, data); err != nil {
+			log.Error().Err(err).Msgf("Failed to send password reset email to user %d", nip)
+		}
+	})
+
+	log.Info().Msgf("Password reset requested for user %d", nip)
+	return resp, nil
+}
+
+// ConfirmPasswordReset sets a new password with a reset token and ends every
+// session of the account.
+func (s *UserService) ConfirmPasswordReset(ctx context.Context, req *assetpb.ConfirmPasswordResetRequest) (*assetpb.ConfirmPasswordResetResponse, error) {
+	if err := s.Passwords.Validate(req.GetNewPassword()); err != nil {
+		return &assetpb.ConfirmPasswordResetResponse{
+			Message: err.Error(),
 			Code:    "400",
 			Success: false}, nil
 	}
 
-	// Reset password: update user password
-	_, err = s.DB.Exec(ctx, "UPDATE users SET user_password = $1 WHERE nip = $2", hashedPassword, req.GetNip())
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to reset password")
-		return &assetpb.ResetPasswordResponse{
-			Message: "Failed to reset password",
+	nip, err := s.Tokens.ResetPassword(ctx, req.GetResetToken(), req.GetNewPassword(), s.Passwords)
+	if errors.Is(err, auth.ErrPasswordPolicy) {
+		return &assetpb.ConfirmPasswordResetResponse{
+			Message: err.Error(),
+			Code:    "400",
+			Success: false}, nil
+	}
+	if errors.Is(err, auth.ErrInvalidToken) {
+		log.Warn().Msg("Invalid reset token")
+		return &assetpb.ConfirmPasswordResetResponse{
+			Message: "Invalid or expired reset token",
 			Code:    "400",
 			Success: false}, nil
 	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to reset password")
+		return nil, status.Error(codes.Internal, "Failed to reset password")
+	}
 
-	return &assetpb.ResetPasswordResponse{
+	log.Info().Msgf("Password reset for user %d, all sessions revoked", nip)
+	return &assetpb.ConfirmPasswordResetResponse{
 		Message: "Successfully reset password",
 		Code:    "200",
 		Success: true}, nil
 }
 
-func getUsers(db *pgxpool.Pool, offset, limit int32, q string) ([]*assetpb.User, error) {
-	var users []*assetpb.User
-	var rows pgx.Rows
-	var err error
+// SetPassword replaces the user's password without a reset token and ends
+// their sessions; they must change it at their next login. An empty password
+// is generated and returned. It backs the admin command line.
+func (s *UserService) SetPassword(ctx context.Context, nip int32, password string) (string, error) {
+	var generated string
+	if password == "" {
+		var err error
+		if generated, err = utils.GeneratePassword(); err != nil {
+			return "", err
+		}
+		password = generated
+	} else if err := s.Passwords.Validate(password); err != nil {
+		return "", err
+	}
+	if err := s.Passwords.Assign(ctx, nip, password); err != nil {
+		return "", err
+	}
+	log.Info().Msgf("Password set for user with NIP: %d", nip)
+	return generated, nil
+}
 
-	query := `SELECT users.nip, users.user_full_name, users.user_email, users.role_id, users.area_id, users.outlet_id, roles.role_name 
-              FROM users 
-              LEFT JOIN roles ON users.role_id = roles.role_id 
-              WHERE users.nip = $1 LIMIT 1`
+// DisableUser blocks the user from logging in and ends their sessions; it
+// backs the admin command line.
+func (s *UserService) DisableUser(ctx context.Context, nip int32) error {
+	if err := s.Users.Deactivate(ctx, nip); err != nil {
+		return err
+	}
+	log.Info().Msgf("User with NIP %d disabled", nip)
+	return nil
+}
+
+// EnableUser lets a disabled user log in again; it backs the admin command
+// line.
+func (s *UserService) EnableUser(ctx context.Context, nip int32) error {
+	if err := s.Users.Reactivate(ctx, nip); err != nil {
+		return err
+	}
+	log.Info().Msgf("User with NIP %d reactivated", nip)
+	return nil
+}
 
-	params := []interface{}{}
+// DeactivateUser blocks the user from logging in and ends their sessions,
+// which also stops their access tokens.
+func (s *UserService) DeactivateUser(ctx context.Context, req *assetpb.DeactivateUserRequest) (*assetpb.DeactivateUserResponse, error) {
+	claims, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetNip() == claims.Nip {
+		return nil, status.Error(codes.FailedPrecondition, "You cannot deactivate yourself")
+	}
 
-	if q != "" {
-		query += " WHERE users.user_full_name LIKE $1"
-		params = append(params, "%"+q+"%")
+	err = s.Users.Deactivate(ctx, req.GetNip())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to deactivate user %d", req.GetNip())
+		return nil, status.Error(codes.Internal, "Failed to deactivate user")
 	}
 
-	query += " ORDER BY users.nip ASC LIMIT $2 OFFSET $3"
-	params = append(params, limit, offset)
+	log.Info().Msgf("User %d deactivated by %d", req.GetNip(), claims.Nip)
+	return &assetpb.DeactivateUserResponse{
+		Message: "Successfully deactivated user",
+		Code:    "200",
+		Success: true}, nil
+}
 
-	rows, err = db.Query(context.Background(), query, params...)
+// ReactivateUser lets a deactivated user log in again. Deleted users cannot be
+// reactivated.
+func (s *UserService) ReactivateUser(ctx context.Context, req *assetpb.ReactivateUserRequest) (*assetpb.ReactivateUserResponse, error) {
+	claims, err := requireAdmin(ctx)
 	if err != nil {
-		log.Error().Err(err).Msg("Error fetching users")
 		return nil, err
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var user assetpb.User
-		var areaID sql.NullInt32
-		var outletID sql.NullInt32
+	err = s.Users.Reactivate(ctx, req.GetNip())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "No deactivated user with this NIP")
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to reactivate user %d", req.GetNip())
+		return nil, status.Error(codes.Internal, "Failed to reactivate user")
+	}
 
-		err := rows.Scan(&user.Nip, &user.UserFullName, &user.RoleName, &user.UserEmail, &user.UserPassword,
-			&user.RoleId, &areaID, &outletID, &user.RoleName)
+	log.Info().Msgf("User %d reactivated by %d", req.GetNip(), claims.Nip)
+	return &assetpb.ReactivateUserResponse{
+		Message: "Successfully reactivated user",
+		Code:    "200",
+		Success: true}, nil
+}
 
-		if err != nil {
-			log.Error().Err(err).Msg("Error scanning user row")
-			return nil, err
-		}
+// OffboardUser hands the user's open submissions, their submission groups and
+// the notification rules addressed to them to successor_nip, then deactivates
+// the user, all in one transaction.
+func (s *UserService) OffboardUser(ctx context.Context, req *assetpb.OffboardUserRequest) (*assetpb.OffboardUserResponse, error) {
+	claims, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetSuccessorNip() == 0 || req.GetSuccessorNip() == req.GetNip() {
+		return nil, status.Error(codes.InvalidArgument, "successor_nip must name another user")
+	}
+	if req.GetNip() == claims.Nip {
+		return nil, status.Error(codes.FailedPrecondition, "You cannot offboard yourself")
+	}
 
-		// Convert NULL values to default values
-		if areaID.Valid {
-			user.AreaId = areaID.Int32
-		} else {
-			user.AreaId = 0
-		}
+	result, err := s.Users.Offboard(ctx, req.GetNip(), req.GetSuccessorNip(), s.ClosedSubmissionStatuses)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	if errors.Is(err, repository.ErrInvalidSuccessor) {
+		return nil, status.Error(codes.FailedPrecondition, "Successor not found or not active")
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to offboard user %d", req.GetNip())
+		return nil, status.Error(codes.Internal, "Failed to offboard user")
+	}
 
-		if outletID.Valid {
-			user.OutletId = outletID.Int32
-		} else {
-			user.OutletId = 0
-		}
+	log.Info().Msgf("User %d offboarded by %d to %d: %d submissions, %d submission groups, %d notification rules",
+		req.GetNip(), claims.Nip, req.GetSuccessorNip(), result.Submissions, result.SubmissionParents, result.NotificationRules)
+	return &assetpb.OffboardUserResponse{
+		Message:           "Successfully offboarded user",
+		Code:              "200",
+		Success:           true,
+		Submissions:       result.Submissions,
+		SubmissionParents: result.SubmissionParents,
+		NotificationRules: result.NotificationRules}, nil
+}
+
+// ImportUsers creates, updates and optionally deactivates users from a
+// spreadsheet. Without commit it only reports the changes; with it they are
+// applied in one transaction, and only if no row has errors.
+func (s *UserService) ImportUsers(ctx context.Context, req *assetpb.ImportUsersRequest) (*assetpb.ImportUsersResponse, error) {
+	claims, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.Importer == nil {
+		return nil, status.Error(codes.Unavailable, "User import is not available")
+	}
 
-		users = append(users, &user)
+	rows, err := userimport.ReadRows(req.GetFile(), req.GetFormat())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result, err := s.Importer.Import(ctx, rows, userimport.Options{
+		Commit:            req.GetCommit(),
+		DeactivateMissing: req.GetDeactivateMissing(),
+		Actor:             claims.Nip,
+	})
+	if errors.Is(err, userimport.ErrInvalidFile) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to import users")
+		return nil, status.Error(codes.Internal, "Failed to import users")
+	}
+
+	resp := &assetpb.ImportUsersResponse{
+		Message:     "Dry run; nothing was changed",
+		Code:        "200",
+		Success:     true,
+		Committed:   result.Committed,
+		Created:     int32(result.Count(userimport.ActionCreate)),
+		Updated:     int32(result.Count(userimport.ActionUpdate)),
+		Deactivated: int32(result.Count(userimport.ActionDeactivate)),
+		Unchanged:   int32(result.Unchanged),
+	}
+	for _, change := range result.Changes {
+		resp.Changes = append(resp.Changes, &assetpb.UserImportChange{
+			Action:          change.Action,
+			Nip:             change.Nip,
+			UserFullName:    change.Name,
+			Fields:          change.Fields,
+			InitialPassword: change.Password,
+		})
+	}
+	for _, rowErr := range result.Errors {
+		resp.Errors = append(resp.Errors, &assetpb.UserImportError{Row: int32(rowErr.Row), Message: rowErr.Message})
 	}
-	return users, nil
+	switch {
+	case len(result.Errors) > 0:
+		resp.Message, resp.Code, resp.Success = "The file has errors; nothing was changed", "400", false
+	case result.Committed:
+		resp.Message = "Successfully imported users"
+		log.Info().Msgf("User import by %d: %d created, %d updated, %d deactivated",
+			claims.Nip, resp.Created, resp.Updated, resp.Deactivated)
+	}
+	return resp, nil
 }
@@ -0,0 +1,167 @@
+package userimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	testWorkbook = `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+    xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+  <sheets><sheet name="Users" sheetId="1" r:id="rId1"/><sheet name="Other" sheetId="2" r:id="rId2"/></sheets>
+</workbook>`
+	testRels = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId2" Target="worksheets/sheet1.xml"/>
+  <Relationship Id="rId1" Target="worksheets/users.xml"/>
+</Relationships>`
+	testSharedStrings = `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <si><t>nip</t></si>
+  <si><t>name</t></si>
+  <si><r><t>Budi </t></r><r><t>Santoso</t></r></si>
+</sst>`
+)
+
+// testXLSX zips the given parts into a workbook.
+func testXLSX(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range parts {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// testSheet wraps rows in a worksheet.
+func testSheet(rows string) string {
+	return `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` + rows + `</sheetData></worksheet>`
+}
+
+func TestReadXLSX(t *testing.T) {
+	tests := []struct {
+		name  string
+		sheet string
+		want  [][]string
+	}{
+		{"shared strings",
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+             <row r="2"><c r="A2"><v>1001</v></c><c r="B2" t="s"><v>2</v></c></row>`,
+			[][]string{{"nip", "name"}, {"1001", "Budi Santoso"}}},
+		{"inline strings",
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>Ani</t></is></c><c r="B1" t="inlineStr"><is><r><t>Rich </t></r><r><t>text</t></r></is></c></row>`,
+			[][]string{{"Ani", "Rich text"}}},
+		{"column gaps",
+			`<row r="1"><c r="A1"><v>1</v></c><c r="D1"><v>4</v></c></row>`,
+			[][]string{{"1", "", "", "4"}}},
+		{"cells without references",
+			`<row><c><v>1</v></c><c><v>2</v></c></row>`,
+			[][]string{{"1", "2"}}},
+		{"row gaps",
+			`<row r="1"><c r="A1"><v>1</v></c></row><row r="4"><c r="B4"><v>2</v></c></row>`,
+			[][]string{{"1"}, nil, nil, {"", "2"}}},
+		{"columns past the limit",
+			`<row r="1"><c r="A1"><v>1</v></c><c r="ZZZ1"><v>2</v></c></row>`,
+			[][]string{{"1"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testXLSX(t, map[string]string{
+				"xl/workbook.xml":            testWorkbook,
+				"xl/_rels/workbook.xml.rels": testRels,
+				"xl/sharedStrings.xml":       testSharedStrings,
+				"xl/worksheets/users.xml":    testSheet(tt.sheet),
+				"xl/worksheets/sheet1.xml":   testSheet(`<row r="1"><c r="A1"><v>wrong sheet</v></c></row>`),
+			})
+			rows, err := ReadRows(data, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(rows, tt.want) {
+				t.Errorf("rows = %q, want %q", rows, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadXLSXEmptyCells(t *testing.T) {
+	data := testXLSX(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testSheet(`<row r="1"><c r="A1"/><c r="B1" t="inlineStr"><is/></c><c r="C1"><v>3</v></c></row>`),
+	})
+	rows, err := ReadRows(data, FormatXLSX)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]string{{"", "", "3"}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows = %q, want %q", rows, want)
+	}
+}
+
+func TestReadXLSXErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		parts   map[string]string
+		wantErr string
+	}{
+		{"no worksheet", map[string]string{"xl/workbook.xml": testWorkbook}, "no worksheet"},
+		{"missing shared string", map[string]string{
+			"xl/sharedStrings.xml":     testSharedStrings,
+			"xl/worksheets/sheet1.xml": testSheet(`<row r="1"><c r="A1" t="s"><v>3</v></c></row>`),
+		}, "missing string"},
+		{"no shared strings", map[string]string{
+			"xl/worksheets/sheet1.xml": testSheet(`<row r="1"><c r="A1" t="s"><v>0</v></c></row>`),
+		}, "missing string"},
+		{"broken XML", map[string]string{"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row>`}, "sheet1.xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadRows(testXLSX(t, tt.parts), FormatXLSX)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want one mentioning %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := ReadRows([]byte("PK\x03\x04 truncated"), ""); err == nil {
+		t.Error("truncated archive: expected an error")
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want [][]string
+	}{
+		{"commas", "nip,name\n1001,Budi\n", [][]string{{"nip", "name"}, {"1001", "Budi"}}},
+		{"semicolons", "nip;name\n1001;Budi, S.\n", [][]string{{"nip", "name"}, {"1001", "Budi, S."}}},
+		{"byte order mark", "\xef\xbb\xbfnip,name\n", [][]string{{"nip", "name"}}},
+		{"ragged rows", "nip,name,email\n1001, Budi\n", [][]string{{"nip", "name", "email"}, {"1001", "Budi"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ReadRows([]byte(tt.data), "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(rows, tt.want) {
+				t.Errorf("rows = %q, want %q", rows, tt.want)
+			}
+		})
+	}
+
+	if _, err := ReadRows([]byte("nip"), "ods"); err == nil {
+		t.Error("unsupported format: expected an error")
+	}
+}
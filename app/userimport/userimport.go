@@ -0,0 +1,530 @@
+// Package userimport creates, updates and deactivates users in bulk from an
+// HR spreadsheet, with a preview of the changes before they are applied.
+package userimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/mail"
+	"sort"
+	"strconv"
+	"strings"
+
+	"asset-management-api/app/repository"
+	"asset-management-api/app/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Actions of a Change.
+const (
+	ActionCreate     = "create"
+	ActionUpdate     = "update"
+	ActionDeactivate = "deactivate"
+)
+
+// maxRows bounds the users in one file.
+const maxRows = 5000
+
+// ErrInvalidFile means the file as a whole cannot be imported, e.g. because
+// a column is missing. Problems with single rows are reported in
+// Result.Errors instead.
+var ErrInvalidFile = errors.New("invalid user file")
+
+// Options control an import.
+type Options struct {
+	// Commit applies the changes; without it Import only reports them
+	Commit bool
+	// DeactivateMissing deactivates the active users of the file's outlets
+	// who are not in it
+	DeactivateMissing bool
+	// Actor is the user running the import, who is never deactivated by it
+	Actor int32
+}
+
+// Change is what an import does to one user.
+type Change struct {
+	Action string
+	Nip    int32
+	Name   string
+	// Fields lists what an update changes; status means reactivation
+	Fields []string
+	// Password is the initial password of a created user, set once committed
+	Password string
+}
+
+// RowError is a problem with one row of the file, numbered from 1 with the
+// header as row 1.
+type RowError struct {
+	Row     int
+	Message string
+}
+
+// Result is the outcome of an import. Nothing is committed while there are
+// errors.
+type Result struct {
+	Changes   []Change
+	Errors    []RowError
+	Unchanged int
+	Committed bool
+}
+
+// Count returns the number of changes with action.
+func (r *Result) Count(action string) int {
+	n := 0
+	for _, c := range r.Changes {
+		if c.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// beginner is implemented by *pgxpool.Pool.
+type beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Importer imports users into the database.
+type Importer struct {
+	DB beginner
+}
+
+func NewImporter(db *pgxpool.Pool) *Importer {
+	return &Importer{DB: db}
+}
+
+// Columns of the file, by their normalized header names.
+const (
+	columnNip    = "nip"
+	columnName   = "name"
+	columnEmail  = "email"
+	columnRole   = "role"
+	columnArea   = "area"
+	columnOutlet = "outlet"
+)
+
+var headerNames = map[string]string{
+	"nip":            columnNip,
+	"name":           columnName,
+	"full name":      columnName,
+	"user full name": columnName,
+	"email":          columnEmail,
+	"user email":     columnEmail,
+	"role":           columnRole,
+	"role name":      columnRole,
+	"area":           columnArea,
+	"area name":      columnArea,
+	"outlet":         columnOutlet,
+	"outlet name":    columnOutlet,
+}
+
+// record is a row of the file with its names resolved.
+type record struct {
+	row      int
+	nip      int32
+	name     string
+	email    string
+	roleId   int32
+	areaId   int32
+	outletId int32
+}
+
+// user is the current state of an existing user.
+type user struct {
+	name     string
+	email    string
+	roleId   int32
+	areaId   int32
+	outletId int32
+	status   string
+}
+
+// Import compares the users in rows, the cells of a spreadsheet whose first
+// row names the columns, with the database and applies the differences if
+// opts.Commit is set and no row has errors. Users missing from the file are
+// left alone unless opts.DeactivateMissing is set.
+func (i *Importer) Import(ctx context.Context, rows [][]string, opts Options) (*Result, error) {
+	columns, err := parseHeader(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := i.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if opts.Commit {
+		// The diff must still hold when it is applied
+		if _, err := tx.Exec(ctx, "LOCK TABLE users IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+			return nil, err
+		}
+	}
+	refs, err := loadReferences(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := loadUsers(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	var records []record
+	seen := map[int32]int{}
+	for n, cells := range rows[1:] {
+		if blank(cells) {
+			continue
+		}
+		rec, problem := refs.parse(columns, cells, n+2)
+		if problem == "" {
+			if first, ok := seen[rec.nip]; ok {
+				problem = fmt.Sprintf("NIP %d is already on row %d", rec.nip, first)
+			}
+		}
+		if problem != "" {
+			result.Errors = append(result.Errors, RowError{Row: n + 2, Message: problem})
+			continue
+		}
+		seen[rec.nip] = rec.row
+		records = append(records, rec)
+	}
+
+	for _, rec := range records {
+		old, ok := users[rec.nip]
+		if !ok {
+			result.Changes = append(result.Changes, Change{Action: ActionCreate, Nip: rec.nip, Name: rec.name})
+			continue
+		}
+		if old.status == repository.UserDeleted {
+			result.Errors = append(result.Errors, RowError{Row: rec.row, Message: fmt.Sprintf("NIP %d belongs to a deleted user", rec.nip)})
+			continue
+		}
+		// Optional columns left out of the file keep their values, except
+		// for an area that follows from the outlet
+		if _, ok := columns[columnEmail]; !ok {
+			rec.email = old.email
+		}
+		if _, ok := columns[columnArea]; !ok && rec.areaId == 0 {
+			rec.areaId = old.areaId
+		}
+		if _, ok := columns[columnOutlet]; !ok {
+			rec.outletId = old.outletId
+		}
+		fields := diff(old, rec)
+		if len(fields) == 0 {
+			result.Unchanged++
+			continue
+		}
+		result.Changes = append(result.Changes, Change{Action: ActionUpdate, Nip: rec.nip, Name: rec.name, Fields: fields})
+	}
+
+	if opts.DeactivateMissing {
+		result.Changes = append(result.Changes, missing(users, records, opts.Actor)...)
+	}
+
+	if !opts.Commit || len(result.Errors) > 0 {
+		return result, nil
+	}
+	if err := apply(ctx, tx, records, result.Changes); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	result.Committed = true
+	return result, nil
+}
+
+func parseHeader(rows [][]string) (map[string]int, error) {
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: the file is empty", ErrInvalidFile)
+	}
+	if len(rows)-1 > maxRows {
+		return nil, fmt.Errorf("%w: more than %d users", ErrInvalidFile, maxRows)
+	}
+	columns := map[string]int{}
+	for i, cell := range rows[0] {
+		header := strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(cell, "_", " "))), " ")
+		if column, ok := headerNames[header]; ok {
+			if _, dup := columns[column]; dup {
+				return nil, fmt.Errorf("%w: column %q appears twice", ErrInvalidFile, column)
+			}
+			columns[column] = i
+		}
+	}
+	for _, required := range []string{columnNip, columnName, columnRole} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: the header row has no %s column", ErrInvalidFile, required)
+		}
+	}
+	return columns, nil
+}
+
+func blank(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// names maps normalized names to the ids carrying them.
+type names map[string][]int32
+
+func normalize(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func (n names) resolve(kind, name string) (int32, string) {
+	ids := n[normalize(name)]
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Sprintf("unknown %s %q", kind, name)
+	case 1:
+		return ids[0], ""
+	default:
+		return 0, fmt.Sprintf("%s %q is ambiguous", kind, name)
+	}
+}
+
+// references are the roles, areas and outlets rows may name.
+type references struct {
+	roles       names
+	areas       names
+	outlets     names
+	outletAreas map[int32]int32
+}
+
+func loadReferences(ctx context.Context, tx pgx.Tx) (*references, error) {
+	refs := &references{outletAreas: map[int32]int32{}}
+	var err error
+	if refs.roles, err = loadNames(ctx, tx, "SELECT role_id, role_name FROM roles"); err != nil {
+		return nil, err
+	}
+	if refs.areas, err = loadNames(ctx, tx, "SELECT area_id, area_name FROM areas"); err != nil {
+		return nil, err
+	}
+	if refs.outlets, err = loadNames(ctx, tx, "SELECT outlet_id, outlet_name FROM outlets"); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, "SELECT outlet_id, area_id FROM area_outlets")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var outletId, areaId int32
+		if err := rows.Scan(&outletId, &areaId); err != nil {
+			return nil, err
+		}
+		refs.outletAreas[outletId] = areaId
+	}
+	return refs, rows.Err()
+}
+
+func loadNames(ctx context.Context, tx pgx.Tx, query string) (names, error) {
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	n := names{}
+	for rows.Next() {
+		var id int32
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		n[normalize(name)] = append(n[normalize(name)], id)
+	}
+	return n, rows.Err()
+}
+
+func loadUsers(ctx context.Context, tx pgx.Tx) (map[int32]user, error) {
+	rows, err := tx.Query(ctx, `
+        SELECT nip, user_full_name, COALESCE(user_email, ''), role_id,
+               COALESCE(area_id, 0), COALESCE(outlet_id, 0), status
+        FROM users`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := map[int32]user{}
+	for rows.Next() {
+		var nip int32
+		var u user
+		if err := rows.Scan(&nip, &u.name, &u.email, &u.roleId, &u.areaId, &u.outletId, &u.status); err != nil {
+			return nil, err
+		}
+		users[nip] = u
+	}
+	return users, rows.Err()
+}
+
+// parse reads and resolves one row, or returns what is wrong with it.
+func (refs *references) parse(columns map[string]int, cells []string, row int) (record, string) {
+	cell := func(column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(cells) {
+			return ""
+		}
+		return strings.TrimSpace(cells[i])
+	}
+
+	rec := record{row: row, name: cell(columnName), email: cell(columnEmail)}
+	nip, ok := parseNip(cell(columnNip))
+	if !ok {
+		return rec, fmt.Sprintf("invalid NIP %q", cell(columnNip))
+	}
+	rec.nip = nip
+	if rec.name == "" || len(rec.name) > 255 {
+		return rec, "name must be 1 to 255 characters"
+	}
+	if rec.email != "" {
+		addr, err := mail.ParseAddress(rec.email)
+		if err != nil || addr.Address != rec.email || len(rec.email) > 255 {
+			return rec, fmt.Sprintf("invalid email %q", rec.email)
+		}
+	}
+
+	var problem string
+	if cell(columnRole) == "" {
+		return rec, "role is required"
+	}
+	if rec.roleId, problem = refs.roles.resolve("role", cell(columnRole)); problem != "" {
+		return rec, problem
+	}
+	if name := cell(columnArea); name != "" {
+		if rec.areaId, problem = refs.areas.resolve("area", name); problem != "" {
+			return rec, problem
+		}
+	}
+	if name := cell(columnOutlet); name != "" {
+		if rec.outletId, problem = refs.outlets.resolve("outlet", name); problem != "" {
+			return rec, problem
+		}
+		// Every outlet belongs to one area
+		areaId := refs.outletAreas[rec.outletId]
+		if rec.areaId != 0 && areaId != rec.areaId {
+			return rec, fmt.Sprintf("outlet %q is not in area %q", name, cell(columnArea))
+		}
+		if areaId != 0 {
+			rec.areaId = areaId
+		}
+	}
+	return rec, ""
+}
+
+// parseNip accepts whole numbers, also as spreadsheets store them, e.g. 1001.0.
+func parseNip(s string) (int32, bool) {
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		return int32(n), n > 0
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f != math.Trunc(f) || f <= 0 || f > math.MaxInt32 {
+		return 0, false
+	}
+	return int32(f), true
+}
+
+func diff(old user, rec record) []string {
+	var fields []string
+	if old.name != rec.name {
+		fields = append(fields, "name")
+	}
+	if old.email != rec.email {
+		fields = append(fields, "email")
+	}
+	if old.roleId != rec.roleId {
+		fields = append(fields, "role")
+	}
+	if old.areaId != rec.areaId {
+		fields = append(fields, "area")
+	}
+	if old.outletId != rec.outletId {
+		fields = append(fields, "outlet")
+	}
+	if old.status != repository.UserActive {
+		fields = append(fields, "status")
+	}
+	return fields
+}
+
+// missing returns the deactivations of the active users of the file's
+// outlets who are not in it.
+func missing(users map[int32]user, records []record, actor int32) []Change {
+	outlets := map[int32]bool{}
+	inFile := map[int32]bool{}
+	for _, rec := range records {
+		inFile[rec.nip] = true
+		if rec.outletId != 0 {
+			outlets[rec.outletId] = true
+		}
+	}
+
+	var changes []Change
+	for nip, u := range users {
+		if u.status == repository.UserActive && outlets[u.outletId] && !inFile[nip] && nip != actor {
+			changes = append(changes, Change{Action: ActionDeactivate, Nip: nip, Name: u.name})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Nip < changes[j].Nip })
+	return changes
+}
+
+// apply writes the changes. Created users get a generated password they
+// must change when they first log in.
+func apply(ctx context.Context, tx pgx.Tx, records []record, changes []Change) error {
+	byNip := make(map[int32]record, len(records))
+	for _, rec := range records {
+		byNip[rec.nip] = rec
+	}
+
+	for i := range changes {
+		change := &changes[i]
+		rec := byNip[change.Nip]
+		switch change.Action {
+		case ActionCreate:
+			password, err := utils.GeneratePassword()
+			if err != nil {
+				return err
+			}
+			hash, err := utils.HashPassword(password)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(ctx, `
+                INSERT INTO users (nip, user_full_name, user_email, user_password, role_id, area_id, outlet_id, must_change_password)
+                VALUES ($1, $2, NULLIF($3, ''), $4, $5, NULLIF($6, 0), NULLIF($7, 0), TRUE)`,
+				rec.nip, rec.name, rec.email, hash, rec.roleId, rec.areaId, rec.outletId)
+			if err != nil {
+				return fmt.Errorf("create user %d: %w", rec.nip, err)
+			}
+			change.Password = password
+		case ActionUpdate:
+			_, err := tx.Exec(ctx, `
+                UPDATE users SET user_full_name = $2, user_email = NULLIF($3, ''), role_id = $4,
+                       area_id = NULLIF($5, 0), outlet_id = NULLIF($6, 0), status = 'active', disabled_at = NULL
+                WHERE nip = $1`,
+				rec.nip, rec.name, rec.email, rec.roleId, rec.areaId, rec.outletId)
+			if err != nil {
+				return fmt.Errorf("update user %d: %w", rec.nip, err)
+			}
+		case ActionDeactivate:
+			if err := repository.DeactivateUser(ctx, tx, change.Nip, repository.UserDeactivated); err != nil {
+				return fmt.Errorf("deactivate user %d: %w", change.Nip, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package userimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"asset-management-api/app/repository"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeDB serves the reference data and users to an import and records what
+// it writes.
+type fakeDB struct {
+	tables map[string][][]interface{}
+	users  map[int32]user
+
+	execs     []string
+	committed bool
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		tables: map[string][][]interface{}{
+			"roles":        {{int32(1), "Admin"}, {int32(6), "Outlet"}},
+			"areas":        {{int32(10), "Jakarta"}, {int32(11), "Bandung"}},
+			"outlets":      {{int32(100), "Kemang"}, {int32(101), "Dago"}},
+			"area_outlets": {{int32(100), int32(10)}, {int32(101), int32(11)}},
+		},
+		users: map[int32]user{
+			1001: {name: "Budi Santoso", email: "budi@example.com", roleId: 6, areaId: 10, outletId: 100, status: repository.UserActive},
+			1002: {name: "Ani", roleId: 6, areaId: 10, outletId: 100, status: repository.UserActive},
+			1003: {name: "Citra", roleId: 6, areaId: 10, outletId: 100, status: repository.UserDeactivated},
+			1004: {name: "Dewi", roleId: 6, areaId: 10, outletId: 100, status: repository.UserActive},
+			1005: {name: "Eko", roleId: 6, areaId: 11, outletId: 101, status: repository.UserActive},
+			1006: {name: "Fajar", roleId: 6, status: repository.UserDeleted},
+		},
+	}
+}
+
+func (db *fakeDB) Begin(ctx context.Context) (pgx.Tx, error) {
+	return &fakeTx{db: db}, nil
+}
+
+// writes returns the statements that changed data, by their first words.
+func (db *fakeDB) writes() []string {
+	var writes []string
+	for _, sql := range db.execs {
+		if !strings.HasPrefix(sql, "LOCK") {
+			writes = append(writes, sql)
+		}
+	}
+	return writes
+}
+
+type fakeTx struct {
+	pgx.Tx
+	db *fakeDB
+}
+
+func (tx *fakeTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	fields := strings.Fields(sql)
+	statement := strings.Join(fields[:2], " ")
+	if len(args) > 0 {
+		statement += fmt.Sprintf(" %v", args[0])
+	}
+	tx.db.execs = append(tx.db.execs, statement)
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (tx *fakeTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	if strings.Contains(sql, "FROM users") {
+		var rows [][]interface{}
+		for nip, u := range tx.db.users {
+			rows = append(rows, []interface{}{nip, u.name, u.email, u.roleId, u.areaId, u.outletId, u.status})
+		}
+		return &fakeRows{rows: rows}, nil
+	}
+	fields := strings.Fields(sql)
+	return &fakeRows{rows: tx.db.tables[fields[len(fields)-1]]}, nil
+}
+
+func (tx *fakeTx) Commit(ctx context.Context) error {
+	tx.db.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback(ctx context.Context) error {
+	return nil
+}
+
+type fakeRows struct {
+	pgx.Rows
+	rows [][]interface{}
+	next int
+}
+
+func (r *fakeRows) Next() bool {
+	r.next++
+	return r.next <= len(r.rows)
+}
+
+func (r *fakeRows) Scan(dest ...interface{}) error {
+	row := r.rows[r.next-1]
+	if len(dest) != len(row) {
+		return errors.New("wrong number of columns")
+	}
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(row[i]))
+	}
+	return nil
+}
+
+func (r *fakeRows) Err() error { return nil }
+func (r *fakeRows) Close()     {}
+
+var testRows = [][]string{
+	{"NIP", "Full Name", "Email", "Role", "Outlet"},
+	// unchanged
+	{"1001", "Budi Santoso", "budi@example.com", "outlet", "kemang"},
+	// renamed and moved
+	{"1002.0", "Ani Lestari", "", "Outlet", "Dago"},
+	// reactivated
+	{"1003", "Citra", "", "Outlet", "Kemang"},
+	// new
+	{"2001", "Gita", "gita@example.com", "Outlet", "Kemang"},
+	{},
+}
+
+func TestImportDryRunAndCommit(t *testing.T) {
+	opts := Options{DeactivateMissing: true, Actor: 1}
+	wantChanges := []Change{
+		{Action: ActionUpdate, Nip: 1002, Name: "Ani Lestari", Fields: []string{"name", "area", "outlet"}},
+		{Action: ActionUpdate, Nip: 1003, Name: "Citra", Fields: []string{"status"}},
+		{Action: ActionCreate, Nip: 2001, Name: "Gita"},
+		// 1005's outlet Dago is in the file through 1002
+		{Action: ActionDeactivate, Nip: 1004, Name: "Dewi"},
+		{Action: ActionDeactivate, Nip: 1005, Name: "Eko"},
+	}
+
+	db := newFakeDB()
+	preview, err := (&Importer{DB: db}).Import(context.Background(), testRows, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(preview.Changes, wantChanges) || preview.Unchanged != 1 || len(preview.Errors) != 0 {
+		t.Errorf("dry run = %+v, want changes %+v and 1 unchanged", preview, wantChanges)
+	}
+	if preview.Committed || db.committed || len(db.execs) != 0 {
+		t.Errorf("dry run wrote %q, committed %v", db.execs, db.committed)
+	}
+
+	opts.Commit = true
+	result, err := (&Importer{DB: db}).Import(context.Background(), testRows, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Committed || !db.committed {
+		t.Fatal("commit did not commit")
+	}
+	if result.Changes[2].Password == "" {
+		t.Error("created user has no initial password")
+	}
+	result.Changes[2].Password = ""
+	if !reflect.DeepEqual(result.Changes, preview.Changes) || result.Unchanged != preview.Unchanged {
+		t.Errorf("committed %+v, previewed %+v", result, preview)
+	}
+	if db.execs[0] != "LOCK TABLE" {
+		t.Errorf("first statement = %q, want the users table locked", db.execs[0])
+	}
+	wantWrites := []string{
+		"UPDATE users 1002",
+		"UPDATE users 1003",
+		"INSERT INTO 2001",
+		"UPDATE users 1004", "UPDATE sessions 1004", "UPDATE refresh_tokens 1004",
+		"UPDATE users 1005", "UPDATE sessions 1005", "UPDATE refresh_tokens 1005",
+	}
+	if !reflect.DeepEqual(db.writes(), wantWrites) {
+		t.Errorf("writes = %q, want %q", db.writes(), wantWrites)
+	}
+}
+
+func TestImportRowErrors(t *testing.T) {
+	rows := [][]string{
+		{"nip", "name", "email", "role", "area", "outlet"},
+		{"1001", "Budi Santoso", "budi@example.com", "Outlet", "", "Kemang"},
+		{"abc", "Bad NIP", "", "Outlet", "", ""},
+		{"2001", "", "", "Outlet", "", ""},
+		{"2002", "Bad Email", "not an address", "Outlet", "", ""},
+		{"2003", "No Role", "", "", "", ""},
+		{"2004", "Unknown Role", "", "Cashier", "", ""},
+		{"2005", "Wrong Area", "", "Outlet", "Bandung", "Kemang"},
+		{"1001", "Budi Again", "", "Outlet", "", ""},
+		{"1006", "Fajar", "", "Outlet", "", ""},
+	}
+	wantErrors := []RowError{
+		{3, `invalid NIP "abc"`},
+		{4, "name must be 1 to 255 characters"},
+		{5, `invalid email "not an address"`},
+		{6, "role is required"},
+		{7, `unknown role "Cashier"`},
+		{8, `outlet "Kemang" is not in area "Bandung"`},
+		{9, "NIP 1001 is already on row 2"},
+		{10, "NIP 1006 belongs to a deleted user"},
+	}
+
+	db := newFakeDB()
+	result, err := (&Importer{DB: db}).Import(context.Background(), rows, Options{Commit: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result.Errors, wantErrors) {
+		t.Errorf("errors = %+v, want %+v", result.Errors, wantErrors)
+	}
+	if result.Committed || db.committed || len(db.writes()) != 0 {
+		t.Errorf("file with errors was applied: writes %q", db.writes())
+	}
+}
+
+func TestImportInvalidFile(t *testing.T) {
+	tooMany := make([][]string, maxRows+2)
+	tooMany[0] = []string{"nip", "name", "role"}
+	tests := []struct {
+		name string
+		rows [][]string
+	}{
+		{"empty", nil},
+		{"no role column", [][]string{{"nip", "name"}}},
+		{"duplicate column", [][]string{{"nip", "name", "full_name", "role"}}},
+		{"too many rows", tooMany},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&Importer{DB: newFakeDB()}).Import(context.Background(), tt.rows, Options{})
+			if !errors.Is(err, ErrInvalidFile) {
+				t.Errorf("err = %v, want ErrInvalidFile", err)
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package utils
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"crypto/rand"
+	"math/big"
+
+	"golang.org/x/crypto/bcrypt"
+)
 
 // HashPassword hashes a plaintext password
 func HashPassword(password string) (string, error) {
@@ -15,3 +20,53 @@ func HashPassword(password string) (string, error) {
 func VerifyPassword(hashedPassword, plainPassword string) error {
 	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(plainPassword))
 }
+
+// Character sets of generated passwords, without look-alikes such as 0/O and
+// 1/l/I.
+var passwordClasses = []string{
+	"abcdefghijkmnopqrstuvwxyz",
+	"ABCDEFGHJKLMNPQRSTUVWXYZ",
+	"23456789",
+	"!#%+-=?@",
+}
+
+// GeneratedPasswordLength is the length of GeneratePassword's passwords
+const GeneratedPasswordLength = 16
+
+// GeneratePassword returns a random password with characters of every class,
+// for accounts created without one.
+func GeneratePassword() (string, error) {
+	var all string
+	for _, class := range passwordClasses {
+		all += class
+	}
+	password := make([]byte, GeneratedPasswordLength)
+	for i := range password {
+		// One character of each class, the rest from all of them
+		set := all
+		if i < len(passwordClasses) {
+			set = passwordClasses[i]
+		}
+		c, err := randomIndex(len(set))
+		if err != nil {
+			return "", err
+		}
+		password[i] = set[c]
+	}
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+	return string(password), nil
+}
+
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(i.Int64()), nil
+}
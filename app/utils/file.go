@@ -1,21 +1,54 @@
 package utils
 
 import (
+	"asset-management-api/app/config"
+	"context"
+	"fmt"
 	"io"
 	"log"
 	"net/http"
-	"os"
-
-	"github.com/joho/godotenv"
 )
 
-var _ = godotenv.Load(".env")
-var NC_API_ENDPOINT = os.Getenv("NEXTCLOUD_API_ENDPOINT")
-var NC_ASSET_PATH = os.Getenv("NEXTCLOUD_ASSET_PATH")
-var NC_USERNAME = os.Getenv("NEXTCLOUD_USERNAME")
-var NC_PASSWORD = os.Getenv("NEXTCLOUD_PASSWORD")
+// Nextcloud stores uploaded files under AssetPath on a Nextcloud WebDAV endpoint.
+type Nextcloud struct {
+	APIEndpoint string
+	AssetPath   string
+	Username    string
+	Password    string
+}
+
+func NewNextcloud(cfg config.Nextcloud) *Nextcloud {
+	return &Nextcloud{
+		APIEndpoint: cfg.APIEndpoint,
+		AssetPath:   cfg.AssetPath,
+		Username:    cfg.Username,
+		Password:    cfg.Password,
+	}
+}
+
+// Ping checks that the asset folder can be listed with the configured
+// credentials.
+func (nc *Nextcloud) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "PROPFIND", nc.APIEndpoint+nc.AssetPath, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(nc.Username, nc.Password)
+	req.Header.Set("Depth", "0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMultiStatus && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("nextcloud responded %s", resp.Status)
+	}
+	return nil
+}
 
-func UploadFile(w http.ResponseWriter, r *http.Request, module string) (filePath *string, err error) {
+func (nc *Nextcloud) UploadFile(w http.ResponseWriter, r *http.Request, module string) (filePath *string, err error) {
 
 	// Parse the multipart form
 	err = r.ParseMultipartForm(10 << 20) // Max memory 10MB
@@ -38,14 +71,14 @@ func UploadFile(w http.ResponseWriter, r *http.Request, module string) (filePath
 	client := &http.Client{}
 
 	// Set the API endpoint and credentials
-	apiEndpoint := NC_API_ENDPOINT + NC_ASSET_PATH + module + "/" + handler.Filename
+	apiEndpoint := nc.APIEndpoint + nc.AssetPath + module + "/" + handler.Filename
 	req, err := http.NewRequest("PUT", apiEndpoint, file)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		log.Default().Println(err)
 		return nil, err
 	}
-	req.SetBasicAuth(NC_USERNAME, NC_PASSWORD)
+	req.SetBasicAuth(nc.Username, nc.Password)
 	req.Header.Set("Content-Type", handler.Header.Get("Content-Type"))
 
 	// Send the request
@@ -69,20 +102,20 @@ func UploadFile(w http.ResponseWriter, r *http.Request, module string) (filePath
 	return &path, nil
 }
 
-func GetFile(w http.ResponseWriter, r *http.Request, filePath string) ([]byte, error) {
+func (nc *Nextcloud) GetFile(w http.ResponseWriter, r *http.Request, filePath string) ([]byte, error) {
 
 	// Create a new HTTP client
 	client := &http.Client{}
 
 	// Set the API endpoint and credentials
-	apiEndpoint := NC_API_ENDPOINT + NC_ASSET_PATH + filePath
+	apiEndpoint := nc.APIEndpoint + nc.AssetPath + filePath
 	req, err := http.NewRequest("GET", apiEndpoint, nil)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		log.Default().Println(err)
 		return nil, err
 	}
-	req.SetBasicAuth(NC_USERNAME, NC_PASSWORD)
+	req.SetBasicAuth(nc.Username, nc.Password)
 
 	// Send the request
 	resp, err := client.Do(req)
@@ -459,6 +459,17 @@ const (
 	NOTIFICATIONService_InsertNotificationsForAllAssets_FullMethodName = "/asset.NOTIFICATIONService/InsertNotificationsForAllAssets"
 	NOTIFICATIONService_GetListNotification_FullMethodName             = "/asset.NOTIFICATIONService/GetListNotification"
 	NOTIFICATIONService_GetNotification_FullMethodName                 = "/asset.NOTIFICATIONService/GetNotification"
+	NOTIFICATIONService_MarkNotificationRead_FullMethodName            = "/asset.NOTIFICATIONService/MarkNotificationRead"
+	NOTIFICATIONService_MarkAllNotificationsRead_FullMethodName        = "/asset.NOTIFICATIONService/MarkAllNotificationsRead"
+	NOTIFICATIONService_DismissNotification_FullMethodName             = "/asset.NOTIFICATIONService/DismissNotification"
+	NOTIFICATIONService_SnoozeNotification_FullMethodName              = "/asset.NOTIFICATIONService/SnoozeNotification"
+	NOTIFICATIONService_GetUnreadNotificationCount_FullMethodName      = "/asset.NOTIFICATIONService/GetUnreadNotificationCount"
+	NOTIFICATIONService_GetChannelPreferences_FullMethodName           = "/asset.NOTIFICATIONService/GetChannelPreferences"
+	NOTIFICATIONService_UpdateChannelPreferences_FullMethodName        = "/asset.NOTIFICATIONService/UpdateChannelPreferences"
+	NOTIFICATIONService_GetDigestSettings_FullMethodName               = "/asset.NOTIFICATIONService/GetDigestSettings"
+	NOTIFICATIONService_UpdateDigestSettings_FullMethodName            = "/asset.NOTIFICATIONService/UpdateDigestSettings"
+	NOTIFICATIONService_UnsubscribeDigest_FullMethodName               = "/asset.NOTIFICATIONService/UnsubscribeDigest"
+	NOTIFICATIONService_StreamNotifications_FullMethodName             = "/asset.NOTIFICATIONService/StreamNotifications"
 )
 
 // NOTIFICATIONServiceClient is the client API for NOTIFICATIONService service.
@@ -469,6 +480,17 @@ type NOTIFICATIONServiceClient interface {
 	InsertNotificationsForAllAssets(ctx context.Context, in *InsertAllRequest, opts ...grpc.CallOption) (*InsertAllResponse, error)
 	GetListNotification(ctx context.Context, in *GetListNotificationRequest, opts ...grpc.CallOption) (*GetListNotificationResponse, error)
 	GetNotification(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*GetNotificationsResponse, error)
+	MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*MarkNotificationReadResponse, error)
+	MarkAllNotificationsRead(ctx context.Context, in *MarkAllNotificationsReadRequest, opts ...grpc.CallOption) (*MarkAllNotificationsReadResponse, error)
+	DismissNotification(ctx context.Context, in *DismissNotificationRequest, opts ...grpc.CallOption) (*DismissNotificationResponse, error)
+	SnoozeNotification(ctx context.Context, in *SnoozeNotificationRequest, opts ...grpc.CallOption) (*SnoozeNotificationResponse, error)
+	GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountRequest, opts ...grpc.CallOption) (*GetUnreadNotificationCountResponse, error)
+	GetChannelPreferences(ctx context.Context, in *GetChannelPreferencesRequest, opts ...grpc.CallOption) (*GetChannelPreferencesResponse, error)
+	UpdateChannelPreferences(ctx context.Context, in *UpdateChannelPreferencesRequest, opts ...grpc.CallOption) (*UpdateChannelPreferencesResponse, error)
+	GetDigestSettings(ctx context.Context, in *GetDigestSettingsRequest, opts ...grpc.CallOption) (*GetDigestSettingsResponse, error)
+	UpdateDigestSettings(ctx context.Context, in *UpdateDigestSettingsRequest, opts ...grpc.CallOption) (*UpdateDigestSettingsResponse, error)
+	UnsubscribeDigest(ctx context.Context, in *UnsubscribeDigestRequest, opts ...grpc.CallOption) (*UnsubscribeDigestResponse, error)
+	StreamNotifications(ctx context.Context, in *StreamNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationEvent], error)
 }
 
 type nOTIFICATIONServiceClient struct {
@@ -519,6 +541,125 @@ func (c *nOTIFICATIONServiceClient) GetNotification(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *nOTIFICATIONServiceClient) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*MarkNotificationReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkNotificationReadResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONService_MarkNotificationRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONServiceClient) MarkAllNotificationsRead(ctx context.Context, in *MarkAllNotificationsReadRequest, opts ...grpc.CallOption) (*MarkAllNotificationsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAllNotificationsReadResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONService_MarkAllNotificationsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONServiceClient) DismissNotification(ctx context.Context, in *DismissNotificationRequest, opts ...grpc.CallOption) (*DismissNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DismissNotificationResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONService_DismissNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONServiceClient) SnoozeNotification(ctx context.Context, in *SnoozeNotificationRequest, opts ...grpc.CallOption) (*SnoozeNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnoozeNotificationResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONService_SnoozeNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONServiceClient) GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountRequest, opts ...grpc.CallOption) (*GetUnreadNotificationCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadNotificationCountResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONService_GetUnreadNotificationCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONServiceClient) GetChannelPreferences(ctx context.Context, in *GetChannelPreferencesRequest, opts ...grpc.CallOption) (*GetChannelPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelPreferencesResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONService_GetChannelPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONServiceClient) UpdateChannelPreferences(ctx context.Context, in *UpdateChannelPreferencesRequest, opts ...grpc.CallOption) (*UpdateChannelPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChannelPreferencesResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONService_UpdateChannelPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONServiceClient) GetDigestSettings(ctx context.Context, in *GetDigestSettingsRequest, opts ...grpc.CallOption) (*GetDigestSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDigestSettingsResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONService_GetDigestSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONServiceClient) UpdateDigestSettings(ctx context.Context, in *UpdateDigestSettingsRequest, opts ...grpc.CallOption) (*UpdateDigestSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDigestSettingsResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONService_UpdateDigestSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONServiceClient) UnsubscribeDigest(ctx context.Context, in *UnsubscribeDigestRequest, opts ...grpc.CallOption) (*UnsubscribeDigestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeDigestResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONService_UnsubscribeDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONServiceClient) StreamNotifications(ctx context.Context, in *StreamNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NOTIFICATIONService_ServiceDesc.Streams[0], NOTIFICATIONService_StreamNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamNotificationsRequest, NotificationEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NOTIFICATIONService_StreamNotificationsClient = grpc.ServerStreamingClient[NotificationEvent]
+
 // NOTIFICATIONServiceServer is the server API for NOTIFICATIONService service.
 // All implementations must embed UnimplementedNOTIFICATIONServiceServer
 // for forward compatibility.
@@ -527,6 +668,17 @@ type NOTIFICATIONServiceServer interface {
 	InsertNotificationsForAllAssets(context.Context, *InsertAllRequest) (*InsertAllResponse, error)
 	GetListNotification(context.Context, *GetListNotificationRequest) (*GetListNotificationResponse, error)
 	GetNotification(context.Context, *GetNotificationsRequest) (*GetNotificationsResponse, error)
+	MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error)
+	MarkAllNotificationsRead(context.Context, *MarkAllNotificationsReadRequest) (*MarkAllNotificationsReadResponse, error)
+	DismissNotification(context.Context, *DismissNotificationRequest) (*DismissNotificationResponse, error)
+	SnoozeNotification(context.Context, *SnoozeNotificationRequest) (*SnoozeNotificationResponse, error)
+	GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountRequest) (*GetUnreadNotificationCountResponse, error)
+	GetChannelPreferences(context.Context, *GetChannelPreferencesRequest) (*GetChannelPreferencesResponse, error)
+	UpdateChannelPreferences(context.Context, *UpdateChannelPreferencesRequest) (*UpdateChannelPreferencesResponse, error)
+	GetDigestSettings(context.Context, *GetDigestSettingsRequest) (*GetDigestSettingsResponse, error)
+	UpdateDigestSettings(context.Context, *UpdateDigestSettingsRequest) (*UpdateDigestSettingsResponse, error)
+	UnsubscribeDigest(context.Context, *UnsubscribeDigestRequest) (*UnsubscribeDigestResponse, error)
+	StreamNotifications(*StreamNotificationsRequest, grpc.ServerStreamingServer[NotificationEvent]) error
 	mustEmbedUnimplementedNOTIFICATIONServiceServer()
 }
 
@@ -549,6 +701,39 @@ func (UnimplementedNOTIFICATIONServiceServer) GetListNotification(context.Contex
 func (UnimplementedNOTIFICATIONServiceServer) GetNotification(context.Context, *GetNotificationsRequest) (*GetNotificationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNotification not implemented")
 }
+func (UnimplementedNOTIFICATIONServiceServer) MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationRead not implemented")
+}
+func (UnimplementedNOTIFICATIONServiceServer) MarkAllNotificationsRead(context.Context, *MarkAllNotificationsReadRequest) (*MarkAllNotificationsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllNotificationsRead not implemented")
+}
+func (UnimplementedNOTIFICATIONServiceServer) DismissNotification(context.Context, *DismissNotificationRequest) (*DismissNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissNotification not implemented")
+}
+func (UnimplementedNOTIFICATIONServiceServer) SnoozeNotification(context.Context, *SnoozeNotificationRequest) (*SnoozeNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeNotification not implemented")
+}
+func (UnimplementedNOTIFICATIONServiceServer) GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountRequest) (*GetUnreadNotificationCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotificationCount not implemented")
+}
+func (UnimplementedNOTIFICATIONServiceServer) GetChannelPreferences(context.Context, *GetChannelPreferencesRequest) (*GetChannelPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelPreferences not implemented")
+}
+func (UnimplementedNOTIFICATIONServiceServer) UpdateChannelPreferences(context.Context, *UpdateChannelPreferencesRequest) (*UpdateChannelPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelPreferences not implemented")
+}
+func (UnimplementedNOTIFICATIONServiceServer) GetDigestSettings(context.Context, *GetDigestSettingsRequest) (*GetDigestSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDigestSettings not implemented")
+}
+func (UnimplementedNOTIFICATIONServiceServer) UpdateDigestSettings(context.Context, *UpdateDigestSettingsRequest) (*UpdateDigestSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDigestSettings not implemented")
+}
+func (UnimplementedNOTIFICATIONServiceServer) UnsubscribeDigest(context.Context, *UnsubscribeDigestRequest) (*UnsubscribeDigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeDigest not implemented")
+}
+func (UnimplementedNOTIFICATIONServiceServer) StreamNotifications(*StreamNotificationsRequest, grpc.ServerStreamingServer[NotificationEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNotifications not implemented")
+}
 func (UnimplementedNOTIFICATIONServiceServer) mustEmbedUnimplementedNOTIFICATIONServiceServer() {}
 func (UnimplementedNOTIFICATIONServiceServer) testEmbeddedByValue()                             {}
 
@@ -642,6 +827,197 @@ func _NOTIFICATIONService_GetNotification_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NOTIFICATIONService_MarkNotificationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONServiceServer).MarkNotificationRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONService_MarkNotificationRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONServiceServer).MarkNotificationRead(ctx, req.(*MarkNotificationReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONService_MarkAllNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONServiceServer).MarkAllNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONService_MarkAllNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONServiceServer).MarkAllNotificationsRead(ctx, req.(*MarkAllNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONService_DismissNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONServiceServer).DismissNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONService_DismissNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONServiceServer).DismissNotification(ctx, req.(*DismissNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONService_SnoozeNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONServiceServer).SnoozeNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONService_SnoozeNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONServiceServer).SnoozeNotification(ctx, req.(*SnoozeNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONService_GetUnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadNotificationCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONServiceServer).GetUnreadNotificationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONService_GetUnreadNotificationCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONServiceServer).GetUnreadNotificationCount(ctx, req.(*GetUnreadNotificationCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONService_GetChannelPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONServiceServer).GetChannelPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONService_GetChannelPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONServiceServer).GetChannelPreferences(ctx, req.(*GetChannelPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONService_UpdateChannelPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONServiceServer).UpdateChannelPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONService_UpdateChannelPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONServiceServer).UpdateChannelPreferences(ctx, req.(*UpdateChannelPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONService_GetDigestSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDigestSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONServiceServer).GetDigestSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONService_GetDigestSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONServiceServer).GetDigestSettings(ctx, req.(*GetDigestSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONService_UpdateDigestSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDigestSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONServiceServer).UpdateDigestSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONService_UpdateDigestSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONServiceServer).UpdateDigestSettings(ctx, req.(*UpdateDigestSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONService_UnsubscribeDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONServiceServer).UnsubscribeDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONService_UnsubscribeDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONServiceServer).UnsubscribeDigest(ctx, req.(*UnsubscribeDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONService_StreamNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NOTIFICATIONServiceServer).StreamNotifications(m, &grpc.GenericServerStream[StreamNotificationsRequest, NotificationEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NOTIFICATIONService_StreamNotificationsServer = grpc.ServerStreamingServer[NotificationEvent]
+
 // NOTIFICATIONService_ServiceDesc is the grpc.ServiceDesc for NOTIFICATIONService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -665,8 +1041,54 @@ var NOTIFICATIONService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNotification",
 			Handler:    _NOTIFICATIONService_GetNotification_Handler,
 		},
+		{
+			MethodName: "MarkNotificationRead",
+			Handler:    _NOTIFICATIONService_MarkNotificationRead_Handler,
+		},
+		{
+			MethodName: "MarkAllNotificationsRead",
+			Handler:    _NOTIFICATIONService_MarkAllNotificationsRead_Handler,
+		},
+		{
+			MethodName: "DismissNotification",
+			Handler:    _NOTIFICATIONService_DismissNotification_Handler,
+		},
+		{
+			MethodName: "SnoozeNotification",
+			Handler:    _NOTIFICATIONService_SnoozeNotification_Handler,
+		},
+		{
+			MethodName: "GetUnreadNotificationCount",
+			Handler:    _NOTIFICATIONService_GetUnreadNotificationCount_Handler,
+		},
+		{
+			MethodName: "GetChannelPreferences",
+			Handler:    _NOTIFICATIONService_GetChannelPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateChannelPreferences",
+			Handler:    _NOTIFICATIONService_UpdateChannelPreferences_Handler,
+		},
+		{
+			MethodName: "GetDigestSettings",
+			Handler:    _NOTIFICATIONService_GetDigestSettings_Handler,
+		},
+		{
+			MethodName: "UpdateDigestSettings",
+			Handler:    _NOTIFICATIONService_UpdateDigestSettings_Handler,
+		},
+		{
+			MethodName: "UnsubscribeDigest",
+			Handler:    _NOTIFICATIONService_UnsubscribeDigest_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamNotifications",
+			Handler:       _NOTIFICATIONService_StreamNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "asset.proto",
 }
 
@@ -1270,8 +1692,25 @@ var AREAService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	AUTHService_Login_FullMethodName  = "/asset.AUTHService/Login"
-	AUTHService_Logout_FullMethodName = "/asset.AUTHService/Logout"
+	AUTHService_Login_FullMethodName                   = "/asset.AUTHService/Login"
+	AUTHService_Logout_FullMethodName                  = "/asset.AUTHService/Logout"
+	AUTHService_RefreshToken_FullMethodName            = "/asset.AUTHService/RefreshToken"
+	AUTHService_ChangePassword_FullMethodName          = "/asset.AUTHService/ChangePassword"
+	AUTHService_ListSessions_FullMethodName            = "/asset.AUTHService/ListSessions"
+	AUTHService_RevokeSession_FullMethodName           = "/asset.AUTHService/RevokeSession"
+	AUTHService_RevokeAllSessions_FullMethodName       = "/asset.AUTHService/RevokeAllSessions"
+	AUTHService_ForceLogout_FullMethodName             = "/asset.AUTHService/ForceLogout"
+	AUTHService_UnlockAccount_FullMethodName           = "/asset.AUTHService/UnlockAccount"
+	AUTHService_ListLoginAttempts_FullMethodName       = "/asset.AUTHService/ListLoginAttempts"
+	AUTHService_VerifyMFA_FullMethodName               = "/asset.AUTHService/VerifyMFA"
+	AUTHService_GetTwoFactorStatus_FullMethodName      = "/asset.AUTHService/GetTwoFactorStatus"
+	AUTHService_EnrollTOTP_FullMethodName              = "/asset.AUTHService/EnrollTOTP"
+	AUTHService_ConfirmTOTP_FullMethodName             = "/asset.AUTHService/ConfirmTOTP"
+	AUTHService_DisableTOTP_FullMethodName             = "/asset.AUTHService/DisableTOTP"
+	AUTHService_RegenerateRecoveryCodes_FullMethodName = "/asset.AUTHService/RegenerateRecoveryCodes"
+	AUTHService_ResetTwoFactor_FullMethodName          = "/asset.AUTHService/ResetTwoFactor"
+	AUTHService_BeginOIDCLogin_FullMethodName          = "/asset.AUTHService/BeginOIDCLogin"
+	AUTHService_CompleteOIDCLogin_FullMethodName       = "/asset.AUTHService/CompleteOIDCLogin"
 )
 
 // AUTHServiceClient is the client API for AUTHService service.
@@ -1280,6 +1719,23 @@ const (
 type AUTHServiceClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error)
+	ForceLogout(ctx context.Context, in *ForceLogoutRequest, opts ...grpc.CallOption) (*ForceLogoutResponse, error)
+	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error)
+	ListLoginAttempts(ctx context.Context, in *ListLoginAttemptsRequest, opts ...grpc.CallOption) (*ListLoginAttemptsResponse, error)
+	VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	GetTwoFactorStatus(ctx context.Context, in *GetTwoFactorStatusRequest, opts ...grpc.CallOption) (*GetTwoFactorStatusResponse, error)
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error)
+	ResetTwoFactor(ctx context.Context, in *ResetTwoFactorRequest, opts ...grpc.CallOption) (*ResetTwoFactorResponse, error)
+	BeginOIDCLogin(ctx context.Context, in *BeginOIDCLoginRequest, opts ...grpc.CallOption) (*BeginOIDCLoginResponse, error)
+	CompleteOIDCLogin(ctx context.Context, in *CompleteOIDCLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 }
 
 type aUTHServiceClient struct {
@@ -1310,12 +1766,199 @@ func (c *aUTHServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts
 	return out, nil
 }
 
-// AUTHServiceServer is the server API for AUTHService service.
-// All implementations must embed UnimplementedAUTHServiceServer
-// for forward compatibility.
-type AUTHServiceServer interface {
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+func (c *aUTHServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, AUTHService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, AUTHService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, AUTHService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, AUTHService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllSessionsResponse)
+	err := c.cc.Invoke(ctx, AUTHService_RevokeAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) ForceLogout(ctx context.Context, in *ForceLogoutRequest, opts ...grpc.CallOption) (*ForceLogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceLogoutResponse)
+	err := c.cc.Invoke(ctx, AUTHService_ForceLogout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockAccountResponse)
+	err := c.cc.Invoke(ctx, AUTHService_UnlockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) ListLoginAttempts(ctx context.Context, in *ListLoginAttemptsRequest, opts ...grpc.CallOption) (*ListLoginAttemptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginAttemptsResponse)
+	err := c.cc.Invoke(ctx, AUTHService_ListLoginAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AUTHService_VerifyMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) GetTwoFactorStatus(ctx context.Context, in *GetTwoFactorStatusRequest, opts ...grpc.CallOption) (*GetTwoFactorStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTwoFactorStatusResponse)
+	err := c.cc.Invoke(ctx, AUTHService_GetTwoFactorStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, AUTHService_EnrollTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTOTPResponse)
+	err := c.cc.Invoke(ctx, AUTHService_ConfirmTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTOTPResponse)
+	err := c.cc.Invoke(ctx, AUTHService_DisableTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, AUTHService_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) ResetTwoFactor(ctx context.Context, in *ResetTwoFactorRequest, opts ...grpc.CallOption) (*ResetTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AUTHService_ResetTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) BeginOIDCLogin(ctx context.Context, in *BeginOIDCLoginRequest, opts ...grpc.CallOption) (*BeginOIDCLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, AUTHService_BeginOIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aUTHServiceClient) CompleteOIDCLogin(ctx context.Context, in *CompleteOIDCLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AUTHService_CompleteOIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AUTHServiceServer is the server API for AUTHService service.
+// All implementations must embed UnimplementedAUTHServiceServer
+// for forward compatibility.
+type AUTHServiceServer interface {
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error)
+	ForceLogout(context.Context, *ForceLogoutRequest) (*ForceLogoutResponse, error)
+	UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error)
+	ListLoginAttempts(context.Context, *ListLoginAttemptsRequest) (*ListLoginAttemptsResponse, error)
+	VerifyMFA(context.Context, *VerifyMFARequest) (*LoginResponse, error)
+	GetTwoFactorStatus(context.Context, *GetTwoFactorStatusRequest) (*GetTwoFactorStatusResponse, error)
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error)
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error)
+	ResetTwoFactor(context.Context, *ResetTwoFactorRequest) (*ResetTwoFactorResponse, error)
+	BeginOIDCLogin(context.Context, *BeginOIDCLoginRequest) (*BeginOIDCLoginResponse, error)
+	CompleteOIDCLogin(context.Context, *CompleteOIDCLoginRequest) (*LoginResponse, error)
 	mustEmbedUnimplementedAUTHServiceServer()
 }
 
@@ -1332,6 +1975,57 @@ func (UnimplementedAUTHServiceServer) Login(context.Context, *LoginRequest) (*Lo
 func (UnimplementedAUTHServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
 }
+func (UnimplementedAUTHServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAUTHServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedAUTHServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedAUTHServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAUTHServiceServer) RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
+func (UnimplementedAUTHServiceServer) ForceLogout(context.Context, *ForceLogoutRequest) (*ForceLogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceLogout not implemented")
+}
+func (UnimplementedAUTHServiceServer) UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockAccount not implemented")
+}
+func (UnimplementedAUTHServiceServer) ListLoginAttempts(context.Context, *ListLoginAttemptsRequest) (*ListLoginAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoginAttempts not implemented")
+}
+func (UnimplementedAUTHServiceServer) VerifyMFA(context.Context, *VerifyMFARequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMFA not implemented")
+}
+func (UnimplementedAUTHServiceServer) GetTwoFactorStatus(context.Context, *GetTwoFactorStatusRequest) (*GetTwoFactorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTwoFactorStatus not implemented")
+}
+func (UnimplementedAUTHServiceServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedAUTHServiceServer) ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedAUTHServiceServer) DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedAUTHServiceServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedAUTHServiceServer) ResetTwoFactor(context.Context, *ResetTwoFactorRequest) (*ResetTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTwoFactor not implemented")
+}
+func (UnimplementedAUTHServiceServer) BeginOIDCLogin(context.Context, *BeginOIDCLoginRequest) (*BeginOIDCLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginOIDCLogin not implemented")
+}
+func (UnimplementedAUTHServiceServer) CompleteOIDCLogin(context.Context, *CompleteOIDCLoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteOIDCLogin not implemented")
+}
 func (UnimplementedAUTHServiceServer) mustEmbedUnimplementedAUTHServiceServer() {}
 func (UnimplementedAUTHServiceServer) testEmbeddedByValue()                     {}
 
@@ -1389,414 +2083,1629 @@ func _AUTHService_Logout_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-// AUTHService_ServiceDesc is the grpc.ServiceDesc for AUTHService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AUTHService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "asset.AUTHService",
-	HandlerType: (*AUTHServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Login",
-			Handler:    _AUTHService_Login_Handler,
-		},
-		{
-			MethodName: "Logout",
-			Handler:    _AUTHService_Logout_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "asset.proto",
-}
-
-const (
-	ROLEService_ListRole_FullMethodName = "/asset.ROLEService/ListRole"
-)
-
-// ROLEServiceClient is the client API for ROLEService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type ROLEServiceClient interface {
-	ListRole(ctx context.Context, in *ListRoleRequest, opts ...grpc.CallOption) (*ListRoleResponse, error)
-}
-
-type rOLEServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewROLEServiceClient(cc grpc.ClientConnInterface) ROLEServiceClient {
-	return &rOLEServiceClient{cc}
-}
-
-func (c *rOLEServiceClient) ListRole(ctx context.Context, in *ListRoleRequest, opts ...grpc.CallOption) (*ListRoleResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListRoleResponse)
-	err := c.cc.Invoke(ctx, ROLEService_ListRole_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _AUTHService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ROLEServiceServer is the server API for ROLEService service.
-// All implementations must embed UnimplementedROLEServiceServer
-// for forward compatibility.
-type ROLEServiceServer interface {
-	ListRole(context.Context, *ListRoleRequest) (*ListRoleResponse, error)
-	mustEmbedUnimplementedROLEServiceServer()
-}
-
-// UnimplementedROLEServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedROLEServiceServer struct{}
-
-func (UnimplementedROLEServiceServer) ListRole(context.Context, *ListRoleRequest) (*ListRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRole not implemented")
-}
-func (UnimplementedROLEServiceServer) mustEmbedUnimplementedROLEServiceServer() {}
-func (UnimplementedROLEServiceServer) testEmbeddedByValue()                     {}
-
-// UnsafeROLEServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ROLEServiceServer will
-// result in compilation errors.
-type UnsafeROLEServiceServer interface {
-	mustEmbedUnimplementedROLEServiceServer()
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterROLEServiceServer(s grpc.ServiceRegistrar, srv ROLEServiceServer) {
-	// If the following call pancis, it indicates UnimplementedROLEServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _AUTHService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ROLEService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ROLEService_ListRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRoleRequest)
+func _AUTHService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ROLEServiceServer).ListRole(ctx, in)
+		return srv.(AUTHServiceServer).ListSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ROLEService_ListRole_FullMethodName,
+		FullMethod: AUTHService_ListSessions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ROLEServiceServer).ListRole(ctx, req.(*ListRoleRequest))
+		return srv.(AUTHServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ROLEService_ServiceDesc is the grpc.ServiceDesc for ROLEService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ROLEService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "asset.ROLEService",
-	HandlerType: (*ROLEServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListRole",
-			Handler:    _ROLEService_ListRole_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "asset.proto",
-}
-
-const (
-	USERService_CreateUser_FullMethodName    = "/asset.USERService/CreateUser"
-	USERService_GetUser_FullMethodName       = "/asset.USERService/GetUser"
-	USERService_UpdateUser_FullMethodName    = "/asset.USERService/UpdateUser"
-	USERService_DeleteUser_FullMethodName    = "/asset.USERService/DeleteUser"
-	USERService_ListUsers_FullMethodName     = "/asset.USERService/ListUsers"
-	USERService_ResetPassword_FullMethodName = "/asset.USERService/ResetPassword"
-)
-
-// USERServiceClient is the client API for USERService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type USERServiceClient interface {
-	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
-	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
-	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
-	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
-	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
-	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
-}
-
-type uSERServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewUSERServiceClient(cc grpc.ClientConnInterface) USERServiceClient {
-	return &uSERServiceClient{cc}
-}
-
-func (c *uSERServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateUserResponse)
-	err := c.cc.Invoke(ctx, USERService_CreateUser_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _AUTHService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uSERServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetUserResponse)
-	err := c.cc.Invoke(ctx, USERService_GetUser_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _AUTHService_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_RevokeAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).RevokeAllSessions(ctx, req.(*RevokeAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uSERServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateUserResponse)
-	err := c.cc.Invoke(ctx, USERService_UpdateUser_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _AUTHService_ForceLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceLogoutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).ForceLogout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_ForceLogout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).ForceLogout(ctx, req.(*ForceLogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uSERServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteUserResponse)
-	err := c.cc.Invoke(ctx, USERService_DeleteUser_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _AUTHService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_UnlockAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).UnlockAccount(ctx, req.(*UnlockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uSERServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListUsersResponse)
-	err := c.cc.Invoke(ctx, USERService_ListUsers_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _AUTHService_ListLoginAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginAttemptsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).ListLoginAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_ListLoginAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).ListLoginAttempts(ctx, req.(*ListLoginAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uSERServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ResetPasswordResponse)
-	err := c.cc.Invoke(ctx, USERService_ResetPassword_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _AUTHService_VerifyMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMFARequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// USERServiceServer is the server API for USERService service.
-// All implementations must embed UnimplementedUSERServiceServer
-// for forward compatibility.
-type USERServiceServer interface {
-	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
-	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
-	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
-	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
-	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
-	mustEmbedUnimplementedUSERServiceServer()
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).VerifyMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_VerifyMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).VerifyMFA(ctx, req.(*VerifyMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedUSERServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedUSERServiceServer struct{}
-
-func (UnimplementedUSERServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
-}
-func (UnimplementedUSERServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
-}
-func (UnimplementedUSERServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
-}
-func (UnimplementedUSERServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
-}
-func (UnimplementedUSERServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
-}
-func (UnimplementedUSERServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+func _AUTHService_GetTwoFactorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTwoFactorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).GetTwoFactorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_GetTwoFactorStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).GetTwoFactorStatus(ctx, req.(*GetTwoFactorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUSERServiceServer) mustEmbedUnimplementedUSERServiceServer() {}
-func (UnimplementedUSERServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeUSERServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to USERServiceServer will
-// result in compilation errors.
-type UnsafeUSERServiceServer interface {
-	mustEmbedUnimplementedUSERServiceServer()
+func _AUTHService_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUSERServiceServer(s grpc.ServiceRegistrar, srv USERServiceServer) {
-	// If the following call pancis, it indicates UnimplementedUSERServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _AUTHService_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&USERService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(AUTHServiceServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AUTHService_ConfirmTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AUTHServiceServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _USERService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _AUTHService_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(USERServiceServer).CreateUser(ctx, in)
+		return srv.(AUTHServiceServer).DisableTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: USERService_CreateUser_FullMethodName,
+		FullMethod: AUTHService_DisableTOTP_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(USERServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(AUTHServiceServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _USERService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _AUTHService_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(USERServiceServer).GetUser(ctx, in)
+		return srv.(AUTHServiceServer).RegenerateRecoveryCodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: USERService_GetUser_FullMethodName,
+		FullMethod: AUTHService_RegenerateRecoveryCodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(USERServiceServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(AUTHServiceServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _USERService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _AUTHService_ResetTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetTwoFactorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(USERServiceServer).UpdateUser(ctx, in)
+		return srv.(AUTHServiceServer).ResetTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: USERService_UpdateUser_FullMethodName,
+		FullMethod: AUTHService_ResetTwoFactor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(USERServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(AUTHServiceServer).ResetTwoFactor(ctx, req.(*ResetTwoFactorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _USERService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _AUTHService_BeginOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginOIDCLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(USERServiceServer).DeleteUser(ctx, in)
+		return srv.(AUTHServiceServer).BeginOIDCLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: USERService_DeleteUser_FullMethodName,
+		FullMethod: AUTHService_BeginOIDCLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(USERServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(AUTHServiceServer).BeginOIDCLogin(ctx, req.(*BeginOIDCLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _USERService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _AUTHService_CompleteOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOIDCLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(USERServiceServer).ListUsers(ctx, in)
+		return srv.(AUTHServiceServer).CompleteOIDCLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: USERService_ListUsers_FullMethodName,
+		FullMethod: AUTHService_CompleteOIDCLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(USERServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+		return srv.(AUTHServiceServer).CompleteOIDCLogin(ctx, req.(*CompleteOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AUTHService_ServiceDesc is the grpc.ServiceDesc for AUTHService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AUTHService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asset.AUTHService",
+	HandlerType: (*AUTHServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _AUTHService_Login_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AUTHService_Logout_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AUTHService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AUTHService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _AUTHService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AUTHService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _AUTHService_RevokeAllSessions_Handler,
+		},
+		{
+			MethodName: "ForceLogout",
+			Handler:    _AUTHService_ForceLogout_Handler,
+		},
+		{
+			MethodName: "UnlockAccount",
+			Handler:    _AUTHService_UnlockAccount_Handler,
+		},
+		{
+			MethodName: "ListLoginAttempts",
+			Handler:    _AUTHService_ListLoginAttempts_Handler,
+		},
+		{
+			MethodName: "VerifyMFA",
+			Handler:    _AUTHService_VerifyMFA_Handler,
+		},
+		{
+			MethodName: "GetTwoFactorStatus",
+			Handler:    _AUTHService_GetTwoFactorStatus_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _AUTHService_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _AUTHService_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _AUTHService_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _AUTHService_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "ResetTwoFactor",
+			Handler:    _AUTHService_ResetTwoFactor_Handler,
+		},
+		{
+			MethodName: "BeginOIDCLogin",
+			Handler:    _AUTHService_BeginOIDCLogin_Handler,
+		},
+		{
+			MethodName: "CompleteOIDCLogin",
+			Handler:    _AUTHService_CompleteOIDCLogin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "asset.proto",
+}
+
+const (
+	ROLEService_ListRole_FullMethodName            = "/asset.ROLEService/ListRole"
+	ROLEService_UpdateRoleMFAPolicy_FullMethodName = "/asset.ROLEService/UpdateRoleMFAPolicy"
+)
+
+// ROLEServiceClient is the client API for ROLEService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ROLEServiceClient interface {
+	ListRole(ctx context.Context, in *ListRoleRequest, opts ...grpc.CallOption) (*ListRoleResponse, error)
+	UpdateRoleMFAPolicy(ctx context.Context, in *UpdateRoleMFAPolicyRequest, opts ...grpc.CallOption) (*UpdateRoleMFAPolicyResponse, error)
+}
+
+type rOLEServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewROLEServiceClient(cc grpc.ClientConnInterface) ROLEServiceClient {
+	return &rOLEServiceClient{cc}
+}
+
+func (c *rOLEServiceClient) ListRole(ctx context.Context, in *ListRoleRequest, opts ...grpc.CallOption) (*ListRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoleResponse)
+	err := c.cc.Invoke(ctx, ROLEService_ListRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rOLEServiceClient) UpdateRoleMFAPolicy(ctx context.Context, in *UpdateRoleMFAPolicyRequest, opts ...grpc.CallOption) (*UpdateRoleMFAPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRoleMFAPolicyResponse)
+	err := c.cc.Invoke(ctx, ROLEService_UpdateRoleMFAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ROLEServiceServer is the server API for ROLEService service.
+// All implementations must embed UnimplementedROLEServiceServer
+// for forward compatibility.
+type ROLEServiceServer interface {
+	ListRole(context.Context, *ListRoleRequest) (*ListRoleResponse, error)
+	UpdateRoleMFAPolicy(context.Context, *UpdateRoleMFAPolicyRequest) (*UpdateRoleMFAPolicyResponse, error)
+	mustEmbedUnimplementedROLEServiceServer()
+}
+
+// UnimplementedROLEServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedROLEServiceServer struct{}
+
+func (UnimplementedROLEServiceServer) ListRole(context.Context, *ListRoleRequest) (*ListRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRole not implemented")
+}
+func (UnimplementedROLEServiceServer) UpdateRoleMFAPolicy(context.Context, *UpdateRoleMFAPolicyRequest) (*UpdateRoleMFAPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoleMFAPolicy not implemented")
+}
+func (UnimplementedROLEServiceServer) mustEmbedUnimplementedROLEServiceServer() {}
+func (UnimplementedROLEServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeROLEServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ROLEServiceServer will
+// result in compilation errors.
+type UnsafeROLEServiceServer interface {
+	mustEmbedUnimplementedROLEServiceServer()
+}
+
+func RegisterROLEServiceServer(s grpc.ServiceRegistrar, srv ROLEServiceServer) {
+	// If the following call pancis, it indicates UnimplementedROLEServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ROLEService_ServiceDesc, srv)
+}
+
+func _ROLEService_ListRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ROLEServiceServer).ListRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ROLEService_ListRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ROLEServiceServer).ListRole(ctx, req.(*ListRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ROLEService_UpdateRoleMFAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleMFAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ROLEServiceServer).UpdateRoleMFAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ROLEService_UpdateRoleMFAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ROLEServiceServer).UpdateRoleMFAPolicy(ctx, req.(*UpdateRoleMFAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ROLEService_ServiceDesc is the grpc.ServiceDesc for ROLEService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ROLEService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asset.ROLEService",
+	HandlerType: (*ROLEServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRole",
+			Handler:    _ROLEService_ListRole_Handler,
+		},
+		{
+			MethodName: "UpdateRoleMFAPolicy",
+			Handler:    _ROLEService_UpdateRoleMFAPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "asset.proto",
+}
+
+const (
+	USERService_CreateUser_FullMethodName           = "/asset.USERService/CreateUser"
+	USERService_GetUser_FullMethodName              = "/asset.USERService/GetUser"
+	USERService_UpdateUser_FullMethodName           = "/asset.USERService/UpdateUser"
+	USERService_DeleteUser_FullMethodName           = "/asset.USERService/DeleteUser"
+	USERService_ListUsers_FullMethodName            = "/asset.USERService/ListUsers"
+	USERService_ResetPassword_FullMethodName        = "/asset.USERService/ResetPassword"
+	USERService_RequestPasswordReset_FullMethodName = "/asset.USERService/RequestPasswordReset"
+	USERService_ConfirmPasswordReset_FullMethodName = "/asset.USERService/ConfirmPasswordReset"
+	USERService_DeactivateUser_FullMethodName       = "/asset.USERService/DeactivateUser"
+	USERService_ReactivateUser_FullMethodName       = "/asset.USERService/ReactivateUser"
+	USERService_OffboardUser_FullMethodName         = "/asset.USERService/OffboardUser"
+	USERService_ImportUsers_FullMethodName          = "/asset.USERService/ImportUsers"
+)
+
+// USERServiceClient is the client API for USERService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type USERServiceClient interface {
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// Deprecated: use ConfirmPasswordReset
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*ConfirmPasswordResetResponse, error)
+	DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error)
+	ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error)
+	OffboardUser(ctx context.Context, in *OffboardUserRequest, opts ...grpc.CallOption) (*OffboardUserResponse, error)
+	ImportUsers(ctx context.Context, in *ImportUsersRequest, opts ...grpc.CallOption) (*ImportUsersResponse, error)
+}
+
+type uSERServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUSERServiceClient(cc grpc.ClientConnInterface) USERServiceClient {
+	return &uSERServiceClient{cc}
+}
+
+func (c *uSERServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, USERService_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, USERService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, USERService_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, USERService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, USERService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, USERService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, USERService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*ConfirmPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmPasswordResetResponse)
+	err := c.cc.Invoke(ctx, USERService_ConfirmPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateUserResponse)
+	err := c.cc.Invoke(ctx, USERService_DeactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateUserResponse)
+	err := c.cc.Invoke(ctx, USERService_ReactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) OffboardUser(ctx context.Context, in *OffboardUserRequest, opts ...grpc.CallOption) (*OffboardUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OffboardUserResponse)
+	err := c.cc.Invoke(ctx, USERService_OffboardUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uSERServiceClient) ImportUsers(ctx context.Context, in *ImportUsersRequest, opts ...grpc.CallOption) (*ImportUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportUsersResponse)
+	err := c.cc.Invoke(ctx, USERService_ImportUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// USERServiceServer is the server API for USERService service.
+// All implementations must embed UnimplementedUSERServiceServer
+// for forward compatibility.
+type USERServiceServer interface {
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// Deprecated: use ConfirmPasswordReset
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*ConfirmPasswordResetResponse, error)
+	DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error)
+	ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error)
+	OffboardUser(context.Context, *OffboardUserRequest) (*OffboardUserResponse, error)
+	ImportUsers(context.Context, *ImportUsersRequest) (*ImportUsersResponse, error)
+	mustEmbedUnimplementedUSERServiceServer()
+}
+
+// UnimplementedUSERServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUSERServiceServer struct{}
+
+func (UnimplementedUSERServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUSERServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUSERServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUSERServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUSERServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUSERServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedUSERServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedUSERServiceServer) ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*ConfirmPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
+func (UnimplementedUSERServiceServer) DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateUser not implemented")
+}
+func (UnimplementedUSERServiceServer) ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateUser not implemented")
+}
+func (UnimplementedUSERServiceServer) OffboardUser(context.Context, *OffboardUserRequest) (*OffboardUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffboardUser not implemented")
+}
+func (UnimplementedUSERServiceServer) ImportUsers(context.Context, *ImportUsersRequest) (*ImportUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportUsers not implemented")
+}
+func (UnimplementedUSERServiceServer) mustEmbedUnimplementedUSERServiceServer() {}
+func (UnimplementedUSERServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUSERServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to USERServiceServer will
+// result in compilation errors.
+type UnsafeUSERServiceServer interface {
+	mustEmbedUnimplementedUSERServiceServer()
+}
+
+func RegisterUSERServiceServer(s grpc.ServiceRegistrar, srv USERServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUSERServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&USERService_ServiceDesc, srv)
+}
+
+func _USERService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_ConfirmPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).DeactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_DeactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).DeactivateUser(ctx, req.(*DeactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_ReactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).ReactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_ReactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).ReactivateUser(ctx, req.(*ReactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_OffboardUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OffboardUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).OffboardUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_OffboardUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).OffboardUser(ctx, req.(*OffboardUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _USERService_ImportUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(USERServiceServer).ImportUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: USERService_ImportUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(USERServiceServer).ImportUsers(ctx, req.(*ImportUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// USERService_ServiceDesc is the grpc.ServiceDesc for USERService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var USERService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asset.USERService",
+	HandlerType: (*USERServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _USERService_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _USERService_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _USERService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _USERService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _USERService_ListUsers_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _USERService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _USERService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _USERService_ConfirmPasswordReset_Handler,
+		},
+		{
+			MethodName: "DeactivateUser",
+			Handler:    _USERService_DeactivateUser_Handler,
+		},
+		{
+			MethodName: "ReactivateUser",
+			Handler:    _USERService_ReactivateUser_Handler,
+		},
+		{
+			MethodName: "OffboardUser",
+			Handler:    _USERService_OffboardUser_Handler,
+		},
+		{
+			MethodName: "ImportUsers",
+			Handler:    _USERService_ImportUsers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "asset.proto",
+}
+
+const (
+	ASSETService_CreateAssets_FullMethodName      = "/asset.ASSETService/CreateAssets"
+	ASSETService_ListMstAssets_FullMethodName     = "/asset.ASSETService/ListMstAssets"
+	ASSETService_GetAsset_FullMethodName          = "/asset.ASSETService/GetAsset"
+	ASSETService_GetAssetByHash_FullMethodName    = "/asset.ASSETService/GetAssetByHash"
+	ASSETService_UpdateAsset_FullMethodName       = "/asset.ASSETService/UpdateAsset"
+	ASSETService_UpdateAssetStatus_FullMethodName = "/asset.ASSETService/UpdateAssetStatus"
+	ASSETService_DeleteAsset_FullMethodName       = "/asset.ASSETService/DeleteAsset"
+	ASSETService_ListAssets_FullMethodName        = "/asset.ASSETService/ListAssets"
+)
+
+// ASSETServiceClient is the client API for ASSETService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ASSETServiceClient interface {
+	CreateAssets(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error)
+	ListMstAssets(ctx context.Context, in *ListMstAssetsRequest, opts ...grpc.CallOption) (*ListMstAssetsResponse, error)
+	GetAsset(ctx context.Context, in *GetAssetRequest, opts ...grpc.CallOption) (*GetAssetResponse, error)
+	GetAssetByHash(ctx context.Context, in *GetAssetByHashRequest, opts ...grpc.CallOption) (*GetAssetByHashResponse, error)
+	UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*UpdateAssetResponse, error)
+	UpdateAssetStatus(ctx context.Context, in *UpdateAssetStatusRequest, opts ...grpc.CallOption) (*UpdateAssetStatusResponse, error)
+	DeleteAsset(ctx context.Context, in *DeleteAssetRequest, opts ...grpc.CallOption) (*DeleteAssetResponse, error)
+	ListAssets(ctx context.Context, in *ListAssetsRequest, opts ...grpc.CallOption) (*ListAssetsResponse, error)
+}
+
+type aSSETServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewASSETServiceClient(cc grpc.ClientConnInterface) ASSETServiceClient {
+	return &aSSETServiceClient{cc}
+}
+
+func (c *aSSETServiceClient) CreateAssets(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAssetResponse)
+	err := c.cc.Invoke(ctx, ASSETService_CreateAssets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aSSETServiceClient) ListMstAssets(ctx context.Context, in *ListMstAssetsRequest, opts ...grpc.CallOption) (*ListMstAssetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMstAssetsResponse)
+	err := c.cc.Invoke(ctx, ASSETService_ListMstAssets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aSSETServiceClient) GetAsset(ctx context.Context, in *GetAssetRequest, opts ...grpc.CallOption) (*GetAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssetResponse)
+	err := c.cc.Invoke(ctx, ASSETService_GetAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aSSETServiceClient) GetAssetByHash(ctx context.Context, in *GetAssetByHashRequest, opts ...grpc.CallOption) (*GetAssetByHashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssetByHashResponse)
+	err := c.cc.Invoke(ctx, ASSETService_GetAssetByHash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aSSETServiceClient) UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*UpdateAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAssetResponse)
+	err := c.cc.Invoke(ctx, ASSETService_UpdateAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aSSETServiceClient) UpdateAssetStatus(ctx context.Context, in *UpdateAssetStatusRequest, opts ...grpc.CallOption) (*UpdateAssetStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAssetStatusResponse)
+	err := c.cc.Invoke(ctx, ASSETService_UpdateAssetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aSSETServiceClient) DeleteAsset(ctx context.Context, in *DeleteAssetRequest, opts ...grpc.CallOption) (*DeleteAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAssetResponse)
+	err := c.cc.Invoke(ctx, ASSETService_DeleteAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aSSETServiceClient) ListAssets(ctx context.Context, in *ListAssetsRequest, opts ...grpc.CallOption) (*ListAssetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssetsResponse)
+	err := c.cc.Invoke(ctx, ASSETService_ListAssets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ASSETServiceServer is the server API for ASSETService service.
+// All implementations must embed UnimplementedASSETServiceServer
+// for forward compatibility.
+type ASSETServiceServer interface {
+	CreateAssets(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error)
+	ListMstAssets(context.Context, *ListMstAssetsRequest) (*ListMstAssetsResponse, error)
+	GetAsset(context.Context, *GetAssetRequest) (*GetAssetResponse, error)
+	GetAssetByHash(context.Context, *GetAssetByHashRequest) (*GetAssetByHashResponse, error)
+	UpdateAsset(context.Context, *UpdateAssetRequest) (*UpdateAssetResponse, error)
+	UpdateAssetStatus(context.Context, *UpdateAssetStatusRequest) (*UpdateAssetStatusResponse, error)
+	DeleteAsset(context.Context, *DeleteAssetRequest) (*DeleteAssetResponse, error)
+	ListAssets(context.Context, *ListAssetsRequest) (*ListAssetsResponse, error)
+	mustEmbedUnimplementedASSETServiceServer()
+}
+
+// UnimplementedASSETServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedASSETServiceServer struct{}
+
+func (UnimplementedASSETServiceServer) CreateAssets(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssets not implemented")
+}
+func (UnimplementedASSETServiceServer) ListMstAssets(context.Context, *ListMstAssetsRequest) (*ListMstAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMstAssets not implemented")
+}
+func (UnimplementedASSETServiceServer) GetAsset(context.Context, *GetAssetRequest) (*GetAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAsset not implemented")
+}
+func (UnimplementedASSETServiceServer) GetAssetByHash(context.Context, *GetAssetByHashRequest) (*GetAssetByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetByHash not implemented")
+}
+func (UnimplementedASSETServiceServer) UpdateAsset(context.Context, *UpdateAssetRequest) (*UpdateAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAsset not implemented")
+}
+func (UnimplementedASSETServiceServer) UpdateAssetStatus(context.Context, *UpdateAssetStatusRequest) (*UpdateAssetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssetStatus not implemented")
+}
+func (UnimplementedASSETServiceServer) DeleteAsset(context.Context, *DeleteAssetRequest) (*DeleteAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAsset not implemented")
+}
+func (UnimplementedASSETServiceServer) ListAssets(context.Context, *ListAssetsRequest) (*ListAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssets not implemented")
+}
+func (UnimplementedASSETServiceServer) mustEmbedUnimplementedASSETServiceServer() {}
+func (UnimplementedASSETServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeASSETServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ASSETServiceServer will
+// result in compilation errors.
+type UnsafeASSETServiceServer interface {
+	mustEmbedUnimplementedASSETServiceServer()
+}
+
+func RegisterASSETServiceServer(s grpc.ServiceRegistrar, srv ASSETServiceServer) {
+	// If the following call pancis, it indicates UnimplementedASSETServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ASSETService_ServiceDesc, srv)
+}
+
+func _ASSETService_CreateAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ASSETServiceServer).CreateAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ASSETService_CreateAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ASSETServiceServer).CreateAssets(ctx, req.(*CreateAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ASSETService_ListMstAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMstAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ASSETServiceServer).ListMstAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ASSETService_ListMstAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ASSETServiceServer).ListMstAssets(ctx, req.(*ListMstAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ASSETService_GetAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ASSETServiceServer).GetAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ASSETService_GetAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ASSETServiceServer).GetAsset(ctx, req.(*GetAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ASSETService_GetAssetByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ASSETServiceServer).GetAssetByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ASSETService_GetAssetByHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ASSETServiceServer).GetAssetByHash(ctx, req.(*GetAssetByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ASSETService_UpdateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ASSETServiceServer).UpdateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ASSETService_UpdateAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ASSETServiceServer).UpdateAsset(ctx, req.(*UpdateAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ASSETService_UpdateAssetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ASSETServiceServer).UpdateAssetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ASSETService_UpdateAssetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ASSETServiceServer).UpdateAssetStatus(ctx, req.(*UpdateAssetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ASSETService_DeleteAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ASSETServiceServer).DeleteAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ASSETService_DeleteAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ASSETServiceServer).DeleteAsset(ctx, req.(*DeleteAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ASSETService_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ASSETServiceServer).ListAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ASSETService_ListAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ASSETServiceServer).ListAssets(ctx, req.(*ListAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ASSETService_ServiceDesc is the grpc.ServiceDesc for ASSETService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ASSETService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asset.ASSETService",
+	HandlerType: (*ASSETServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAssets",
+			Handler:    _ASSETService_CreateAssets_Handler,
+		},
+		{
+			MethodName: "ListMstAssets",
+			Handler:    _ASSETService_ListMstAssets_Handler,
+		},
+		{
+			MethodName: "GetAsset",
+			Handler:    _ASSETService_GetAsset_Handler,
+		},
+		{
+			MethodName: "GetAssetByHash",
+			Handler:    _ASSETService_GetAssetByHash_Handler,
+		},
+		{
+			MethodName: "UpdateAsset",
+			Handler:    _ASSETService_UpdateAsset_Handler,
+		},
+		{
+			MethodName: "UpdateAssetStatus",
+			Handler:    _ASSETService_UpdateAssetStatus_Handler,
+		},
+		{
+			MethodName: "DeleteAsset",
+			Handler:    _ASSETService_DeleteAsset_Handler,
+		},
+		{
+			MethodName: "ListAssets",
+			Handler:    _ASSETService_ListAssets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "asset.proto",
+}
+
+const (
+	ASSETUPDATEService_CreateAssetUpdate_FullMethodName = "/asset.ASSETUPDATEService/CreateAssetUpdate"
+)
+
+// ASSETUPDATEServiceClient is the client API for ASSETUPDATEService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ASSETUPDATEServiceClient interface {
+	CreateAssetUpdate(ctx context.Context, in *CreateAssetUpdateRequest, opts ...grpc.CallOption) (*CreateAssetUpdateResponse, error)
+}
+
+type aSSETUPDATEServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewASSETUPDATEServiceClient(cc grpc.ClientConnInterface) ASSETUPDATEServiceClient {
+	return &aSSETUPDATEServiceClient{cc}
+}
+
+func (c *aSSETUPDATEServiceClient) CreateAssetUpdate(ctx context.Context, in *CreateAssetUpdateRequest, opts ...grpc.CallOption) (*CreateAssetUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAssetUpdateResponse)
+	err := c.cc.Invoke(ctx, ASSETUPDATEService_CreateAssetUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ASSETUPDATEServiceServer is the server API for ASSETUPDATEService service.
+// All implementations must embed UnimplementedASSETUPDATEServiceServer
+// for forward compatibility.
+type ASSETUPDATEServiceServer interface {
+	CreateAssetUpdate(context.Context, *CreateAssetUpdateRequest) (*CreateAssetUpdateResponse, error)
+	mustEmbedUnimplementedASSETUPDATEServiceServer()
+}
+
+// UnimplementedASSETUPDATEServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedASSETUPDATEServiceServer struct{}
+
+func (UnimplementedASSETUPDATEServiceServer) CreateAssetUpdate(context.Context, *CreateAssetUpdateRequest) (*CreateAssetUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssetUpdate not implemented")
+}
+func (UnimplementedASSETUPDATEServiceServer) mustEmbedUnimplementedASSETUPDATEServiceServer() {}
+func (UnimplementedASSETUPDATEServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeASSETUPDATEServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ASSETUPDATEServiceServer will
+// result in compilation errors.
+type UnsafeASSETUPDATEServiceServer interface {
+	mustEmbedUnimplementedASSETUPDATEServiceServer()
+}
+
+func RegisterASSETUPDATEServiceServer(s grpc.ServiceRegistrar, srv ASSETUPDATEServiceServer) {
+	// If the following call pancis, it indicates UnimplementedASSETUPDATEServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ASSETUPDATEService_ServiceDesc, srv)
+}
+
+func _ASSETUPDATEService_CreateAssetUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssetUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ASSETUPDATEServiceServer).CreateAssetUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ASSETUPDATEService_CreateAssetUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ASSETUPDATEServiceServer).CreateAssetUpdate(ctx, req.(*CreateAssetUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ASSETUPDATEService_ServiceDesc is the grpc.ServiceDesc for ASSETUPDATEService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ASSETUPDATEService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asset.ASSETUPDATEService",
+	HandlerType: (*ASSETUPDATEServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAssetUpdate",
+			Handler:    _ASSETUPDATEService_CreateAssetUpdate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "asset.proto",
+}
+
+const (
+	PERSONALRESPONSIBLEService_ListPersonalResponsible_FullMethodName = "/asset.PERSONALRESPONSIBLEService/ListPersonalResponsible"
+)
+
+// PERSONALRESPONSIBLEServiceClient is the client API for PERSONALRESPONSIBLEService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PERSONALRESPONSIBLEServiceClient interface {
+	ListPersonalResponsible(ctx context.Context, in *ListPersonalResponsibleRequest, opts ...grpc.CallOption) (*ListPersonalResponsibleResponse, error)
+}
+
+type pERSONALRESPONSIBLEServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPERSONALRESPONSIBLEServiceClient(cc grpc.ClientConnInterface) PERSONALRESPONSIBLEServiceClient {
+	return &pERSONALRESPONSIBLEServiceClient{cc}
+}
+
+func (c *pERSONALRESPONSIBLEServiceClient) ListPersonalResponsible(ctx context.Context, in *ListPersonalResponsibleRequest, opts ...grpc.CallOption) (*ListPersonalResponsibleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPersonalResponsibleResponse)
+	err := c.cc.Invoke(ctx, PERSONALRESPONSIBLEService_ListPersonalResponsible_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PERSONALRESPONSIBLEServiceServer is the server API for PERSONALRESPONSIBLEService service.
+// All implementations must embed UnimplementedPERSONALRESPONSIBLEServiceServer
+// for forward compatibility.
+type PERSONALRESPONSIBLEServiceServer interface {
+	ListPersonalResponsible(context.Context, *ListPersonalResponsibleRequest) (*ListPersonalResponsibleResponse, error)
+	mustEmbedUnimplementedPERSONALRESPONSIBLEServiceServer()
+}
+
+// UnimplementedPERSONALRESPONSIBLEServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPERSONALRESPONSIBLEServiceServer struct{}
+
+func (UnimplementedPERSONALRESPONSIBLEServiceServer) ListPersonalResponsible(context.Context, *ListPersonalResponsibleRequest) (*ListPersonalResponsibleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersonalResponsible not implemented")
+}
+func (UnimplementedPERSONALRESPONSIBLEServiceServer) mustEmbedUnimplementedPERSONALRESPONSIBLEServiceServer() {
+}
+func (UnimplementedPERSONALRESPONSIBLEServiceServer) testEmbeddedByValue() {}
+
+// UnsafePERSONALRESPONSIBLEServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PERSONALRESPONSIBLEServiceServer will
+// result in compilation errors.
+type UnsafePERSONALRESPONSIBLEServiceServer interface {
+	mustEmbedUnimplementedPERSONALRESPONSIBLEServiceServer()
+}
+
+func RegisterPERSONALRESPONSIBLEServiceServer(s grpc.ServiceRegistrar, srv PERSONALRESPONSIBLEServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPERSONALRESPONSIBLEServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&PERSONALRESPONSIBLEService_ServiceDesc, srv)
 }
 
-func _USERService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPasswordRequest)
+func _PERSONALRESPONSIBLEService_ListPersonalResponsible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonalResponsibleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(USERServiceServer).ResetPassword(ctx, in)
+		return srv.(PERSONALRESPONSIBLEServiceServer).ListPersonalResponsible(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: USERService_ResetPassword_FullMethodName,
+		FullMethod: PERSONALRESPONSIBLEService_ListPersonalResponsible_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(USERServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+		return srv.(PERSONALRESPONSIBLEServiceServer).ListPersonalResponsible(ctx, req.(*ListPersonalResponsibleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// USERService_ServiceDesc is the grpc.ServiceDesc for USERService service.
+// PERSONALRESPONSIBLEService_ServiceDesc is the grpc.ServiceDesc for PERSONALRESPONSIBLEService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var USERService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "asset.USERService",
-	HandlerType: (*USERServiceServer)(nil),
+var PERSONALRESPONSIBLEService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asset.PERSONALRESPONSIBLEService",
+	HandlerType: (*PERSONALRESPONSIBLEServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateUser",
-			Handler:    _USERService_CreateUser_Handler,
-		},
-		{
-			MethodName: "GetUser",
-			Handler:    _USERService_GetUser_Handler,
-		},
-		{
-			MethodName: "UpdateUser",
-			Handler:    _USERService_UpdateUser_Handler,
-		},
-		{
-			MethodName: "DeleteUser",
-			Handler:    _USERService_DeleteUser_Handler,
-		},
-		{
-			MethodName: "ListUsers",
-			Handler:    _USERService_ListUsers_Handler,
-		},
-		{
-			MethodName: "ResetPassword",
-			Handler:    _USERService_ResetPassword_Handler,
+			MethodName: "ListPersonalResponsible",
+			Handler:    _PERSONALRESPONSIBLEService_ListPersonalResponsible_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1804,367 +3713,431 @@ var USERService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	ASSETService_CreateAssets_FullMethodName      = "/asset.ASSETService/CreateAssets"
-	ASSETService_ListMstAssets_FullMethodName     = "/asset.ASSETService/ListMstAssets"
-	ASSETService_GetAsset_FullMethodName          = "/asset.ASSETService/GetAsset"
-	ASSETService_GetAssetByHash_FullMethodName    = "/asset.ASSETService/GetAssetByHash"
-	ASSETService_UpdateAsset_FullMethodName       = "/asset.ASSETService/UpdateAsset"
-	ASSETService_UpdateAssetStatus_FullMethodName = "/asset.ASSETService/UpdateAssetStatus"
-	ASSETService_DeleteAsset_FullMethodName       = "/asset.ASSETService/DeleteAsset"
-	ASSETService_ListAssets_FullMethodName        = "/asset.ASSETService/ListAssets"
+	CALENDARService_CreateCalendarFeed_FullMethodName = "/asset.CALENDARService/CreateCalendarFeed"
+	CALENDARService_ListCalendarFeeds_FullMethodName  = "/asset.CALENDARService/ListCalendarFeeds"
+	CALENDARService_RotateCalendarFeed_FullMethodName = "/asset.CALENDARService/RotateCalendarFeed"
+	CALENDARService_RevokeCalendarFeed_FullMethodName = "/asset.CALENDARService/RevokeCalendarFeed"
 )
 
-// ASSETServiceClient is the client API for ASSETService service.
+// CALENDARServiceClient is the client API for CALENDARService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type ASSETServiceClient interface {
-	CreateAssets(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error)
-	ListMstAssets(ctx context.Context, in *ListMstAssetsRequest, opts ...grpc.CallOption) (*ListMstAssetsResponse, error)
-	GetAsset(ctx context.Context, in *GetAssetRequest, opts ...grpc.CallOption) (*GetAssetResponse, error)
-	GetAssetByHash(ctx context.Context, in *GetAssetByHashRequest, opts ...grpc.CallOption) (*GetAssetByHashResponse, error)
-	UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*UpdateAssetResponse, error)
-	UpdateAssetStatus(ctx context.Context, in *UpdateAssetStatusRequest, opts ...grpc.CallOption) (*UpdateAssetStatusResponse, error)
-	DeleteAsset(ctx context.Context, in *DeleteAssetRequest, opts ...grpc.CallOption) (*DeleteAssetResponse, error)
-	ListAssets(ctx context.Context, in *ListAssetsRequest, opts ...grpc.CallOption) (*ListAssetsResponse, error)
+type CALENDARServiceClient interface {
+	CreateCalendarFeed(ctx context.Context, in *CreateCalendarFeedRequest, opts ...grpc.CallOption) (*CreateCalendarFeedResponse, error)
+	ListCalendarFeeds(ctx context.Context, in *ListCalendarFeedsRequest, opts ...grpc.CallOption) (*ListCalendarFeedsResponse, error)
+	RotateCalendarFeed(ctx context.Context, in *RotateCalendarFeedRequest, opts ...grpc.CallOption) (*RotateCalendarFeedResponse, error)
+	RevokeCalendarFeed(ctx context.Context, in *RevokeCalendarFeedRequest, opts ...grpc.CallOption) (*RevokeCalendarFeedResponse, error)
 }
 
-type aSSETServiceClient struct {
+type cALENDARServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewASSETServiceClient(cc grpc.ClientConnInterface) ASSETServiceClient {
-	return &aSSETServiceClient{cc}
-}
-
-func (c *aSSETServiceClient) CreateAssets(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateAssetResponse)
-	err := c.cc.Invoke(ctx, ASSETService_CreateAssets_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *aSSETServiceClient) ListMstAssets(ctx context.Context, in *ListMstAssetsRequest, opts ...grpc.CallOption) (*ListMstAssetsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListMstAssetsResponse)
-	err := c.cc.Invoke(ctx, ASSETService_ListMstAssets_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *aSSETServiceClient) GetAsset(ctx context.Context, in *GetAssetRequest, opts ...grpc.CallOption) (*GetAssetResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAssetResponse)
-	err := c.cc.Invoke(ctx, ASSETService_GetAsset_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *aSSETServiceClient) GetAssetByHash(ctx context.Context, in *GetAssetByHashRequest, opts ...grpc.CallOption) (*GetAssetByHashResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAssetByHashResponse)
-	err := c.cc.Invoke(ctx, ASSETService_GetAssetByHash_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewCALENDARServiceClient(cc grpc.ClientConnInterface) CALENDARServiceClient {
+	return &cALENDARServiceClient{cc}
 }
 
-func (c *aSSETServiceClient) UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*UpdateAssetResponse, error) {
+func (c *cALENDARServiceClient) CreateCalendarFeed(ctx context.Context, in *CreateCalendarFeedRequest, opts ...grpc.CallOption) (*CreateCalendarFeedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateAssetResponse)
-	err := c.cc.Invoke(ctx, ASSETService_UpdateAsset_FullMethodName, in, out, cOpts...)
+	out := new(CreateCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, CALENDARService_CreateCalendarFeed_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aSSETServiceClient) UpdateAssetStatus(ctx context.Context, in *UpdateAssetStatusRequest, opts ...grpc.CallOption) (*UpdateAssetStatusResponse, error) {
+func (c *cALENDARServiceClient) ListCalendarFeeds(ctx context.Context, in *ListCalendarFeedsRequest, opts ...grpc.CallOption) (*ListCalendarFeedsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateAssetStatusResponse)
-	err := c.cc.Invoke(ctx, ASSETService_UpdateAssetStatus_FullMethodName, in, out, cOpts...)
+	out := new(ListCalendarFeedsResponse)
+	err := c.cc.Invoke(ctx, CALENDARService_ListCalendarFeeds_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aSSETServiceClient) DeleteAsset(ctx context.Context, in *DeleteAssetRequest, opts ...grpc.CallOption) (*DeleteAssetResponse, error) {
+func (c *cALENDARServiceClient) RotateCalendarFeed(ctx context.Context, in *RotateCalendarFeedRequest, opts ...grpc.CallOption) (*RotateCalendarFeedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteAssetResponse)
-	err := c.cc.Invoke(ctx, ASSETService_DeleteAsset_FullMethodName, in, out, cOpts...)
+	out := new(RotateCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, CALENDARService_RotateCalendarFeed_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aSSETServiceClient) ListAssets(ctx context.Context, in *ListAssetsRequest, opts ...grpc.CallOption) (*ListAssetsResponse, error) {
+func (c *cALENDARServiceClient) RevokeCalendarFeed(ctx context.Context, in *RevokeCalendarFeedRequest, opts ...grpc.CallOption) (*RevokeCalendarFeedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListAssetsResponse)
-	err := c.cc.Invoke(ctx, ASSETService_ListAssets_FullMethodName, in, out, cOpts...)
+	out := new(RevokeCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, CALENDARService_RevokeCalendarFeed_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ASSETServiceServer is the server API for ASSETService service.
-// All implementations must embed UnimplementedASSETServiceServer
+// CALENDARServiceServer is the server API for CALENDARService service.
+// All implementations must embed UnimplementedCALENDARServiceServer
 // for forward compatibility.
-type ASSETServiceServer interface {
-	CreateAssets(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error)
-	ListMstAssets(context.Context, *ListMstAssetsRequest) (*ListMstAssetsResponse, error)
-	GetAsset(context.Context, *GetAssetRequest) (*GetAssetResponse, error)
-	GetAssetByHash(context.Context, *GetAssetByHashRequest) (*GetAssetByHashResponse, error)
-	UpdateAsset(context.Context, *UpdateAssetRequest) (*UpdateAssetResponse, error)
-	UpdateAssetStatus(context.Context, *UpdateAssetStatusRequest) (*UpdateAssetStatusResponse, error)
-	DeleteAsset(context.Context, *DeleteAssetRequest) (*DeleteAssetResponse, error)
-	ListAssets(context.Context, *ListAssetsRequest) (*ListAssetsResponse, error)
-	mustEmbedUnimplementedASSETServiceServer()
+type CALENDARServiceServer interface {
+	CreateCalendarFeed(context.Context, *CreateCalendarFeedRequest) (*CreateCalendarFeedResponse, error)
+	ListCalendarFeeds(context.Context, *ListCalendarFeedsRequest) (*ListCalendarFeedsResponse, error)
+	RotateCalendarFeed(context.Context, *RotateCalendarFeedRequest) (*RotateCalendarFeedResponse, error)
+	RevokeCalendarFeed(context.Context, *RevokeCalendarFeedRequest) (*RevokeCalendarFeedResponse, error)
+	mustEmbedUnimplementedCALENDARServiceServer()
 }
 
-// UnimplementedASSETServiceServer must be embedded to have
+// UnimplementedCALENDARServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedASSETServiceServer struct{}
+type UnimplementedCALENDARServiceServer struct{}
 
-func (UnimplementedASSETServiceServer) CreateAssets(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAssets not implemented")
-}
-func (UnimplementedASSETServiceServer) ListMstAssets(context.Context, *ListMstAssetsRequest) (*ListMstAssetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMstAssets not implemented")
-}
-func (UnimplementedASSETServiceServer) GetAsset(context.Context, *GetAssetRequest) (*GetAssetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAsset not implemented")
-}
-func (UnimplementedASSETServiceServer) GetAssetByHash(context.Context, *GetAssetByHashRequest) (*GetAssetByHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAssetByHash not implemented")
-}
-func (UnimplementedASSETServiceServer) UpdateAsset(context.Context, *UpdateAssetRequest) (*UpdateAssetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAsset not implemented")
+func (UnimplementedCALENDARServiceServer) CreateCalendarFeed(context.Context, *CreateCalendarFeedRequest) (*CreateCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendarFeed not implemented")
 }
-func (UnimplementedASSETServiceServer) UpdateAssetStatus(context.Context, *UpdateAssetStatusRequest) (*UpdateAssetStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssetStatus not implemented")
+func (UnimplementedCALENDARServiceServer) ListCalendarFeeds(context.Context, *ListCalendarFeedsRequest) (*ListCalendarFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCalendarFeeds not implemented")
 }
-func (UnimplementedASSETServiceServer) DeleteAsset(context.Context, *DeleteAssetRequest) (*DeleteAssetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAsset not implemented")
+func (UnimplementedCALENDARServiceServer) RotateCalendarFeed(context.Context, *RotateCalendarFeedRequest) (*RotateCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCalendarFeed not implemented")
 }
-func (UnimplementedASSETServiceServer) ListAssets(context.Context, *ListAssetsRequest) (*ListAssetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAssets not implemented")
+func (UnimplementedCALENDARServiceServer) RevokeCalendarFeed(context.Context, *RevokeCalendarFeedRequest) (*RevokeCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCalendarFeed not implemented")
 }
-func (UnimplementedASSETServiceServer) mustEmbedUnimplementedASSETServiceServer() {}
-func (UnimplementedASSETServiceServer) testEmbeddedByValue()                      {}
+func (UnimplementedCALENDARServiceServer) mustEmbedUnimplementedCALENDARServiceServer() {}
+func (UnimplementedCALENDARServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafeASSETServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ASSETServiceServer will
+// UnsafeCALENDARServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CALENDARServiceServer will
 // result in compilation errors.
-type UnsafeASSETServiceServer interface {
-	mustEmbedUnimplementedASSETServiceServer()
+type UnsafeCALENDARServiceServer interface {
+	mustEmbedUnimplementedCALENDARServiceServer()
 }
 
-func RegisterASSETServiceServer(s grpc.ServiceRegistrar, srv ASSETServiceServer) {
-	// If the following call pancis, it indicates UnimplementedASSETServiceServer was
+func RegisterCALENDARServiceServer(s grpc.ServiceRegistrar, srv CALENDARServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCALENDARServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&ASSETService_ServiceDesc, srv)
+	s.RegisterService(&CALENDARService_ServiceDesc, srv)
 }
 
-func _ASSETService_CreateAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAssetRequest)
+func _CALENDARService_CreateCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ASSETServiceServer).CreateAssets(ctx, in)
+		return srv.(CALENDARServiceServer).CreateCalendarFeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ASSETService_CreateAssets_FullMethodName,
+		FullMethod: CALENDARService_CreateCalendarFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ASSETServiceServer).CreateAssets(ctx, req.(*CreateAssetRequest))
+		return srv.(CALENDARServiceServer).CreateCalendarFeed(ctx, req.(*CreateCalendarFeedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ASSETService_ListMstAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMstAssetsRequest)
+func _CALENDARService_ListCalendarFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalendarFeedsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ASSETServiceServer).ListMstAssets(ctx, in)
+		return srv.(CALENDARServiceServer).ListCalendarFeeds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ASSETService_ListMstAssets_FullMethodName,
+		FullMethod: CALENDARService_ListCalendarFeeds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ASSETServiceServer).ListMstAssets(ctx, req.(*ListMstAssetsRequest))
+		return srv.(CALENDARServiceServer).ListCalendarFeeds(ctx, req.(*ListCalendarFeedsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ASSETService_GetAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAssetRequest)
+func _CALENDARService_RotateCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCalendarFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ASSETServiceServer).GetAsset(ctx, in)
+		return srv.(CALENDARServiceServer).RotateCalendarFeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ASSETService_GetAsset_FullMethodName,
+		FullMethod: CALENDARService_RotateCalendarFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ASSETServiceServer).GetAsset(ctx, req.(*GetAssetRequest))
+		return srv.(CALENDARServiceServer).RotateCalendarFeed(ctx, req.(*RotateCalendarFeedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ASSETService_GetAssetByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAssetByHashRequest)
+func _CALENDARService_RevokeCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCalendarFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ASSETServiceServer).GetAssetByHash(ctx, in)
+		return srv.(CALENDARServiceServer).RevokeCalendarFeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ASSETService_GetAssetByHash_FullMethodName,
+		FullMethod: CALENDARService_RevokeCalendarFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ASSETServiceServer).GetAssetByHash(ctx, req.(*GetAssetByHashRequest))
+		return srv.(CALENDARServiceServer).RevokeCalendarFeed(ctx, req.(*RevokeCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CALENDARService_ServiceDesc is the grpc.ServiceDesc for CALENDARService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CALENDARService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asset.CALENDARService",
+	HandlerType: (*CALENDARServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCalendarFeed",
+			Handler:    _CALENDARService_CreateCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ListCalendarFeeds",
+			Handler:    _CALENDARService_ListCalendarFeeds_Handler,
+		},
+		{
+			MethodName: "RotateCalendarFeed",
+			Handler:    _CALENDARService_RotateCalendarFeed_Handler,
+		},
+		{
+			MethodName: "RevokeCalendarFeed",
+			Handler:    _CALENDARService_RevokeCalendarFeed_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "asset.proto",
+}
+
+const (
+	ESCALATIONService_ListEscalationRules_FullMethodName  = "/asset.ESCALATIONService/ListEscalationRules"
+	ESCALATIONService_CreateEscalationRule_FullMethodName = "/asset.ESCALATIONService/CreateEscalationRule"
+	ESCALATIONService_UpdateEscalationRule_FullMethodName = "/asset.ESCALATIONService/UpdateEscalationRule"
+	ESCALATIONService_DeleteEscalationRule_FullMethodName = "/asset.ESCALATIONService/DeleteEscalationRule"
+)
+
+// ESCALATIONServiceClient is the client API for ESCALATIONService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ESCALATIONServiceClient interface {
+	ListEscalationRules(ctx context.Context, in *ListEscalationRulesRequest, opts ...grpc.CallOption) (*ListEscalationRulesResponse, error)
+	CreateEscalationRule(ctx context.Context, in *CreateEscalationRuleRequest, opts ...grpc.CallOption) (*CreateEscalationRuleResponse, error)
+	UpdateEscalationRule(ctx context.Context, in *UpdateEscalationRuleRequest, opts ...grpc.CallOption) (*UpdateEscalationRuleResponse, error)
+	DeleteEscalationRule(ctx context.Context, in *DeleteEscalationRuleRequest, opts ...grpc.CallOption) (*DeleteEscalationRuleResponse, error)
+}
+
+type eSCALATIONServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewESCALATIONServiceClient(cc grpc.ClientConnInterface) ESCALATIONServiceClient {
+	return &eSCALATIONServiceClient{cc}
+}
+
+func (c *eSCALATIONServiceClient) ListEscalationRules(ctx context.Context, in *ListEscalationRulesRequest, opts ...grpc.CallOption) (*ListEscalationRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEscalationRulesResponse)
+	err := c.cc.Invoke(ctx, ESCALATIONService_ListEscalationRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eSCALATIONServiceClient) CreateEscalationRule(ctx context.Context, in *CreateEscalationRuleRequest, opts ...grpc.CallOption) (*CreateEscalationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEscalationRuleResponse)
+	err := c.cc.Invoke(ctx, ESCALATIONService_CreateEscalationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eSCALATIONServiceClient) UpdateEscalationRule(ctx context.Context, in *UpdateEscalationRuleRequest, opts ...grpc.CallOption) (*UpdateEscalationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEscalationRuleResponse)
+	err := c.cc.Invoke(ctx, ESCALATIONService_UpdateEscalationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eSCALATIONServiceClient) DeleteEscalationRule(ctx context.Context, in *DeleteEscalationRuleRequest, opts ...grpc.CallOption) (*DeleteEscalationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEscalationRuleResponse)
+	err := c.cc.Invoke(ctx, ESCALATIONService_DeleteEscalationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ESCALATIONServiceServer is the server API for ESCALATIONService service.
+// All implementations must embed UnimplementedESCALATIONServiceServer
+// for forward compatibility.
+type ESCALATIONServiceServer interface {
+	ListEscalationRules(context.Context, *ListEscalationRulesRequest) (*ListEscalationRulesResponse, error)
+	CreateEscalationRule(context.Context, *CreateEscalationRuleRequest) (*CreateEscalationRuleResponse, error)
+	UpdateEscalationRule(context.Context, *UpdateEscalationRuleRequest) (*UpdateEscalationRuleResponse, error)
+	DeleteEscalationRule(context.Context, *DeleteEscalationRuleRequest) (*DeleteEscalationRuleResponse, error)
+	mustEmbedUnimplementedESCALATIONServiceServer()
+}
+
+// UnimplementedESCALATIONServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedESCALATIONServiceServer struct{}
+
+func (UnimplementedESCALATIONServiceServer) ListEscalationRules(context.Context, *ListEscalationRulesRequest) (*ListEscalationRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEscalationRules not implemented")
+}
+func (UnimplementedESCALATIONServiceServer) CreateEscalationRule(context.Context, *CreateEscalationRuleRequest) (*CreateEscalationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEscalationRule not implemented")
+}
+func (UnimplementedESCALATIONServiceServer) UpdateEscalationRule(context.Context, *UpdateEscalationRuleRequest) (*UpdateEscalationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEscalationRule not implemented")
+}
+func (UnimplementedESCALATIONServiceServer) DeleteEscalationRule(context.Context, *DeleteEscalationRuleRequest) (*DeleteEscalationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEscalationRule not implemented")
+}
+func (UnimplementedESCALATIONServiceServer) mustEmbedUnimplementedESCALATIONServiceServer() {}
+func (UnimplementedESCALATIONServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeESCALATIONServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ESCALATIONServiceServer will
+// result in compilation errors.
+type UnsafeESCALATIONServiceServer interface {
+	mustEmbedUnimplementedESCALATIONServiceServer()
+}
+
+func RegisterESCALATIONServiceServer(s grpc.ServiceRegistrar, srv ESCALATIONServiceServer) {
+	// If the following call pancis, it indicates UnimplementedESCALATIONServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&ESCALATIONService_ServiceDesc, srv)
 }
 
-func _ASSETService_UpdateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAssetRequest)
+func _ESCALATIONService_ListEscalationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEscalationRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ASSETServiceServer).UpdateAsset(ctx, in)
+		return srv.(ESCALATIONServiceServer).ListEscalationRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ASSETService_UpdateAsset_FullMethodName,
+		FullMethod: ESCALATIONService_ListEscalationRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ASSETServiceServer).UpdateAsset(ctx, req.(*UpdateAssetRequest))
+		return srv.(ESCALATIONServiceServer).ListEscalationRules(ctx, req.(*ListEscalationRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ASSETService_UpdateAssetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAssetStatusRequest)
+func _ESCALATIONService_CreateEscalationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEscalationRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ASSETServiceServer).UpdateAssetStatus(ctx, in)
+		return srv.(ESCALATIONServiceServer).CreateEscalationRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ASSETService_UpdateAssetStatus_FullMethodName,
+		FullMethod: ESCALATIONService_CreateEscalationRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ASSETServiceServer).UpdateAssetStatus(ctx, req.(*UpdateAssetStatusRequest))
+		return srv.(ESCALATIONServiceServer).CreateEscalationRule(ctx, req.(*CreateEscalationRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ASSETService_DeleteAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAssetRequest)
+func _ESCALATIONService_UpdateEscalationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEscalationRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ASSETServiceServer).DeleteAsset(ctx, in)
+		return srv.(ESCALATIONServiceServer).UpdateEscalationRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ASSETService_DeleteAsset_FullMethodName,
+		FullMethod: ESCALATIONService_UpdateEscalationRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ASSETServiceServer).DeleteAsset(ctx, req.(*DeleteAssetRequest))
+		return srv.(ESCALATIONServiceServer).UpdateEscalationRule(ctx, req.(*UpdateEscalationRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ASSETService_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAssetsRequest)
+func _ESCALATIONService_DeleteEscalationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEscalationRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ASSETServiceServer).ListAssets(ctx, in)
+		return srv.(ESCALATIONServiceServer).DeleteEscalationRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ASSETService_ListAssets_FullMethodName,
+		FullMethod: ESCALATIONService_DeleteEscalationRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ASSETServiceServer).ListAssets(ctx, req.(*ListAssetsRequest))
+		return srv.(ESCALATIONServiceServer).DeleteEscalationRule(ctx, req.(*DeleteEscalationRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ASSETService_ServiceDesc is the grpc.ServiceDesc for ASSETService service.
+// ESCALATIONService_ServiceDesc is the grpc.ServiceDesc for ESCALATIONService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var ASSETService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "asset.ASSETService",
-	HandlerType: (*ASSETServiceServer)(nil),
+var ESCALATIONService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asset.ESCALATIONService",
+	HandlerType: (*ESCALATIONServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateAssets",
-			Handler:    _ASSETService_CreateAssets_Handler,
-		},
-		{
-			MethodName: "ListMstAssets",
-			Handler:    _ASSETService_ListMstAssets_Handler,
-		},
-		{
-			MethodName: "GetAsset",
-			Handler:    _ASSETService_GetAsset_Handler,
-		},
-		{
-			MethodName: "GetAssetByHash",
-			Handler:    _ASSETService_GetAssetByHash_Handler,
-		},
-		{
-			MethodName: "UpdateAsset",
-			Handler:    _ASSETService_UpdateAsset_Handler,
+			MethodName: "ListEscalationRules",
+			Handler:    _ESCALATIONService_ListEscalationRules_Handler,
 		},
 		{
-			MethodName: "UpdateAssetStatus",
-			Handler:    _ASSETService_UpdateAssetStatus_Handler,
+			MethodName: "CreateEscalationRule",
+			Handler:    _ESCALATIONService_CreateEscalationRule_Handler,
 		},
 		{
-			MethodName: "DeleteAsset",
-			Handler:    _ASSETService_DeleteAsset_Handler,
+			MethodName: "UpdateEscalationRule",
+			Handler:    _ESCALATIONService_UpdateEscalationRule_Handler,
 		},
 		{
-			MethodName: "ListAssets",
-			Handler:    _ASSETService_ListAssets_Handler,
+			MethodName: "DeleteEscalationRule",
+			Handler:    _ESCALATIONService_DeleteEscalationRule_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2172,101 +4145,216 @@ var ASSETService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	ASSETUPDATEService_CreateAssetUpdate_FullMethodName = "/asset.ASSETUPDATEService/CreateAssetUpdate"
+	NOTIFICATIONRULEService_ListNotificationRules_FullMethodName  = "/asset.NOTIFICATIONRULEService/ListNotificationRules"
+	NOTIFICATIONRULEService_CreateNotificationRule_FullMethodName = "/asset.NOTIFICATIONRULEService/CreateNotificationRule"
+	NOTIFICATIONRULEService_UpdateNotificationRule_FullMethodName = "/asset.NOTIFICATIONRULEService/UpdateNotificationRule"
+	NOTIFICATIONRULEService_DeleteNotificationRule_FullMethodName = "/asset.NOTIFICATIONRULEService/DeleteNotificationRule"
 )
 
-// ASSETUPDATEServiceClient is the client API for ASSETUPDATEService service.
+// NOTIFICATIONRULEServiceClient is the client API for NOTIFICATIONRULEService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type ASSETUPDATEServiceClient interface {
-	CreateAssetUpdate(ctx context.Context, in *CreateAssetUpdateRequest, opts ...grpc.CallOption) (*CreateAssetUpdateResponse, error)
+type NOTIFICATIONRULEServiceClient interface {
+	ListNotificationRules(ctx context.Context, in *ListNotificationRulesRequest, opts ...grpc.CallOption) (*ListNotificationRulesResponse, error)
+	CreateNotificationRule(ctx context.Context, in *CreateNotificationRuleRequest, opts ...grpc.CallOption) (*CreateNotificationRuleResponse, error)
+	UpdateNotificationRule(ctx context.Context, in *UpdateNotificationRuleRequest, opts ...grpc.CallOption) (*UpdateNotificationRuleResponse, error)
+	DeleteNotificationRule(ctx context.Context, in *DeleteNotificationRuleRequest, opts ...grpc.CallOption) (*DeleteNotificationRuleResponse, error)
 }
 
-type aSSETUPDATEServiceClient struct {
+type nOTIFICATIONRULEServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewASSETUPDATEServiceClient(cc grpc.ClientConnInterface) ASSETUPDATEServiceClient {
-	return &aSSETUPDATEServiceClient{cc}
+func NewNOTIFICATIONRULEServiceClient(cc grpc.ClientConnInterface) NOTIFICATIONRULEServiceClient {
+	return &nOTIFICATIONRULEServiceClient{cc}
 }
 
-func (c *aSSETUPDATEServiceClient) CreateAssetUpdate(ctx context.Context, in *CreateAssetUpdateRequest, opts ...grpc.CallOption) (*CreateAssetUpdateResponse, error) {
+func (c *nOTIFICATIONRULEServiceClient) ListNotificationRules(ctx context.Context, in *ListNotificationRulesRequest, opts ...grpc.CallOption) (*ListNotificationRulesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateAssetUpdateResponse)
-	err := c.cc.Invoke(ctx, ASSETUPDATEService_CreateAssetUpdate_FullMethodName, in, out, cOpts...)
+	out := new(ListNotificationRulesResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONRULEService_ListNotificationRules_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ASSETUPDATEServiceServer is the server API for ASSETUPDATEService service.
-// All implementations must embed UnimplementedASSETUPDATEServiceServer
+func (c *nOTIFICATIONRULEServiceClient) CreateNotificationRule(ctx context.Context, in *CreateNotificationRuleRequest, opts ...grpc.CallOption) (*CreateNotificationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNotificationRuleResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONRULEService_CreateNotificationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONRULEServiceClient) UpdateNotificationRule(ctx context.Context, in *UpdateNotificationRuleRequest, opts ...grpc.CallOption) (*UpdateNotificationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotificationRuleResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONRULEService_UpdateNotificationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nOTIFICATIONRULEServiceClient) DeleteNotificationRule(ctx context.Context, in *DeleteNotificationRuleRequest, opts ...grpc.CallOption) (*DeleteNotificationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNotificationRuleResponse)
+	err := c.cc.Invoke(ctx, NOTIFICATIONRULEService_DeleteNotificationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NOTIFICATIONRULEServiceServer is the server API for NOTIFICATIONRULEService service.
+// All implementations must embed UnimplementedNOTIFICATIONRULEServiceServer
 // for forward compatibility.
-type ASSETUPDATEServiceServer interface {
-	CreateAssetUpdate(context.Context, *CreateAssetUpdateRequest) (*CreateAssetUpdateResponse, error)
-	mustEmbedUnimplementedASSETUPDATEServiceServer()
+type NOTIFICATIONRULEServiceServer interface {
+	ListNotificationRules(context.Context, *ListNotificationRulesRequest) (*ListNotificationRulesResponse, error)
+	CreateNotificationRule(context.Context, *CreateNotificationRuleRequest) (*CreateNotificationRuleResponse, error)
+	UpdateNotificationRule(context.Context, *UpdateNotificationRuleRequest) (*UpdateNotificationRuleResponse, error)
+	DeleteNotificationRule(context.Context, *DeleteNotificationRuleRequest) (*DeleteNotificationRuleResponse, error)
+	mustEmbedUnimplementedNOTIFICATIONRULEServiceServer()
 }
 
-// UnimplementedASSETUPDATEServiceServer must be embedded to have
+// UnimplementedNOTIFICATIONRULEServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedASSETUPDATEServiceServer struct{}
+type UnimplementedNOTIFICATIONRULEServiceServer struct{}
 
-func (UnimplementedASSETUPDATEServiceServer) CreateAssetUpdate(context.Context, *CreateAssetUpdateRequest) (*CreateAssetUpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAssetUpdate not implemented")
+func (UnimplementedNOTIFICATIONRULEServiceServer) ListNotificationRules(context.Context, *ListNotificationRulesRequest) (*ListNotificationRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationRules not implemented")
 }
-func (UnimplementedASSETUPDATEServiceServer) mustEmbedUnimplementedASSETUPDATEServiceServer() {}
-func (UnimplementedASSETUPDATEServiceServer) testEmbeddedByValue()                            {}
+func (UnimplementedNOTIFICATIONRULEServiceServer) CreateNotificationRule(context.Context, *CreateNotificationRuleRequest) (*CreateNotificationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotificationRule not implemented")
+}
+func (UnimplementedNOTIFICATIONRULEServiceServer) UpdateNotificationRule(context.Context, *UpdateNotificationRuleRequest) (*UpdateNotificationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationRule not implemented")
+}
+func (UnimplementedNOTIFICATIONRULEServiceServer) DeleteNotificationRule(context.Context, *DeleteNotificationRuleRequest) (*DeleteNotificationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotificationRule not implemented")
+}
+func (UnimplementedNOTIFICATIONRULEServiceServer) mustEmbedUnimplementedNOTIFICATIONRULEServiceServer() {
+}
+func (UnimplementedNOTIFICATIONRULEServiceServer) testEmbeddedByValue() {}
 
-// UnsafeASSETUPDATEServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ASSETUPDATEServiceServer will
+// UnsafeNOTIFICATIONRULEServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NOTIFICATIONRULEServiceServer will
 // result in compilation errors.
-type UnsafeASSETUPDATEServiceServer interface {
-	mustEmbedUnimplementedASSETUPDATEServiceServer()
+type UnsafeNOTIFICATIONRULEServiceServer interface {
+	mustEmbedUnimplementedNOTIFICATIONRULEServiceServer()
 }
 
-func RegisterASSETUPDATEServiceServer(s grpc.ServiceRegistrar, srv ASSETUPDATEServiceServer) {
-	// If the following call pancis, it indicates UnimplementedASSETUPDATEServiceServer was
+func RegisterNOTIFICATIONRULEServiceServer(s grpc.ServiceRegistrar, srv NOTIFICATIONRULEServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNOTIFICATIONRULEServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&ASSETUPDATEService_ServiceDesc, srv)
+	s.RegisterService(&NOTIFICATIONRULEService_ServiceDesc, srv)
 }
 
-func _ASSETUPDATEService_CreateAssetUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAssetUpdateRequest)
+func _NOTIFICATIONRULEService_ListNotificationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ASSETUPDATEServiceServer).CreateAssetUpdate(ctx, in)
+		return srv.(NOTIFICATIONRULEServiceServer).ListNotificationRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ASSETUPDATEService_CreateAssetUpdate_FullMethodName,
+		FullMethod: NOTIFICATIONRULEService_ListNotificationRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ASSETUPDATEServiceServer).CreateAssetUpdate(ctx, req.(*CreateAssetUpdateRequest))
+		return srv.(NOTIFICATIONRULEServiceServer).ListNotificationRules(ctx, req.(*ListNotificationRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ASSETUPDATEService_ServiceDesc is the grpc.ServiceDesc for ASSETUPDATEService service.
+func _NOTIFICATIONRULEService_CreateNotificationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONRULEServiceServer).CreateNotificationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONRULEService_CreateNotificationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONRULEServiceServer).CreateNotificationRule(ctx, req.(*CreateNotificationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONRULEService_UpdateNotificationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONRULEServiceServer).UpdateNotificationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONRULEService_UpdateNotificationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONRULEServiceServer).UpdateNotificationRule(ctx, req.(*UpdateNotificationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NOTIFICATIONRULEService_DeleteNotificationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NOTIFICATIONRULEServiceServer).DeleteNotificationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NOTIFICATIONRULEService_DeleteNotificationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NOTIFICATIONRULEServiceServer).DeleteNotificationRule(ctx, req.(*DeleteNotificationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NOTIFICATIONRULEService_ServiceDesc is the grpc.ServiceDesc for NOTIFICATIONRULEService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var ASSETUPDATEService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "asset.ASSETUPDATEService",
-	HandlerType: (*ASSETUPDATEServiceServer)(nil),
+var NOTIFICATIONRULEService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asset.NOTIFICATIONRULEService",
+	HandlerType: (*NOTIFICATIONRULEServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateAssetUpdate",
-			Handler:    _ASSETUPDATEService_CreateAssetUpdate_Handler,
+			MethodName: "ListNotificationRules",
+			Handler:    _NOTIFICATIONRULEService_ListNotificationRules_Handler,
+		},
+		{
+			MethodName: "CreateNotificationRule",
+			Handler:    _NOTIFICATIONRULEService_CreateNotificationRule_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationRule",
+			Handler:    _NOTIFICATIONRULEService_UpdateNotificationRule_Handler,
+		},
+		{
+			MethodName: "DeleteNotificationRule",
+			Handler:    _NOTIFICATIONRULEService_DeleteNotificationRule_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2274,102 +4362,177 @@ var ASSETUPDATEService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	PERSONALRESPONSIBLEService_ListPersonalResponsible_FullMethodName = "/asset.PERSONALRESPONSIBLEService/ListPersonalResponsible"
+	APIKEYService_ListAPIKeys_FullMethodName  = "/asset.APIKEYService/ListAPIKeys"
+	APIKEYService_CreateAPIKey_FullMethodName = "/asset.APIKEYService/CreateAPIKey"
+	APIKEYService_RevokeAPIKey_FullMethodName = "/asset.APIKEYService/RevokeAPIKey"
 )
 
-// PERSONALRESPONSIBLEServiceClient is the client API for PERSONALRESPONSIBLEService service.
+// APIKEYServiceClient is the client API for APIKEYService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type PERSONALRESPONSIBLEServiceClient interface {
-	ListPersonalResponsible(ctx context.Context, in *ListPersonalResponsibleRequest, opts ...grpc.CallOption) (*ListPersonalResponsibleResponse, error)
+type APIKEYServiceClient interface {
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
 }
 
-type pERSONALRESPONSIBLEServiceClient struct {
+type aPIKEYServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewPERSONALRESPONSIBLEServiceClient(cc grpc.ClientConnInterface) PERSONALRESPONSIBLEServiceClient {
-	return &pERSONALRESPONSIBLEServiceClient{cc}
+func NewAPIKEYServiceClient(cc grpc.ClientConnInterface) APIKEYServiceClient {
+	return &aPIKEYServiceClient{cc}
 }
 
-func (c *pERSONALRESPONSIBLEServiceClient) ListPersonalResponsible(ctx context.Context, in *ListPersonalResponsibleRequest, opts ...grpc.CallOption) (*ListPersonalResponsibleResponse, error) {
+func (c *aPIKEYServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListPersonalResponsibleResponse)
-	err := c.cc.Invoke(ctx, PERSONALRESPONSIBLEService_ListPersonalResponsible_FullMethodName, in, out, cOpts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, APIKEYService_ListAPIKeys_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PERSONALRESPONSIBLEServiceServer is the server API for PERSONALRESPONSIBLEService service.
-// All implementations must embed UnimplementedPERSONALRESPONSIBLEServiceServer
+func (c *aPIKEYServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, APIKEYService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKEYServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, APIKEYService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIKEYServiceServer is the server API for APIKEYService service.
+// All implementations must embed UnimplementedAPIKEYServiceServer
 // for forward compatibility.
-type PERSONALRESPONSIBLEServiceServer interface {
-	ListPersonalResponsible(context.Context, *ListPersonalResponsibleRequest) (*ListPersonalResponsibleResponse, error)
-	mustEmbedUnimplementedPERSONALRESPONSIBLEServiceServer()
+type APIKEYServiceServer interface {
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	mustEmbedUnimplementedAPIKEYServiceServer()
 }
 
-// UnimplementedPERSONALRESPONSIBLEServiceServer must be embedded to have
+// UnimplementedAPIKEYServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedPERSONALRESPONSIBLEServiceServer struct{}
+type UnimplementedAPIKEYServiceServer struct{}
 
-func (UnimplementedPERSONALRESPONSIBLEServiceServer) ListPersonalResponsible(context.Context, *ListPersonalResponsibleRequest) (*ListPersonalResponsibleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPersonalResponsible not implemented")
+func (UnimplementedAPIKEYServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
 }
-func (UnimplementedPERSONALRESPONSIBLEServiceServer) mustEmbedUnimplementedPERSONALRESPONSIBLEServiceServer() {
+func (UnimplementedAPIKEYServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
 }
-func (UnimplementedPERSONALRESPONSIBLEServiceServer) testEmbeddedByValue() {}
+func (UnimplementedAPIKEYServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedAPIKEYServiceServer) mustEmbedUnimplementedAPIKEYServiceServer() {}
+func (UnimplementedAPIKEYServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafePERSONALRESPONSIBLEServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PERSONALRESPONSIBLEServiceServer will
+// UnsafeAPIKEYServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIKEYServiceServer will
 // result in compilation errors.
-type UnsafePERSONALRESPONSIBLEServiceServer interface {
-	mustEmbedUnimplementedPERSONALRESPONSIBLEServiceServer()
+type UnsafeAPIKEYServiceServer interface {
+	mustEmbedUnimplementedAPIKEYServiceServer()
 }
 
-func RegisterPERSONALRESPONSIBLEServiceServer(s grpc.ServiceRegistrar, srv PERSONALRESPONSIBLEServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPERSONALRESPONSIBLEServiceServer was
+func RegisterAPIKEYServiceServer(s grpc.ServiceRegistrar, srv APIKEYServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAPIKEYServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&PERSONALRESPONSIBLEService_ServiceDesc, srv)
+	s.RegisterService(&APIKEYService_ServiceDesc, srv)
 }
 
-func _PERSONALRESPONSIBLEService_ListPersonalResponsible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPersonalResponsibleRequest)
+func _APIKEYService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PERSONALRESPONSIBLEServiceServer).ListPersonalResponsible(ctx, in)
+		return srv.(APIKEYServiceServer).ListAPIKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PERSONALRESPONSIBLEService_ListPersonalResponsible_FullMethodName,
+		FullMethod: APIKEYService_ListAPIKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PERSONALRESPONSIBLEServiceServer).ListPersonalResponsible(ctx, req.(*ListPersonalResponsibleRequest))
+		return srv.(APIKEYServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PERSONALRESPONSIBLEService_ServiceDesc is the grpc.ServiceDesc for PERSONALRESPONSIBLEService service.
+func _APIKEYService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKEYServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKEYService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKEYServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKEYService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKEYServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKEYService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKEYServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// APIKEYService_ServiceDesc is the grpc.ServiceDesc for APIKEYService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var PERSONALRESPONSIBLEService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "asset.PERSONALRESPONSIBLEService",
-	HandlerType: (*PERSONALRESPONSIBLEServiceServer)(nil),
+var APIKEYService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "asset.APIKEYService",
+	HandlerType: (*APIKEYServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "ListPersonalResponsible",
-			Handler:    _PERSONALRESPONSIBLEService_ListPersonalResponsible_Handler,
+			MethodName: "ListAPIKeys",
+			Handler:    _APIKEYService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _APIKEYService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _APIKEYService_RevokeAPIKey_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2,23 +2,41 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
+	"asset-management-api/app/admin"
 	"asset-management-api/app/auth"
+	"asset-management-api/app/calendar"
+	"asset-management-api/app/config"
 	"asset-management-api/app/database"
+	"asset-management-api/app/delivery"
+	"asset-management-api/app/digest"
+	"asset-management-api/app/health"
+	"asset-management-api/app/lifecycle"
+	"asset-management-api/app/migrate"
+	"asset-management-api/app/realtime"
+	"asset-management-api/app/repository"
+	"asset-management-api/app/rules"
+	"asset-management-api/app/scheduler"
 	"asset-management-api/app/services"
+	"asset-management-api/app/userimport"
 	"asset-management-api/app/utils"
 	"asset-management-api/assetpb"
 
 	"github.com/gin-gonic/gin"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	"github.com/joho/godotenv"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 )
 
 func main() {
@@ -26,66 +44,227 @@ func main() {
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 
-	// Initialize database
-	db := database.DBConn()
+	// Load configuration; invalid or missing settings stop startup
+	cfg := config.MustLoad()
+
+	// Initialize database. This is the only pool; everything below receives it
+	db := database.DBConn(cfg.Database)
+
+	// Subcommands ("server migrate up", "server user create ...") run and exit
+	if len(os.Args) > 1 {
+		runCommand(cfg, db, os.Args[1:])
+		return
+	}
+	warnPendingMigrations(db)
+
+	// Notification delivery over email, webhook and chat
+	dispatcher := delivery.NewDispatcher(db, delivery.ChannelsFromConfig(cfg.Delivery)...)
+	dispatcher.MaxAttempts = cfg.Delivery.MaxAttempts
+	dispatcher.Backoff = cfg.Delivery.Backoff
+
+	// Data access shared by the services
+	assets := repository.NewAssetRepository(db)
+	submissions := repository.NewSubmissionRepository(db)
+	notifications := repository.NewNotificationRepository(db)
+	users := repository.NewUserRepository(db)
+
+	// Token signing keys; the previous ones keep verifying during a rotation
+	keys, err := auth.NewKeyManager(cfg.Auth)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load JWT keys")
+	}
+	// Short-lived access tokens and rotating refresh tokens, one family per
+	// login session
+	tokens := auth.NewTokenIssuer(db, cfg.Auth, keys)
+	// Passwords users choose must pass the policy and not repeat recent ones
+	passwords, err := auth.NewPasswords(db, cfg.Passwords)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load password policy")
+	}
+	// Failed logins are throttled per account and address, and audited
+	loginGuard := auth.NewLoginGuard(db, cfg.Auth)
+	// Passwords are checked locally and/or against LDAP, single sign-on goes
+	// through OIDC, and directory users are matched to local ones
+	providers, err := auth.NewProviders(db, cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to set up login providers")
+	}
+	authService := services.NewAuthService(db, tokens, loginGuard, auth.NewTwoFactor(db, cfg.Auth), providers, passwords)
+	// Hashed, scoped keys for the REST server
+	apiKeys := auth.NewAPIKeys(db)
+
+	// Admin-defined notification rules, evaluated on asset writes and hourly
+	ruleEngine := rules.NewEngine(db, dispatcher)
+
+	// Periodic jobs: digest emails go out hourly to whoever is due, late
+	// notifications are escalated according to the escalation rules, and the
+	// notification rules are checked against every asset; expired and revoked
+	// sessions, stale login counters and old login audit entries are purged
+	jobs := scheduler.New()
+	digests := digest.NewGenerator(db, dispatcher, cfg.Server.AppBaseURL, cfg.Server.APIBaseURL)
+	jobs.Every("notification-digest", time.Hour, digests.Run)
+	escalations := services.NewEscalationService(db, users, dispatcher)
+	jobs.Every("notification-escalation", time.Hour, escalations.EscalateLateNotifications)
+	jobs.Every("notification-rules", time.Hour, ruleEngine.Sweep)
+	jobs.Every("session-cleanup", time.Hour, authService.CleanupSessions)
+	jobs.Every("login-cleanup", time.Hour, loginGuard.Cleanup)
+
+	// The notification hub feeds streaming clients
+	hub := realtime.NewHub(db)
+	// Notifications and rule evaluations that requests hand off
+	tasks := lifecycle.NewTasks()
+
+	userService := services.NewUserService(users, tokens, passwords, dispatcher, tasks, cfg.Server.AppBaseURL)
+	userService.ClosedSubmissionStatuses = cfg.Submissions.ClosedStatuses
+	userService.Importer = userimport.NewImporter(db)
 
 	// Create services
 	servicesList := []services.InterfaceService{
-		services.NewAuthService(db),
-		services.NewAssetService(db),
-		services.NewUserService(db),
+		authService,
+		services.NewAssetService(assets, ruleEngine, tasks),
+		userService,
 		services.NewAreaService(db),
 		services.NewOutletService(db),
 		services.NewClassificationService(db),
 		services.NewMaintenancePeriodService(db),
 		services.NewRoleService(db),
 		services.NewPersonalResponsibleService(db),
-		services.NewSubmissionService(db),
-		services.NewNotificationService(db),
+		services.NewSubmissionService(submissions, assets, users, dispatcher, tasks),
+		services.NewNotificationService(db, notifications, assets, submissions, users, hub, dispatcher, tasks),
+		services.NewCalendarService(db, cfg.Server.APIBaseURL),
+		escalations,
+		services.NewNotificationRuleService(db, ruleEngine),
+		services.NewAPIKeyService(apiKeys),
         services.NewPositionService(db),
 	}
 
-	// Start the gRPC server
-	go startGRPCServer(servicesList)
+	// Readiness depends on the database, file storage and the job scheduler
+	nextcloud := utils.NewNextcloud(cfg.Nextcloud)
+	monitor := health.NewMonitor()
+	monitor.AddCheck("database", db.Ping)
+	if cfg.Nextcloud.APIEndpoint != "" {
+		monitor.AddCheck("nextcloud", nextcloud.Ping)
+	}
+	monitor.AddCheck("scheduler", func(ctx context.Context) error {
+		if !jobs.Started() {
+			return errors.New("scheduler is not running")
+		}
+		return nil
+	})
+
+	grpcServer := newGRPCServer(tokens, servicesList)
+	monitor.Register(grpcServer)
 
-	// Start the HTTP server
-	go startRESTServer()
+	gateway, err := newHTTPGateway(cfg.Server, db, monitor, keys)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to set up HTTP gateway")
+	}
 
-	// Start the HTTP Gateway
-	startHTTPGateway()
+	// The servers and workers run until SIGTERM or until one of the servers
+	// fails; either way all of them are stopped before the pool is closed
+	app := lifecycle.New(cfg.Server.ShutdownTimeout)
+	app.GRPC("gRPC", grpcServer, cfg.Server.GRPCAddr)
+	app.HTTP("HTTP gateway", gateway)
+	app.HTTP("REST server", newRESTServer(cfg, assets, nextcloud, apiKeys))
+	app.Go("scheduler", jobs.Run)
+	app.Go("notification hub", hub.Run)
+	app.Go("session revocations", tokens.Revocations.Run)
+	app.Go("health checks", monitor.Run)
+	app.Go("background tasks", tasks.Run)
+	app.OnShutdown(monitor.Drain)
+	app.OnClose(db.Close)
+
+	if err := app.Run(context.Background()); err != nil {
+		log.Fatal().Err(err).Msg("Server stopped")
+	}
 }
 
-func startGRPCServer(servicesList []services.InterfaceService) {
-	lis, err := net.Listen("tcp", ":50053")
-	if err != nil {
-		log.Fatal().Err(err).Msg("Failed to listen")
+// runCommand runs a migrate or admin subcommand, exiting non-zero on failure.
+func runCommand(cfg config.Config, db *pgxpool.Pool, args []string) {
+	ctx := context.Background()
+	switch {
+	case args[0] == "migrate":
+		if err := migrate.Command(ctx, db, args[1:], os.Stdout); err != nil {
+			log.Fatal().Err(err).Msg("Migration failed")
+		}
+	case admin.IsCommand(args[0]):
+		keys, err := auth.NewKeyManager(cfg.Auth)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to load JWT keys")
+		}
+		passwords, err := auth.NewPasswords(db, cfg.Passwords)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to load password policy")
+		}
+		if err := admin.Command(ctx, admin.Env{Config: cfg, DB: db, Keys: keys, Passwords: passwords}, args, os.Stdout); err != nil {
+			log.Fatal().Err(err).Msgf("%s command failed", args[0])
+		}
+	default:
+		log.Fatal().Msgf("Unknown command %q; see \"server migrate\" and \"server user\"", args[0])
 	}
+}
 
-	// Load environment variables
-	err = godotenv.Load()
+// warnPendingMigrations logs unapplied migrations; the server still starts so
+// that a rolling deploy can migrate after the new binary is out.
+func warnPendingMigrations(db *pgxpool.Pool) {
+	m, err := migrate.New(db)
 	if err != nil {
-		log.Fatal().Err(err).Msg("Error loading .env file")
+		log.Fatal().Err(err).Msg("Invalid embedded migrations")
 	}
-	jwtSecret := os.Getenv("JWT_SECRET")
+	pending, err := m.Pending(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check database migrations")
+		return
+	}
+	if len(pending) > 0 {
+		log.Warn().Msgf("%d database migrations are pending, run \"server migrate up\"", len(pending))
+	}
+}
 
+func newGRPCServer(tokens *auth.TokenIssuer, servicesList []services.InterfaceService) *grpc.Server {
 	// Add JWT middleware to the gRPC server
-	excludedMethods := []string{"/asset.AUTHService/Login"}
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.JWTAuthMiddleware(jwtSecret, excludedMethods)))
+	excludedMethods := []string{
+		"/asset.AUTHService/Login",
+		"/asset.AUTHService/RefreshToken",
+		"/asset.AUTHService/VerifyMFA",
+		"/asset.AUTHService/EnrollTOTP",
+		"/asset.AUTHService/ConfirmTOTP",
+		"/asset.AUTHService/BeginOIDCLogin",
+		"/asset.AUTHService/CompleteOIDCLogin",
+		"/asset.USERService/ResetPassword",
+		"/asset.USERService/RequestPasswordReset",
+		"/asset.USERService/ConfirmPasswordReset",
+		"/asset.NOTIFICATIONService/UnsubscribeDigest",
+		healthpb.Health_Check_FullMethodName,
+		healthpb.Health_Watch_FullMethodName,
+	}
+	// Users who must change their password can only use the auth service
+	var passwordMethods []string
+	for _, method := range assetpb.AUTHService_ServiceDesc.Methods {
+		passwordMethods = append(passwordMethods, "/"+assetpb.AUTHService_ServiceDesc.ServiceName+"/"+method.MethodName)
+	}
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(auth.JWTAuthMiddleware(tokens, excludedMethods, passwordMethods)),
+		grpc.StreamInterceptor(auth.JWTStreamAuthMiddleware(tokens, excludedMethods, passwordMethods)),
+	)
 
 	// Dynamically register all services
 	for _, svc := range servicesList {
 		svc.Register(grpcServer)
 	}
-
-	log.Info().Msg("Serving gRPC on :50053")
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatal().Err(err).Msg("Failed to serve")
-	}
+	return grpcServer
 }
 
-func startHTTPGateway() {
+func newHTTPGateway(cfg config.Server, db *pgxpool.Pool, monitor *health.Monitor, keys *auth.KeyManager) (*http.Server, error) {
 	ctx := context.Background()
-	mux := runtime.NewServeMux()
+	// The gateway vouches for the client address it forwards, and clients
+	// cannot send that address or the gateway's secret themselves
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+		runtime.WithMetadata(func(context.Context, *http.Request) metadata.MD {
+			return auth.GatewayMetadata()
+		}),
+	)
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 
 	// Register gRPC-Gateway handlers
@@ -104,40 +283,63 @@ func startHTTPGateway() {
 		{"PERSONALRESPONSIBLEService", assetpb.RegisterPERSONALRESPONSIBLEServiceHandlerFromEndpoint},
 		{"SUBMISSIONService", assetpb.RegisterSUBMISSIONServiceHandlerFromEndpoint},
 		{"NOTIFICATIONService", assetpb.RegisterNOTIFICATIONServiceHandlerFromEndpoint},
+		{"CALENDARService", assetpb.RegisterCALENDARServiceHandlerFromEndpoint},
+		{"ESCALATIONService", assetpb.RegisterESCALATIONServiceHandlerFromEndpoint},
+		{"NOTIFICATIONRULEService", assetpb.RegisterNOTIFICATIONRULEServiceHandlerFromEndpoint},
+		{"APIKEYService", assetpb.RegisterAPIKEYServiceHandlerFromEndpoint},
         {"POSITIONService", assetpb.RegisterPOSITIONServiceHandlerFromEndpoint},
 	}
 
 	for _, svc := range services {
-		err := svc.fn(ctx, mux, "localhost:50053", opts)
+		err := svc.fn(ctx, mux, cfg.GRPCEndpoint, opts)
 		if err != nil {
-			log.Fatal().Err(err).Msgf("Failed to start HTTP gateway for %s", svc.name)
+			return nil, fmt.Errorf("register %s: %w", svc.name, err)
 		}
 	}
 
-	log.Info().Msg("Serving HTTP Gateway on :8080")
-	if err := http.ListenAndServe(":8080", corsHandler(mux)); err != nil {
-		log.Fatal().Err(err).Msg("HTTP Gateway failed")
+	// Server-Sent Events for the web app, bridged from StreamNotifications
+	conn, err := grpc.Dial(cfg.GRPCEndpoint, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("dial gRPC server for notification events: %w", err)
+	}
+	sse := realtime.SSEHandler(assetpb.NewNOTIFICATIONServiceClient(conn))
+	err = mux.HandlePath("GET", "/api/notifications/events", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		sse(w, r)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("register notification events endpoint: %w", err)
 	}
-}
 
-func startRESTServer() {
-	// Load environment variables
-	err := godotenv.Load()
+	// Tokenized iCalendar feeds; calendar apps fetch these without logging in
+	err = mux.HandlePath("GET", "/api/calendar/{token}", calendar.Handler(db))
 	if err != nil {
-		log.Fatal().Err(err).Msg("Error loading .env file")
+		return nil, fmt.Errorf("register calendar feed endpoint: %w", err)
 	}
-	apiKeysEnv := os.Getenv("API_KEYS")
 
-	// Split the API keys into a slice
-	apiKeysSlice := []string{}
-	for _, key := range strings.Split(apiKeysEnv, ",") {
-		apiKeysSlice = append(apiKeysSlice, strings.TrimSpace(key))
+	// Liveness and readiness probes, and the public keys other services
+	// verify our tokens with
+	for path, handler := range map[string]http.HandlerFunc{
+		"/healthz":               monitor.LivenessHandler(),
+		"/readyz":                monitor.ReadinessHandler(),
+		"/.well-known/jwks.json": keys.JWKSHandler(),
+	} {
+		handler := handler
+		err = mux.HandlePath("GET", path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			handler(w, r)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("register %s: %w", path, err)
+		}
 	}
 
-	// Convert the slice to a map
-	apiKeys := make(map[string]bool)
-	for _, key := range apiKeysSlice {
-		apiKeys[key] = true
+	return &http.Server{Addr: cfg.GatewayAddr, Handler: corsHandler(clientAddressHandler(cfg.TrustedProxyHops, mux))}, nil
+}
+
+// newRESTServer serves file transfers and the asset list to integrations,
+// authenticated by API keys whose scopes grant each route.
+func newRESTServer(cfg config.Config, assets repository.AssetRepository, nextcloud *utils.Nextcloud, apiKeys *auth.APIKeys) *http.Server {
+	if os.Getenv("API_KEYS") != "" {
+		log.Warn().Msg("API_KEYS is no longer read; create API keys with the API or `server apikey create`")
 	}
 
 	r := gin.Default()
@@ -147,9 +349,9 @@ func startRESTServer() {
 	r.Use(auth.APIKeyMiddleware(apiKeys))
 
 	// Upload file to Nextcloud
-	r.POST("/upload", func(c *gin.Context) {
+	r.POST("/upload", auth.RequireScope(auth.ScopeUpload), func(c *gin.Context) {
 		module := c.DefaultQuery("module", "")
-		filePath, err := utils.UploadFile(c.Writer, c.Request, module)
+		filePath, err := nextcloud.UploadFile(c.Writer, c.Request, module)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
@@ -160,9 +362,9 @@ func startRESTServer() {
 		})
 	})
 
-	r.GET("/get-file", func(c *gin.Context) {
+	r.GET("/get-file", auth.RequireScope(auth.ScopeGetFile), func(c *gin.Context) {
 		filePath := c.DefaultQuery("path", "")
-		res, err := utils.GetFile(c.Writer, c.Request, filePath)
+		res, err := nextcloud.GetFile(c.Writer, c.Request, filePath)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
@@ -174,18 +376,17 @@ func startRESTServer() {
 	})
 
 	// Add the new endpoint for listing assets
-	assetService := services.NewAssetService(database.DBConn())
-	r.GET("/assets", assetService.ListAssetsHandler)
+	assetService := services.NewAssetService(assets, nil, nil)
+	r.GET("/assets", auth.RequireScope(auth.ScopeAssetsRead), assetService.ListAssetsHandler)
 
-	log.Info().Msg("Server REST started on port 8081")
-	r.Run(":8081")
+	return &http.Server{Addr: cfg.Server.RESTAddr, Handler: r}
 }
 
 func corsHandler(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Last-Event-ID")
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
 			return
@@ -194,6 +395,44 @@ func corsHandler(h http.Handler) http.Handler {
 	})
 }
 
+// gatewayHeaderMatcher forwards headers like the default matcher, except the
+// ones that tell the gRPC services where a call came from.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	name, ok := runtime.DefaultHeaderMatcher(key)
+	if !ok || strings.EqualFold(name, "x-forwarded-for") || strings.EqualFold(name, auth.GatewayHeader) {
+		return "", false
+	}
+	return name, true
+}
+
+// clientAddressHandler settles the client address before the gateway passes
+// it on as X-Forwarded-For. Only the entries added by the hops trusted proxies
+// in front of the gateway are believed; anything else the client sent is
+// dropped, so it cannot be used to get around per-address login limits.
+func clientAddressHandler(hops int, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var forwarded []string
+		for _, value := range r.Header.Values("X-Forwarded-For") {
+			for _, addr := range strings.Split(value, ",") {
+				if addr = strings.TrimSpace(addr); addr != "" {
+					forwarded = append(forwarded, addr)
+				}
+			}
+		}
+		r.Header.Del("X-Forwarded-For")
+
+		// Each proxy appends the address it received the request from
+		if hops > 0 && len(forwarded) > 0 {
+			client := forwarded[0]
+			if len(forwarded) >= hops {
+				client = forwarded[len(forwarded)-hops]
+			}
+			r.RemoteAddr = net.JoinHostPort(client, "0")
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
 func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*") // Allow all origins